@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -54,6 +55,13 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder records (or replays) the requests made during this test, when a Recording Mode is specified
+	recorder *recorder.Recorder
+
+	// random is used to generate the random values for this test, which is seeded from the recording
+	// when a Recording Mode is specified
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
@@ -85,6 +93,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if mode := recorder.ModeFromEnvironment(); mode != "" {
+		testData.startRecording(t, mode)
+	}
+
 	return testData
 }
 
@@ -123,6 +135,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return randStringFromCharSetWithRand(len, charSetAlphaNum, td.random)
+	}
+
 	return randString(len)
 }
 
@@ -140,3 +156,13 @@ func randStringFromCharSet(strlen int, charSet string) string {
 	}
 	return string(result)
 }
+
+// randStringFromCharSetWithRand generates a random string by selecting characters from
+// the charset provided, using the specified source of randomness
+func randStringFromCharSetWithRand(strlen int, charSet string, random *rand.Rand) string {
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[random.Intn(len(charSet))]
+	}
+	return string(result)
+}
@@ -0,0 +1,106 @@
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (s *Server) handleMetadata(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("api-version") != "2020-06-01" {
+		// older API versions (used to retrieve the supported locations) return no information
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/", s.URL())
+	writeJSON(w, http.StatusOK, []interface{}{
+		map[string]interface{}{
+			"name":            EnvironmentName,
+			"portal":          endpoint,
+			"resourceManager": endpoint,
+			"graph":           endpoint,
+			"graphAudience":   endpoint,
+			"batch":           endpoint,
+			"gallery":         endpoint,
+			"authentication": map[string]interface{}{
+				"loginEndpoint":    endpoint,
+				"audiences":        []string{endpoint},
+				"tenant":           "common",
+				"identityProvider": "AAD",
+			},
+			"suffixes": map[string]interface{}{
+				"keyVaultDns":       "vault.fakearm.local",
+				"storage":           "core.fakearm.local",
+				"sqlServerHostname": "database.fakearm.local",
+				"acrLoginServer":    "azurecr.fakearm.local",
+			},
+		},
+	})
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "tokens must be requested using a POST")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("client_id") != ClientId || r.PostForm.Get("client_secret") != ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid_client", "the Client ID or Client Secret is invalid")
+		return
+	}
+
+	now := time.Now()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   "3600",
+		"expires_on":   strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+		"not_before":   strconv.FormatInt(now.Unix(), 10),
+		"resource":     r.PostForm.Get("resource"),
+	})
+}
+
+func (s *Server) handleServicePrincipals(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(w, r) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": []interface{}{
+			map[string]interface{}{
+				"objectId":   ObjectId,
+				"objectType": "ServicePrincipal",
+				"appId":      ClientId,
+			},
+		},
+	})
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	op, ok := s.operations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the Operation %q was not found", id))
+		return
+	}
+
+	// the first poll returns that the operation is still in progress, to exercise the polling logic
+	op.polls++
+	status := "Succeeded"
+	if op.polls == 1 {
+		status = "InProgress"
+		w.Header().Set("Retry-After", "0")
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     id,
+		"status": status,
+	})
+}
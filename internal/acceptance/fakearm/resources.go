@@ -0,0 +1,377 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+// Resource returns the body of the Resource with the specified ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	existing, ok := s.resources[resourceKey(id)]
+	if !ok {
+		return nil, false
+	}
+
+	return copyBody(existing.body), true
+}
+
+// PutResource creates (or replaces) the Resource with the specified ID, for example to test importing
+// a Resource which already exists
+func (s *Server) PutResource(id string, body map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.putResource(id, strings.Split(strings.Trim(id, "/"), "/"), copyBody(body))
+}
+
+func (s *Server) handleResourceManager(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) < 2 || !strings.EqualFold(segments[1], SubscriptionId) {
+		writeError(w, http.StatusNotFound, "SubscriptionNotFound", "The subscription could not be found.")
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := "/" + strings.Join(segments, "/")
+
+	// Resource IDs are made up of key/value pairs - so an odd number of segments is a Collection
+	if len(segments)%2 == 1 {
+		if r.Method == http.MethodPost {
+			s.handleAction(w, r, segments)
+			return
+		}
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for the collection %q", r.Method, id))
+			return
+		}
+		s.handleList(w, segments)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		existing, ok := s.resources[resourceKey(id)]
+		if !ok {
+			s.writeNotFound(w, segments)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing.body)
+
+	case http.MethodHead:
+		if _, ok := s.resources[resourceKey(id)]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		if !s.parentExists(w, segments) {
+			return
+		}
+
+		_, exists := s.resources[resourceKey(id)]
+		stored := s.putResource(id, segments, body)
+
+		statusCode := http.StatusCreated
+		if exists {
+			statusCode = http.StatusOK
+		}
+		s.writeOperationResult(w, segments, statusCode, stored.body)
+
+	case http.MethodPatch:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		existing, ok := s.resources[resourceKey(id)]
+		if !ok {
+			s.writeNotFound(w, segments)
+			return
+		}
+
+		for k, v := range body {
+			if k == "properties" {
+				properties, _ := existing.body["properties"].(map[string]interface{})
+				if properties == nil {
+					properties = map[string]interface{}{}
+				}
+				if updated, ok := v.(map[string]interface{}); ok {
+					for pk, pv := range updated {
+						properties[pk] = pv
+					}
+				}
+				existing.body["properties"] = properties
+				continue
+			}
+			existing.body[k] = v
+		}
+		s.writeOperationResult(w, segments, http.StatusOK, existing.body)
+
+	case http.MethodDelete:
+		key := resourceKey(id)
+		if _, ok := s.resources[key]; !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		// deleting a Resource also deletes any nested Resources (e.g. those within a Resource Group)
+		for k := range s.resources {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				delete(s.resources, k)
+			}
+		}
+		s.writeOperationResult(w, segments, http.StatusAccepted, nil)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for %q", r.Method, id))
+	}
+}
+
+func (s *Server) putResource(id string, segments []string, body map[string]interface{}) *resource {
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+
+	// the first ID used for a Resource is returned (with the casing of the well-known segments normalized), as Azure does
+	key := resourceKey(id)
+	if existing, ok := s.resources[key]; ok {
+		id = existing.id
+	} else {
+		id = normalizeId(segments)
+	}
+
+	properties, _ := body["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	properties["provisioningState"] = "Succeeded"
+	body["properties"] = properties
+	body["id"] = id
+	body["name"] = segments[len(segments)-1]
+	body["type"] = resourceType(segments)
+
+	stored := &resource{
+		id:   id,
+		body: body,
+	}
+	s.resources[key] = stored
+	return stored
+}
+
+// parentExists validates that the Resource Group (and any parent Resource) exists for this Resource
+func (s *Server) parentExists(w http.ResponseWriter, segments []string) bool {
+	if len(segments) > 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		if _, ok := s.resources[resourceKey(strings.Join(segments[:4], "/"))]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+			return false
+		}
+	}
+
+	if i := providersIndex(segments); i != -1 && len(segments)-i > 4 {
+		if _, ok := s.resources[resourceKey(strings.Join(segments[:len(segments)-2], "/"))]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", segments[len(segments)-3]))
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeOperationResult writes the response for a Create/Update/Delete - which is either synchronous, or
+// a Long Running Operation which must be polled using the `Azure-AsyncOperation` header
+func (s *Server) writeOperationResult(w http.ResponseWriter, segments []string, statusCode int, body map[string]interface{}) {
+	if _, ok := s.synchronous[strings.ToLower(resourceType(segments))]; ok {
+		if statusCode == http.StatusAccepted {
+			statusCode = http.StatusOK
+		}
+		writeJSON(w, statusCode, body)
+		return
+	}
+
+	s.operationCount++
+	operationId := strconv.Itoa(s.operationCount)
+	s.operations[operationId] = &operation{}
+
+	operationUrl := fmt.Sprintf("%s/operations/%s?api-version=2020-01-01", s.URL(), operationId)
+	w.Header().Set("Azure-AsyncOperation", operationUrl)
+	w.Header().Set("Retry-After", "0")
+	if statusCode == http.StatusAccepted {
+		w.Header().Set("Location", operationUrl)
+	}
+
+	if body != nil {
+		// the operation hasn't completed yet, so the response contains the in-progress state
+		response := copyBody(body)
+		if properties, ok := response["properties"].(map[string]interface{}); ok {
+			properties["provisioningState"] = "Accepted"
+		}
+		writeJSON(w, statusCode, response)
+		return
+	}
+	writeJSON(w, statusCode, nil)
+}
+
+func (s *Server) writeNotFound(w http.ResponseWriter, segments []string) {
+	if len(segments) > 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		if _, ok := s.resources[resourceKey(strings.Join(segments[:4], "/"))]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+			return
+		}
+	}
+	if len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", resourceType(segments)+"/"+segments[len(segments)-1]))
+}
+
+func (s *Server) handleList(w http.ResponseWriter, segments []string) {
+	// the Resource Providers are all registered
+	if len(segments) == 3 && strings.EqualFold(segments[2], "providers") {
+		providers := make([]interface{}, 0)
+		for namespace := range resourceproviders.Required() {
+			providers = append(providers, map[string]interface{}{
+				"id":                fmt.Sprintf("/subscriptions/%s/providers/%s", SubscriptionId, namespace),
+				"namespace":         namespace,
+				"registrationState": "Registered",
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": providers,
+		})
+		return
+	}
+
+	scope := resourceKey(strings.Join(segments[:len(segments)-1], "/"))
+	collection := resourceKey(strings.Join(segments, "/"))
+	allResources := strings.EqualFold(segments[len(segments)-1], "resources")
+
+	keys := make([]string, 0)
+	for k := range s.resources {
+		if allResources {
+			// e.g. listing all of the Resources within a Resource Group
+			if strings.HasPrefix(k, scope+"/") && providersIndex(strings.Split(k, "/")) != -1 {
+				keys = append(keys, k)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(k, collection+"/") && len(strings.Split(k, "/")) == len(segments)+1 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0)
+	for _, k := range keys {
+		values = append(values, s.resources[k].body)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) handleAction(w http.ResponseWriter, r *http.Request, segments []string) {
+	id := "/" + strings.Join(segments[:len(segments)-1], "/")
+	action := strings.ToLower(segments[len(segments)-1])
+
+	handler, ok := s.actions[action]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ActionNotFound", fmt.Sprintf("the Action %q is not supported by the fake ARM Server", action))
+		return
+	}
+	if _, ok := s.resources[resourceKey(id)]; !ok {
+		s.writeNotFound(w, segments[:len(segments)-1])
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	statusCode, response := handler(id, body)
+	writeJSON(w, statusCode, response)
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	body := map[string]interface{}{}
+	if r.Body == nil || r.ContentLength == 0 {
+		return body, true
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid and could not be deserialized: %+v", err))
+		return nil, false
+	}
+
+	return body, true
+}
+
+// resourceType returns the Resource Type for the specified Resource ID segments, e.g. `Microsoft.Storage/storageAccounts`
+func resourceType(segments []string) string {
+	i := providersIndex(segments)
+	if i == -1 {
+		if len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups") {
+			return "Microsoft.Resources/resourceGroups"
+		}
+		return "Microsoft.Resources/subscriptions"
+	}
+
+	types := []string{segments[i+1]}
+	for j := i + 2; j < len(segments); j += 2 {
+		types = append(types, segments[j])
+	}
+	return strings.Join(types, "/")
+}
+
+// normalizeId returns the Resource ID for the specified segments, using the casing Azure returns for the well-known segments
+func normalizeId(segments []string) string {
+	normalized := make([]string, len(segments))
+	copy(normalized, segments)
+
+	normalized[0] = "subscriptions"
+	if len(normalized) > 2 && strings.EqualFold(normalized[2], "resourceGroups") {
+		normalized[2] = "resourceGroups"
+	}
+	if i := providersIndex(normalized); i != -1 {
+		normalized[i] = "providers"
+	}
+
+	return "/" + strings.Join(normalized, "/")
+}
+
+func providersIndex(segments []string) int {
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") && i+1 < len(segments) {
+			return i
+		}
+	}
+	return -1
+}
+
+func resourceKey(id string) string {
+	return strings.ToLower(strings.Trim(id, "/"))
+}
+
+func copyBody(input map[string]interface{}) map[string]interface{} {
+	contents, _ := json.Marshal(input)
+	output := map[string]interface{}{}
+	_ = json.Unmarshal(contents, &output)
+	return output
+}
@@ -0,0 +1,191 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// EnvironmentName is the name of the Azure Environment returned from the MetaData endpoint
+	EnvironmentName = "FakeARM"
+
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+	TenantId       = "00000000-0000-0000-0000-000000000001"
+	ClientId       = "00000000-0000-0000-0000-000000000002"
+	ClientSecret   = "fake-client-secret"
+	ObjectId       = "00000000-0000-0000-0000-000000000003"
+
+	accessToken = "fake-access-token"
+)
+
+// Server is an in-process stand-in for Azure Resource Manager, which stores Resources in memory.
+//
+// Resources are created/updated/deleted immediately, however (unless the Resource Type is registered
+// as synchronous) the response includes an `Azure-AsyncOperation` header which must be polled for
+// completion, in the same way as a Long Running Operation.
+type Server struct {
+	server *httptest.Server
+
+	lock           sync.Mutex
+	resources      map[string]*resource
+	operations     map[string]*operation
+	actions        map[string]ActionFunc
+	synchronous    map[string]struct{}
+	operationCount int
+}
+
+// ActionFunc handles a POST request to an Action on a Resource (e.g. `listKeys`), returning the
+// status code and the body for the response
+type ActionFunc func(id string, body map[string]interface{}) (int, interface{})
+
+type resource struct {
+	id   string
+	body map[string]interface{}
+}
+
+type operation struct {
+	polls int
+}
+
+var trustCertificate sync.Once
+
+// New starts a new Server which is closed when the test completes.
+//
+// Since the Provider requires that the MetaData Host uses HTTPS, the certificate used by the Server is trusted
+// by setting the `SSL_CERT_FILE` environment variable - as such this must be called before any other TLS
+// connections are made in this process, and requests to other hosts will fail. Tests using the Server outside
+// of this package must therefore use the `fakearm` build tag, so that these are compiled into a separate test
+// binary to the Acceptance Tests - and are skipped when running the Acceptance Tests (`TF_ACC` is set).
+func New(t *testing.T) *Server {
+	if os.Getenv("TF_ACC") != "" {
+		t.Skip("skipping since `TF_ACC` is set - the fake ARM Server can't be used alongside requests to Azure")
+	}
+
+	s := &Server{
+		resources:  map[string]*resource{},
+		operations: map[string]*operation{},
+		actions:    map[string]ActionFunc{},
+		synchronous: map[string]struct{}{
+			"microsoft.resources/resourcegroups": {},
+		},
+	}
+	s.server = httptest.NewTLSServer(s)
+	t.Cleanup(s.server.Close)
+
+	trustCertificate.Do(func() {
+		// all httptest servers use the same certificate, so this only needs to be trusted once
+		path := filepath.Join(os.TempDir(), "fakearm-certificate.pem")
+		contents := pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: s.server.Certificate().Raw,
+		})
+		if err := os.WriteFile(path, contents, 0600); err != nil {
+			t.Fatalf("writing the certificate for the fake ARM Server: %+v", err)
+		}
+		if err := os.Setenv("SSL_CERT_FILE", path); err != nil {
+			t.Fatalf("trusting the certificate for the fake ARM Server: %+v", err)
+		}
+	})
+
+	return s
+}
+
+// URL returns the base URL for this Server, e.g. `https://127.0.0.1:1234`
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// MetadataHost returns the host which should be used as the Provider's `metadata_host`
+func (s *Server) MetadataHost() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+// RegisterAction registers a handler for POST requests to the specified Action (e.g. `listKeys`)
+func (s *Server) RegisterAction(action string, handler ActionFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.actions[strings.ToLower(action)] = handler
+}
+
+// RegisterSynchronousType registers a Resource Type (e.g. `Microsoft.Resources/resourceGroups`) whose
+// Create/Update/Delete operations complete synchronously, rather than as a Long Running Operation
+func (s *Server) RegisterSynchronousType(resourceType string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.synchronous[strings.ToLower(resourceType)] = struct{}{}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := "/" + strings.Trim(r.URL.Path, "/")
+	// the MetaData Service can be requested with a double slash
+	for strings.Contains(path, "//") {
+		path = strings.ReplaceAll(path, "//", "/")
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case strings.EqualFold(path, "/metadata/endpoints"):
+		s.handleMetadata(w, r)
+
+	case len(segments) == 3 && strings.EqualFold(segments[1], "oauth2") && strings.EqualFold(segments[2], "token"):
+		s.handleToken(w, r)
+
+	case len(segments) == 2 && strings.EqualFold(segments[1], "servicePrincipals"):
+		s.handleServicePrincipals(w, r)
+
+	case len(segments) == 2 && segments[0] == "operations":
+		if !s.authorized(w, r) {
+			return
+		}
+		s.handleOperation(w, segments[1])
+
+	case strings.EqualFold(segments[0], "subscriptions"):
+		if !s.authorized(w, r) {
+			return
+		}
+		if r.URL.Query().Get("api-version") == "" {
+			writeError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+			return
+		}
+		s.handleResourceManager(w, r, segments)
+
+	default:
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the path %q is not supported by the fake ARM Server", path))
+	}
+}
+
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", accessToken) {
+		writeError(w, http.StatusUnauthorized, "AuthenticationFailed", "Authentication failed. The 'Authorization' header is missing or invalid.")
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
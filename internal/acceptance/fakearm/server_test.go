@@ -0,0 +1,138 @@
+package fakearm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServerResourceLifecycle(t *testing.T) {
+	s := New(t)
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	accountId := fmt.Sprintf("%s/providers/Microsoft.Storage/storageAccounts/example", resourceGroupId)
+	containerId := fmt.Sprintf("%s/blobServices/default/containers/example", accountId)
+
+	// Resources are scoped to a Resource Group, which must exist
+	resp, body := s.do(t, http.MethodPut, accountId, map[string]interface{}{"location": "westeurope"})
+	assertError(t, resp, body, http.StatusNotFound, "ResourceGroupNotFound")
+
+	resp, _ = s.do(t, http.MethodPut, resourceGroupId, map[string]interface{}{"location": "westeurope"})
+	if resp.StatusCode != http.StatusCreated || resp.Header.Get("Azure-AsyncOperation") != "" {
+		t.Fatalf("expected the Resource Group to be created synchronously but got %d", resp.StatusCode)
+	}
+
+	resp, body = s.do(t, http.MethodPut, accountId, map[string]interface{}{"location": "westeurope"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 but got %d", resp.StatusCode)
+	}
+	operationUrl := resp.Header.Get("Azure-AsyncOperation")
+	if operationUrl == "" {
+		t.Fatalf("expected an `Azure-AsyncOperation` header")
+	}
+	if body["properties"].(map[string]interface{})["provisioningState"] != "Accepted" {
+		t.Fatalf("expected the initial response to be `Accepted` but got %+v", body)
+	}
+
+	for _, expected := range []string{"InProgress", "Succeeded"} {
+		resp, body = s.do(t, http.MethodGet, operationUrl, nil)
+		if resp.StatusCode != http.StatusOK || body["status"] != expected {
+			t.Fatalf("expected the operation to be %q but got %d / %+v", expected, resp.StatusCode, body)
+		}
+	}
+
+	resp, body = s.do(t, http.MethodGet, accountId, nil)
+	if resp.StatusCode != http.StatusOK || body["type"] != "Microsoft.Storage/storageAccounts" {
+		t.Fatalf("expected the Storage Account to exist but got %d / %+v", resp.StatusCode, body)
+	}
+
+	// nested Resources require that their parent exists
+	resp, body = s.do(t, http.MethodPut, containerId, nil)
+	assertError(t, resp, body, http.StatusNotFound, "ParentResourceNotFound")
+
+	resp, body = s.do(t, http.MethodGet, accountId+"-missing", nil)
+	assertError(t, resp, body, http.StatusNotFound, "ResourceNotFound")
+
+	resp, body = s.do(t, http.MethodGet, fmt.Sprintf("%s/resources", resourceGroupId), nil)
+	if values := body["value"].([]interface{}); resp.StatusCode != http.StatusOK || len(values) != 1 {
+		t.Fatalf("expected 1 Resource within the Resource Group but got %d / %+v", resp.StatusCode, body)
+	}
+
+	// deleting the Resource Group deletes the Resources within it
+	resp, _ = s.do(t, http.MethodDelete, resourceGroupId, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 when deleting the Resource Group but got %d", resp.StatusCode)
+	}
+	if _, ok := s.Resource(accountId); ok {
+		t.Fatalf("expected the Storage Account to have been deleted with the Resource Group")
+	}
+	resp, _ = s.do(t, http.MethodDelete, resourceGroupId, nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 when deleting a Resource Group which doesn't exist but got %d", resp.StatusCode)
+	}
+}
+
+func TestServerRequiresAuthorizationAndApiVersion(t *testing.T) {
+	s := New(t)
+	uri := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/example", s.URL(), SubscriptionId)
+
+	resp, err := s.server.Client().Get(uri + "?api-version=2020-06-01")
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 without an Authorization header but got %d", resp.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, uri, nil)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	resp, err = s.server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a 400 without an api-version but got %d", resp.StatusCode)
+	}
+}
+
+func (s *Server) do(t *testing.T, method, uri string, body map[string]interface{}) (*http.Response, map[string]interface{}) {
+	if uri[0] == '/' {
+		uri = fmt.Sprintf("%s%s?api-version=2021-01-01", s.URL(), uri)
+	}
+
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatalf("serializing body: %+v", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, uri, &payload)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := s.server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	out := map[string]interface{}{}
+	_ = json.NewDecoder(resp.Body).Decode(&out)
+	return resp, out
+}
+
+func assertError(t *testing.T, resp *http.Response, body map[string]interface{}, statusCode int, code string) {
+	t.Helper()
+
+	if resp.StatusCode != statusCode {
+		t.Fatalf("expected a %d but got %d", statusCode, resp.StatusCode)
+	}
+	if e, ok := body["error"].(map[string]interface{}); !ok || e["code"] != code {
+		t.Fatalf("expected the error code %q but got %+v", code, body)
+	}
+}
@@ -0,0 +1,121 @@
+package acceptance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+// FakeARMProvider is an instance of the Provider configured (via `metadata_host`) against an in-process
+// fake Azure Resource Manager, which allows the Create/Read/Update/Delete and Import logic for a Resource
+// to be tested without a Subscription
+type FakeARMProvider struct {
+	Provider *schema.Provider
+	Server   *fakearm.Server
+}
+
+// NewFakeARMProvider starts a fake Azure Resource Manager server and configures the Provider against it.
+// Tests using this must use the `fakearm` build tag, see `fakearm.New` for more information
+func NewFakeARMProvider(t *testing.T) FakeARMProvider {
+	server := fakearm.New(t)
+
+	// the requests and responses (including those for access tokens) are only logged when `TF_LOG` is set
+	logging.SetOutput(t)
+
+	p := provider.TestAzureProvider()
+	diags := p.Configure(context.TODO(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"client_id":       fakearm.ClientId,
+		"client_secret":   fakearm.ClientSecret,
+		"environment":     fakearm.EnvironmentName,
+		"metadata_host":   server.MetadataHost(),
+		"subscription_id": fakearm.SubscriptionId,
+		"tenant_id":       fakearm.TenantId,
+		"features": []interface{}{
+			map[string]interface{}{},
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("configuring the Provider against the fake ARM Server: %+v", diags)
+	}
+
+	return FakeARMProvider{
+		Provider: p,
+		Server:   server,
+	}
+}
+
+// Plan returns the diff between the specified state and configuration for the Resource Type - where a
+// nil diff means there are no changes
+func (f FakeARMProvider) Plan(t *testing.T, resourceType string, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceDiff {
+	diff, err := f.resource(t, resourceType).Diff(context.TODO(), state, terraform.NewResourceConfigRaw(config), f.Provider.Meta())
+	if err != nil {
+		t.Fatalf("planning %s: %+v", resourceType, err)
+	}
+
+	return diff
+}
+
+// Apply creates (when the state is nil) or updates the Resource to match the specified configuration
+func (f FakeARMProvider) Apply(t *testing.T, resourceType string, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	diff := f.Plan(t, resourceType, state, config)
+	if diff == nil {
+		return state
+	}
+
+	newState, diags := f.resource(t, resourceType).Apply(context.TODO(), state, diff, f.Provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("applying %s: %+v", resourceType, diags)
+	}
+
+	return newState
+}
+
+// Read refreshes the state of the Resource - returning nil if the Resource no longer exists
+func (f FakeARMProvider) Read(t *testing.T, resourceType string, state *terraform.InstanceState) *terraform.InstanceState {
+	newState, diags := f.resource(t, resourceType).RefreshWithoutUpgrade(context.TODO(), state, f.Provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("reading %s: %+v", resourceType, diags)
+	}
+
+	return newState
+}
+
+// Import imports the Resource with the specified ID and then refreshes it
+func (f FakeARMProvider) Import(t *testing.T, resourceType string, id string) *terraform.InstanceState {
+	resource := f.resource(t, resourceType)
+	if resource.Importer == nil || resource.Importer.StateContext == nil {
+		t.Fatalf("%s does not support importing", resourceType)
+	}
+
+	data, err := resource.Importer.StateContext(context.TODO(), resource.Data(&terraform.InstanceState{ID: id}), f.Provider.Meta())
+	if err != nil {
+		t.Fatalf("importing %s %q: %+v", resourceType, id, err)
+	}
+	if len(data) != 1 {
+		t.Fatalf("expected 1 Resource to be imported for %s %q but got %d", resourceType, id, len(data))
+	}
+
+	return f.Read(t, resourceType, data[0].State())
+}
+
+// Destroy deletes the Resource
+func (f FakeARMProvider) Destroy(t *testing.T, resourceType string, state *terraform.InstanceState) {
+	_, diags := f.resource(t, resourceType).Apply(context.TODO(), state, &terraform.InstanceDiff{Destroy: true}, f.Provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("destroying %s: %+v", resourceType, diags)
+	}
+}
+
+func (f FakeARMProvider) resource(t *testing.T, resourceType string) *schema.Resource {
+	resource, ok := f.Provider.ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("the Resource %q was not found", resourceType)
+	}
+
+	return resource
+}
@@ -0,0 +1,53 @@
+package recorder
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvVar is the Environment Variable used to specify the Recording Mode
+const EnvVar = "ARM_TEST_RECORDING_MODE"
+
+type Mode string
+
+const (
+	// ModeRecord runs the Acceptance Tests against Azure, recording the requests made into a Cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay runs the Acceptance Tests using the recorded Cassettes, without access to Azure
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnvironment returns the Recording Mode specified in the Environment, where an empty
+// value means that the Acceptance Tests are run against Azure without recording
+func ModeFromEnvironment() Mode {
+	switch strings.ToLower(os.Getenv(EnvVar)) {
+	case string(ModeRecord):
+		return ModeRecord
+	case string(ModeReplay):
+		return ModeReplay
+	}
+
+	return ""
+}
+
+// credentialPlaceholders maps the Environment Variables containing the credentials used during a live run
+// to the placeholder values which replace them in the Cassettes, and which are used when replaying
+var credentialPlaceholders = map[string]string{
+	"ARM_SUBSCRIPTION_ID": "00000000-0000-0000-0000-000000000000",
+	"ARM_TENANT_ID":       "00000000-0000-0000-0000-000000000001",
+	"ARM_CLIENT_ID":       "00000000-0000-0000-0000-000000000002",
+}
+
+// ConfigureEnvironmentForReplay sets the credentials in the Environment to the placeholder values
+// used in the Cassettes, so that the Provider is configured as it was when recording
+func ConfigureEnvironmentForReplay() error {
+	for variable, placeholder := range credentialPlaceholders {
+		if err := os.Setenv(variable, placeholder); err != nil {
+			return err
+		}
+	}
+
+	// the Client Secret isn't used when replaying, but is required to configure the Provider
+	return os.Setenv("ARM_CLIENT_SECRET", "replayed")
+}
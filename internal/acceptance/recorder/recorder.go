@@ -0,0 +1,268 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+var _ common.RequestRecorder = &Recorder{}
+
+// Recorder records the requests made to Azure (and their responses) into a Cassette during a
+// live run of an Acceptance Test - which can then be replayed to run the test without access to Azure
+type Recorder struct {
+	mode Mode
+	path string
+
+	cassette   Cassette
+	replayed   []bool
+	sanitizers []sanitizer
+
+	lock sync.Mutex
+}
+
+// Cassette is the file containing the requests recorded for a single Acceptance Test
+type Cassette struct {
+	// Variables contains the values which must be the same when replaying the test (e.g. the random values in the TestData)
+	Variables map[string]string `json:"variables"`
+
+	// Interactions are the requests sent to Azure and their responses, in the order they were sent
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type sanitizer struct {
+	regex       *regexp.Regexp
+	placeholder string
+}
+
+// New returns a Recorder for the Cassette at the specified path - when replaying this Cassette must exist
+func New(path string, mode Mode) (*Recorder, error) {
+	r := Recorder{
+		mode: mode,
+		path: path,
+		cassette: Cassette{
+			Variables:    map[string]string{},
+			Interactions: []Interaction{},
+		},
+	}
+
+	switch mode {
+	case ModeRecord:
+		// the credentials used for the live run are replaced with placeholders, which are used when replaying
+		for variable, placeholder := range credentialPlaceholders {
+			if value := os.Getenv(variable); value != "" {
+				r.sanitizers = append(r.sanitizers, sanitizer{
+					regex:       regexp.MustCompile(fmt.Sprintf("(?i)%s", regexp.QuoteMeta(value))),
+					placeholder: placeholder,
+				})
+			}
+		}
+
+	case ModeReplay:
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the Cassette %q: %w", path, err)
+		}
+		if err := json.Unmarshal(contents, &r.cassette); err != nil {
+			return nil, fmt.Errorf("deserializing the Cassette %q: %+v", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+
+	default:
+		return nil, fmt.Errorf("unsupported Recording Mode %q", string(mode))
+	}
+
+	return &r, nil
+}
+
+// Replaying returns whether the recorded responses are being replayed
+func (r *Recorder) Replaying() bool {
+	return r.mode == ModeReplay
+}
+
+// Variable returns the recorded value for the specified Variable
+func (r *Recorder) Variable(name string) (string, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v, ok := r.cassette.Variables[name]
+	return v, ok
+}
+
+// SetVariable records the value for the specified Variable
+func (r *Recorder) SetVariable(name, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Variables[name] = value
+}
+
+// Sender returns a Sender which either records the requests sent via the specified Sender, or
+// replays the recorded response for each request
+func (r *Recorder) Sender(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if r.Replaying() {
+			return r.replay(req)
+		}
+
+		return r.record(s, req)
+	})
+}
+
+// Stop writes the Cassette to disk when recording
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the Cassette %q: %+v", r.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating the directory for the Cassette %q: %+v", r.path, err)
+	}
+
+	if err := os.WriteFile(r.path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing the Cassette %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) record(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the request body: %+v", err)
+	}
+
+	resp, err := s.Do(req)
+	if err != nil || resp == nil {
+		// there's no response to replay, so there's nothing to record
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the response body: %+v", err)
+	}
+
+	// secrets are redacted from the Cassette, however the live response is returned as-is
+	secretOperation := isSecretOperation(req.URL.String())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    redactValue(r.sanitize(req.URL.String())),
+			Body:   redactBody(r.sanitize(requestBody), false),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header, r.sanitize),
+			Body:       redactBody(r.sanitize(responseBody), secretOperation),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	url := req.URL.String()
+
+	// requests are matched to the first Interaction with the same Method and URL which hasn't been replayed yet,
+	// falling back to the last matching Interaction (for example when polling for longer than during the recording)
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Request.Method != req.Method || interaction.Request.URL != url {
+			continue
+		}
+
+		match = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("no recorded Interaction was found in %q for %s %s", r.path, req.Method, url)
+	}
+	r.replayed[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	headers := recorded.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	// there's no need to wait when replaying
+	headers.Del("Retry-After")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(bytes.NewBufferString(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) sanitize(input string) string {
+	for _, s := range r.sanitizers {
+		input = s.regex.ReplaceAllString(input, s.placeholder)
+	}
+	return input
+}
+
+// readBody reads the specified body, replacing it so that it can be read again
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	contents, err := io.ReadAll(*body)
+	if err != nil {
+		return "", err
+	}
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(contents))
+
+	return string(contents), nil
+}
@@ -0,0 +1,197 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Setenv("ARM_SUBSCRIPTION_ID", testSubscriptionId)
+	path := filepath.Join(t.TempDir(), "TestExample.json")
+
+	recorder, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorder.SetVariable("random_integer", "123")
+
+	statuses := []string{"InProgress", "Succeeded"}
+	live := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		body := `{"id": "/subscriptions/` + testSubscriptionId + `/resourceGroups/example", "status": "` + status + `"}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Retry-After": []string{"10"},
+			},
+			Body:    io.NopCloser(bytes.NewBufferString(body)),
+			Request: r,
+		}, nil
+	})
+
+	liveUri := "https://management.azure.com/subscriptions/" + testSubscriptionId + "/resourceGroups/example?api-version=2020-06-01"
+	for i := 0; i < 2; i++ {
+		resp, err := recorder.Sender(live).Do(testRequest(t, liveUri))
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		// the body must still be readable after it's been recorded
+		if body := readResponse(t, resp); !strings.Contains(body, testSubscriptionId) {
+			t.Fatalf("expected the live response to contain the Subscription ID but got %q", body)
+		}
+	}
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %+v", err)
+	}
+
+	replayer, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	if v, _ := replayer.Variable("random_integer"); v != "123" {
+		t.Fatalf("expected the variable `random_integer` to be `123` but got %q", v)
+	}
+
+	offline := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request to %s when replaying", r.URL)
+		return nil, nil
+	})
+
+	replayUri := strings.ReplaceAll(liveUri, testSubscriptionId, credentialPlaceholders["ARM_SUBSCRIPTION_ID"])
+	for _, expected := range []string{"InProgress", "Succeeded", "Succeeded"} {
+		resp, err := replayer.Sender(offline).Do(testRequest(t, replayUri))
+		if err != nil {
+			t.Fatalf("replaying request: %+v", err)
+		}
+		if v := resp.Header.Get("Retry-After"); v != "" {
+			t.Fatalf("expected the `Retry-After` header to be removed but got %q", v)
+		}
+
+		body := readResponse(t, resp)
+		if strings.Contains(body, testSubscriptionId) {
+			t.Fatalf("expected the Subscription ID to be sanitized but got %q", body)
+		}
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected the status %q but got %q", expected, body)
+		}
+	}
+
+	if _, err := replayer.Sender(offline).Do(testRequest(t, "https://management.azure.com/subscriptions/other")); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestSecrets.json")
+
+	recorder, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	const accountKey = "c2VjcmV0LWFjY291bnQta2V5LXZhbHVl"
+	const signature = "c2lnbmF0dXJl"
+	responses := map[string]string{
+		"listKeys":   `{"keys": [{"keyName": "key1", "value": "` + accountKey + `", "permissions": "FULL"}]}`,
+		"example":    `{"properties": {"primaryKey": "` + accountKey + `", "connectionString": "DefaultEndpointsProtocol=https;AccountName=example;AccountKey=` + accountKey + `", "sasUri": "https://example.blob.core.windows.net/?sv=2020-08-04\u0026sig=` + signature + `", "count": 12345678901234567890}}`,
+		"listSasUri": `"https://example.blob.core.windows.net/?sv=2020-08-04&sig=` + signature + `"`,
+	}
+	live := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		segments := strings.Split(r.URL.Path, "/")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Set-Cookie":    []string{"session=" + accountKey},
+				"Authorization": []string{"Bearer " + accountKey},
+			},
+			Body:    io.NopCloser(bytes.NewBufferString(responses[segments[len(segments)-1]])),
+			Request: r,
+		}, nil
+	})
+
+	baseUri := "https://management.azure.com/subscriptions/" + testSubscriptionId + "/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example"
+	for _, uri := range []string{baseUri + "/listKeys?api-version=2021-04-01", baseUri + "/example?api-version=2021-04-01", baseUri + "/listSasUri?api-version=2021-04-01"} {
+		resp, err := recorder.Sender(live).Do(testRequest(t, uri))
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		// the live response must be returned as-is, since the secrets are used during the live run
+		if body := readResponse(t, resp); !strings.Contains(body, accountKey) && !strings.Contains(body, signature) {
+			t.Fatalf("expected the live response to contain the secrets but got %q", body)
+		}
+	}
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading the Cassette: %+v", err)
+	}
+	cassette := string(contents)
+	for _, secret := range []string{accountKey, signature} {
+		if strings.Contains(cassette, secret) {
+			t.Fatalf("expected the secret %q to be redacted from the Cassette but got %s", secret, cassette)
+		}
+	}
+	for _, expected := range []string{"key1", "FULL", "12345678901234567890"} {
+		if !strings.Contains(cassette, expected) {
+			t.Fatalf("expected the Cassette to contain %q but got %s", expected, cassette)
+		}
+	}
+
+	// the redacted bodies must still be replayable as the same models
+	replayer, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	resp, err := replayer.Sender(live).Do(testRequest(t, baseUri+"/listKeys?api-version=2021-04-01"))
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if resp.Header.Get("Set-Cookie") != "" || resp.Header.Get("Authorization") != "" {
+		t.Fatalf("expected the `Set-Cookie` and `Authorization` headers not to be recorded but got %+v", resp.Header)
+	}
+
+	var keys struct {
+		Keys []struct {
+			KeyName string `json:"keyName"`
+			Value   string `json:"value"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(readResponse(t, resp)), &keys); err != nil {
+		t.Fatalf("deserializing the replayed response: %+v", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].KeyName != "key1" || keys.Keys[0].Value != redactedPlaceholder {
+		t.Fatalf("expected the replayed key `key1` to have a redacted value but got %+v", keys)
+	}
+}
+
+func testRequest(t *testing.T, uri string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	return req
+}
+
+func readResponse(t *testing.T, resp *http.Response) string {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	return string(body)
+}
@@ -0,0 +1,200 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redactedPlaceholder replaces secrets in the Cassettes - this is a string so that the recorded
+// JSON bodies can still be deserialized into the same models when replaying
+const redactedPlaceholder = "REDACTED"
+
+// redactedHeaders are the headers which are never recorded, since they either contain credentials
+// or aren't meaningful when replaying
+var redactedHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Set-Cookie":          {},
+	"X-Ms-Encryption-Key": {},
+}
+
+// sensitiveFields are the (case-insensitive) names of the JSON fields whose string values are redacted
+// in any request or response body
+var sensitiveFields = map[string]struct{}{
+	"accesskey":                  {},
+	"adminpassword":              {},
+	"clientsecret":               {},
+	"connectionstring":           {},
+	"keys":                       {},
+	"password":                   {},
+	"primaryconnectionstring":    {},
+	"primarykey":                 {},
+	"primarymasterkey":           {},
+	"primaryreadonlymasterkey":   {},
+	"sastoken":                   {},
+	"secondaryconnectionstring":  {},
+	"secondarykey":               {},
+	"secondarymasterkey":         {},
+	"secondaryreadonlymasterkey": {},
+	"secret":                     {},
+	"sharedaccesskey":            {},
+}
+
+// nonSensitiveFields are the (case-insensitive) names of the JSON fields which are retained in the
+// response of a secret-bearing operation, since the Provider uses these to identify the secrets
+var nonSensitiveFields = map[string]struct{}{
+	"creationtime": {},
+	"id":           {},
+	"keyname":      {},
+	"name":         {},
+	"nextlink":     {},
+	"permissions":  {},
+	"type":         {},
+}
+
+// secretOperationRegex matches the final segment of the path for operations which return secrets,
+// such as `listKeys`, `listConnectionStrings`, `listCredentials` or `regenerateKey`
+var secretOperationRegex = regexp.MustCompile(`(?i)^(list\w*(keys?|secrets?|credentials?|connectionstrings?|passwords?)|regenerate\w*)$`)
+
+// secretValueRegexes match secrets embedded within strings (for example in SAS URIs or connection strings),
+// where the first group is retained
+var secretValueRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)((?:[?&]|\\u0026)sig=)[^&"\s\\]+`),
+	regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|Password)=)[^;&"\s]+`),
+}
+
+// isSecretOperation returns whether the request is for an operation which returns secrets
+func isSecretOperation(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+
+	segments := strings.Split(strings.TrimSuffix(u.Path, "/"), "/")
+	return secretOperationRegex.MatchString(segments[len(segments)-1])
+}
+
+// redactHeaders returns a copy of the headers without those which are never recorded, redacting
+// any secrets embedded in the remaining values
+func redactHeaders(input http.Header, sanitize func(string) string) http.Header {
+	output := http.Header{}
+	for k, values := range input {
+		if _, ok := redactedHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue
+		}
+		for _, v := range values {
+			output.Add(k, redactValue(sanitize(v)))
+		}
+	}
+	return output
+}
+
+// redactBody redacts the secrets within the specified body - when the body is JSON the values of any
+// sensitive fields are redacted, and when all is true (for the responses of secret-bearing operations)
+// every string value is redacted other than those identifying the secrets
+func redactBody(body string, all bool) string {
+	if body == "" {
+		return body
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	// numbers are retained as-is, rather than being converted to (and serialized as) a float64
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return redactValue(body)
+	}
+
+	redacted, changed := redactJSON(v, all)
+	if !changed {
+		return redactValue(body)
+	}
+
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redacted); err != nil {
+		return redactValue(body)
+	}
+
+	return redactValue(strings.TrimSuffix(buf.String(), "\n"))
+}
+
+func redactJSON(input interface{}, all bool) (interface{}, bool) {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		changed := false
+		for key, value := range v {
+			name := strings.ToLower(key)
+			if _, ok := sensitiveFields[name]; ok {
+				v[key] = redactAll(value)
+				changed = true
+				continue
+			}
+			if s, ok := value.(string); ok && all {
+				if _, ok := nonSensitiveFields[name]; !ok && s != "" {
+					v[key] = redactedPlaceholder
+					changed = true
+				}
+				continue
+			}
+
+			redacted, c := redactJSON(value, all)
+			v[key] = redacted
+			changed = changed || c
+		}
+		return v, changed
+
+	case []interface{}:
+		changed := false
+		for i, value := range v {
+			redacted, c := redactJSON(value, all)
+			v[i] = redacted
+			changed = changed || c
+		}
+		return v, changed
+	}
+
+	return input, false
+}
+
+// redactAll redacts every string value within the specified value, retaining the structure
+// so that it can be deserialized into the same model when replaying
+func redactAll(input interface{}) interface{} {
+	switch v := input.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+		return redactedPlaceholder
+
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := nonSensitiveFields[strings.ToLower(key)]; ok {
+				continue
+			}
+			v[key] = redactAll(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactAll(value)
+		}
+		return v
+	}
+
+	return input
+}
+
+// redactValue redacts any secrets embedded within the specified string
+func redactValue(input string) string {
+	for _, regex := range secretValueRegexes {
+		input = regex.ReplaceAllString(input, "${1}"+redactedPlaceholder)
+	}
+	return input
+}
@@ -0,0 +1,131 @@
+package acceptance
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+)
+
+// recordingsDirectory is the directory (within the package containing the test) where the Cassettes are stored
+const recordingsDirectory = "testdata/recordings"
+
+const (
+	variableLocationPrimary   = "location_primary"
+	variableLocationSecondary = "location_secondary"
+	variableLocationTernary   = "location_ternary"
+	variableRandomInteger     = "random_integer"
+	variableRandomSeed        = "random_seed"
+	variableRandomString      = "random_string"
+)
+
+var configureEnvironmentForReplay sync.Once
+
+// startRecording records the requests made during this test into a Cassette - or when replaying, pins the
+// random values for this test to those which were recorded, so that the same requests are made
+func (td *TestData) startRecording(t *testing.T, mode recorder.Mode) {
+	path := filepath.Join(recordingsDirectory, fmt.Sprintf("%s.json", strings.ReplaceAll(t.Name(), "/", "_")))
+
+	if mode == recorder.ModeReplay {
+		configureEnvironmentForReplay.Do(func() {
+			if err := recorder.ConfigureEnvironmentForReplay(); err != nil {
+				t.Fatalf("configuring the Environment to replay recordings: %+v", err)
+			}
+		})
+	}
+
+	rec, err := recorder.New(path, mode)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			t.Skipf("Skipping since no recording exists at %q", path)
+		}
+		t.Fatalf("starting the recorder: %+v", err)
+	}
+	td.recorder = rec
+
+	t.Cleanup(func() {
+		// a recording of a failed test isn't useful, so only successful runs are persisted
+		if t.Failed() {
+			return
+		}
+		if err := rec.Stop(); err != nil {
+			t.Errorf("stopping the recorder: %+v", err)
+		}
+	})
+
+	if mode == recorder.ModeRecord {
+		seed := time.Now().UnixNano()
+		rec.SetVariable(variableLocationPrimary, td.Locations.Primary)
+		rec.SetVariable(variableLocationSecondary, td.Locations.Secondary)
+		rec.SetVariable(variableLocationTernary, td.Locations.Ternary)
+		rec.SetVariable(variableRandomInteger, strconv.Itoa(td.RandomInteger))
+		rec.SetVariable(variableRandomSeed, strconv.FormatInt(seed, 10))
+		rec.SetVariable(variableRandomString, td.RandomString)
+		td.random = rand.New(rand.NewSource(seed)) //nolint:gosec
+		return
+	}
+
+	variable := func(name string) string {
+		v, ok := rec.Variable(name)
+		if !ok {
+			t.Fatalf("the variable %q was not found in the recording %q", name, path)
+		}
+		return v
+	}
+
+	randomInteger, err := strconv.Atoi(variable(variableRandomInteger))
+	if err != nil {
+		t.Fatalf("parsing the recorded %q: %+v", variableRandomInteger, err)
+	}
+	seed, err := strconv.ParseInt(variable(variableRandomSeed), 10, 64)
+	if err != nil {
+		t.Fatalf("parsing the recorded %q: %+v", variableRandomSeed, err)
+	}
+
+	td.Locations = Regions{
+		Primary:   variable(variableLocationPrimary),
+		Secondary: variable(variableLocationSecondary),
+		Ternary:   variable(variableLocationTernary),
+	}
+	td.RandomInteger = randomInteger
+	td.RandomString = variable(variableRandomString)
+	td.random = rand.New(rand.NewSource(seed)) //nolint:gosec
+}
+
+// withRecorder ensures the requests made by the Checks within this test case (which use the shared test client)
+// are recorded (or replayed) using the recorder for this test
+func (td TestData) withRecorder(testCase resource.TestCase) resource.TestCase {
+	if td.recorder == nil {
+		return testCase
+	}
+
+	wrap := func(check resource.TestCheckFunc) resource.TestCheckFunc {
+		if check == nil {
+			return nil
+		}
+
+		return func(s *terraform.State) error {
+			return testclient.WithRecorder(td.recorder, func() error {
+				return check(s)
+			})
+		}
+	}
+
+	testCase.CheckDestroy = wrap(testCase.CheckDestroy)
+	for i, step := range testCase.Steps {
+		testCase.Steps[i].Check = wrap(step.Check)
+	}
+
+	return testCase
+}
@@ -104,6 +104,7 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 }
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
+	testCase = td.withRecorder(testCase)
 	testCase.ExternalProviders = td.externalProviders()
 	testCase.ProviderFactories = td.providers()
 
@@ -111,6 +112,7 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
+	testCase = td.withRecorder(testCase)
 	testCase.ExternalProviders = td.externalProviders()
 	testCase.ProviderFactories = td.providers()
 
@@ -120,16 +122,24 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := td.testAzureProvider()
 			return azurerm, nil
 		},
 		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := td.testAzureProvider()
 			return azurerm, nil
 		},
 	}
 }
 
+func (td TestData) testAzureProvider() *schema.Provider {
+	if td.recorder != nil {
+		return provider.TestAzureProviderWithRecorder(td.recorder)
+	}
+
+	return provider.TestAzureProvider()
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
 	return map[string]resource.ExternalProvider{
 		"azuread": {
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -49,6 +50,11 @@ func Build() (*clients.Client, error) {
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
 		}
+		if mode := recorder.ModeFromEnvironment(); mode != "" {
+			clientBuilder.Recorder = activeRecorder{
+				replaying: mode == recorder.ModeReplay,
+			}
+		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,69 @@
+package testclient
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+var (
+	// checkLock ensures only a single Test is using the (shared) client at a time when recording/replaying
+	checkLock = &sync.Mutex{}
+
+	currentRecorder     common.RequestRecorder
+	currentRecorderLock = &sync.RWMutex{}
+)
+
+// WithRecorder runs the specified function (e.g. a Check) with the requests made using the shared client
+// recorded (or replayed) by the Recorder for the Test - since the client is shared these are run one at a time
+func WithRecorder(rec common.RequestRecorder, f func() error) error {
+	checkLock.Lock()
+	defer checkLock.Unlock()
+
+	setCurrentRecorder(rec)
+	defer setCurrentRecorder(nil)
+
+	return f()
+}
+
+func setCurrentRecorder(rec common.RequestRecorder) {
+	currentRecorderLock.Lock()
+	defer currentRecorderLock.Unlock()
+
+	currentRecorder = rec
+}
+
+func getCurrentRecorder() common.RequestRecorder {
+	currentRecorderLock.RLock()
+	defer currentRecorderLock.RUnlock()
+
+	return currentRecorder
+}
+
+var _ common.RequestRecorder = activeRecorder{}
+
+// activeRecorder sends requests via the Recorder for the Test currently running a Check, see `WithRecorder`
+type activeRecorder struct {
+	replaying bool
+}
+
+func (r activeRecorder) Sender(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if rec := getCurrentRecorder(); rec != nil {
+			return rec.Sender(s).Do(req)
+		}
+
+		if r.replaying {
+			return nil, fmt.Errorf("unable to send %s %s: requests can only be replayed from within `WithRecorder`", req.Method, req.URL)
+		}
+
+		return s.Do(req)
+	})
+}
+
+func (r activeRecorder) Replaying() bool {
+	return r.replaying
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
@@ -26,6 +27,11 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	RetryOptions                common.RetryOptions
+	Tags                        tags.ProviderConfiguration
+
+	// Recorder optionally records (or replays) the requests made by the clients, used by the Acceptance Tests
+	Recorder common.RequestRecorder
 }
 
 const azureStackEnvironmentError = `
@@ -56,14 +62,22 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
 
+	replaying := builder.Recorder != nil && builder.Recorder.Replaying()
+	authConfig := *builder.AuthConfig
+	if replaying {
+		// the Object ID is looked up from Microsoft Graph, which isn't recorded
+		authConfig.GetAuthenticatedObjectID = nil
+	}
+
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	client := Client{
 		Account: account,
+		Tags:    builder.Tags,
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -110,7 +124,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	// Key Vault Endpoints
-	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
+	var keyVaultAuth autorest.Authorizer = builder.AuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
 
 	// Batch Management Endpoints
 	batchManagementAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.BatchManagementEndpoint)
@@ -118,6 +132,18 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to get authorization token for batch management endpoint: %+v", err)
 	}
 
+	if replaying {
+		// the recorded responses don't require authorization, so don't try to obtain a token
+		auth = autorest.NullAuthorizer{}
+		graphAuth = autorest.NullAuthorizer{}
+		storageAuth = autorest.NullAuthorizer{}
+		if synapseAuth != nil {
+			synapseAuth = autorest.NullAuthorizer{}
+		}
+		keyVaultAuth = autorest.NullAuthorizer{}
+		batchManagementAuth = autorest.NullAuthorizer{}
+	}
+
 	o := &common.ClientOptions{
 		SubscriptionId:              builder.AuthConfig.SubscriptionID,
 		TenantID:                    builder.AuthConfig.TenantID,
@@ -137,8 +163,14 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		Environment:                 *env,
 		Features:                    builder.Features,
+		RetryOptions:                builder.RetryOptions,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Recorder:                    builder.Recorder,
 		TokenFunc: func(endpoint string) (autorest.Authorizer, error) {
+			if replaying {
+				return autorest.NullAuthorizer{}, nil
+			}
+
 			authorizer, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("getting authorization token for endpoint %s: %+v", endpoint, err)
@@ -151,7 +183,8 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
-	if features.EnhancedValidationEnabled() {
+	// the lookups used for Enhanced Validation are skipped when recording, since these depend on the order the tests run in
+	if features.EnhancedValidationEnabled() && builder.Recorder == nil {
 		location.CacheSupportedLocations(ctx, env.ResourceManagerEndpoint)
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
 	}
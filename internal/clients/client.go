@@ -104,6 +104,7 @@ import (
 	videoAnalyzer "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoanalyzer/client"
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type Client struct {
@@ -113,6 +114,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// Tags is the Provider-level configuration for Tags (Default Tags and Ignored Tags)
+	Tags tags.ProviderConfiguration
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
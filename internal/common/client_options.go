@@ -34,8 +34,12 @@ type ClientOptions struct {
 	DisableTerraformPartnerID   bool
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
+	RetryOptions                RetryOptions
 	StorageUseAzureAD           bool
 
+	// Recorder optionally records (or replays) the requests made by the clients, used by the Acceptance Tests
+	Recorder RequestRecorder
+
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc func(endpoint string) (autorest.Authorizer, error)
 }
@@ -45,7 +49,16 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.Recorder != nil {
+		c.Sender = o.Recorder.Sender(c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	o.RetryOptions.configureClient(c)
+	if o.Recorder != nil && o.Recorder.Replaying() {
+		// there's no need to wait between polling/retrying when the responses are being replayed
+		c.PollingDelay = 0
+		c.RetryDuration = 0
+	}
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
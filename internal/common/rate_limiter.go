@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var subscriptionIdRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
+var (
+	rateLimiters     = map[rateLimiterId]*rateLimiter{}
+	rateLimitersLock = &sync.Mutex{}
+)
+
+// rateLimiterId identifies a shared rate limiter - which includes the rate, so that provider instances configured
+// with different rates for the same Subscription (e.g. using provider aliases) are each limited to their own rate
+type rateLimiterId struct {
+	key               string
+	requestsPerSecond float64
+}
+
+// rateLimiterKey returns the key used to rate limit this request - which is the Subscription ID for
+// Resource Manager requests, and the Host for other (e.g. Data Plane) requests
+func rateLimiterKey(r *http.Request) string {
+	if r.URL == nil {
+		return ""
+	}
+
+	if matches := subscriptionIdRegex.FindStringSubmatch(r.URL.Path); len(matches) == 2 {
+		return strings.ToLower(matches[1])
+	}
+
+	return strings.ToLower(r.URL.Host)
+}
+
+// rateLimiterFor returns the rate limiter for the specified key and rate, which is shared by the provider instances
+// configured with the same rate
+func rateLimiterFor(key string, requestsPerSecond float64) *rateLimiter {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	id := rateLimiterId{
+		key:               key,
+		requestsPerSecond: requestsPerSecond,
+	}
+	limiter, ok := rateLimiters[id]
+	if !ok {
+		limiter = newRateLimiter(requestsPerSecond)
+		rateLimiters[id] = limiter
+	}
+
+	return limiter
+}
+
+// rateLimiter is a token bucket which allows up to `requestsPerSecond` requests each second,
+// with a burst of up to `requestsPerSecond` requests (and at least one)
+type rateLimiter struct {
+	lock sync.Mutex
+
+	interval  time.Duration
+	maxTokens float64
+	tokens    float64
+	last      time.Time
+
+	// now is overridden in the tests
+	now func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	maxTokens := requestsPerSecond
+	if maxTokens < 1 {
+		maxTokens = 1
+	}
+
+	return &rateLimiter{
+		interval:  time.Duration(float64(time.Second) / requestsPerSecond),
+		maxTokens: maxTokens,
+		tokens:    maxTokens,
+		last:      time.Now(),
+		now:       time.Now,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	elapsed := now.Sub(l.last)
+	l.last = now
+
+	l.tokens += float64(elapsed) / float64(l.interval)
+	if l.tokens > l.maxTokens {
+		l.tokens = l.maxTokens
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// Wait blocks until a request can be made, returning how long it waited
+func (l *rateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	delay := l.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		// return the token, since this request won't be made
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+		return delay, ctx.Err()
+	}
+}
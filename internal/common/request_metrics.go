@@ -0,0 +1,173 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// requestMetrics tracks the requests made by all of the autorest clients configured via ClientOptions
+var requestMetrics = &requestMetricsCounter{}
+
+type requestMetricsCounter struct {
+	requests           int64
+	retryableResponses int64
+	throttled          int64
+	rateLimitedWait    int64
+}
+
+// RequestMetrics is a summary of the requests made by the autorest clients
+type RequestMetrics struct {
+	// Requests is the number of HTTP requests sent (including retries)
+	Requests int64
+
+	// RetryableResponses is the number of responses with a status code which is retried (e.g. 429 or 5xx)
+	RetryableResponses int64
+
+	// Throttled is the number of responses with a 429 (Too Many Requests) status code
+	Throttled int64
+
+	// RateLimitedWait is the total duration requests have waited for the client-side rate limiter
+	RateLimitedWait time.Duration
+}
+
+type requestMetricsContextKey struct{}
+
+// operationRequestMetrics maps the ResourceData for each in-progress operation to the context tracking its requests,
+// since most Resources derive the context for their requests from the StopContext rather than the operation context
+var operationRequestMetrics sync.Map
+
+// CurrentRequestMetrics returns the totals for the requests made by this Provider so far
+func CurrentRequestMetrics() RequestMetrics {
+	return requestMetrics.current()
+}
+
+// ContextWithRequestMetrics returns a copy of the context which tracks the requests made using it (or any context
+// derived from it) separately to the requests made by other operations - which can be retrieved using
+// RequestMetricsFromContext
+func ContextWithRequestMetrics(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestMetricsContextKey{}, &requestMetricsCounter{})
+}
+
+// ContextWithRequestMetricsFrom returns a copy of the context which tracks the requests made using it alongside the
+// requests made using the source context (a context returned from ContextWithRequestMetrics)
+func ContextWithRequestMetricsFrom(ctx context.Context, source context.Context) context.Context {
+	counter := requestMetricsCounterFromContext(source)
+	if counter == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, requestMetricsContextKey{}, counter)
+}
+
+// TrackRequestMetricsForResourceData tracks the requests made using a context returned from
+// ContextWithRequestMetricsForResourceData for the specified ResourceData alongside the requests made using the source
+// context (a context returned from ContextWithRequestMetrics) - until the returned function is called once the operation
+// has completed
+func TrackRequestMetricsForResourceData(d *pluginsdk.ResourceData, source context.Context) func() {
+	if d == nil || requestMetricsCounterFromContext(source) == nil {
+		return func() {}
+	}
+
+	operationRequestMetrics.Store(d, source)
+	return func() {
+		operationRequestMetrics.Delete(d)
+	}
+}
+
+// ContextWithRequestMetricsForResourceData returns a copy of the context which tracks the requests made using it alongside
+// the other requests made by the operation for the specified ResourceData, when these are being tracked
+func ContextWithRequestMetricsForResourceData(ctx context.Context, d *pluginsdk.ResourceData) context.Context {
+	source, ok := operationRequestMetrics.Load(d)
+	if !ok {
+		return ctx
+	}
+
+	return ContextWithRequestMetricsFrom(ctx, source.(context.Context))
+}
+
+// RequestMetricsFromContext returns the totals for the requests made using a context returned from
+// ContextWithRequestMetrics so far - or an empty summary when the requests made using this context aren't tracked
+func RequestMetricsFromContext(ctx context.Context) RequestMetrics {
+	if counter := requestMetricsCounterFromContext(ctx); counter != nil {
+		return counter.current()
+	}
+
+	return RequestMetrics{}
+}
+
+func requestMetricsCounterFromContext(ctx context.Context) *requestMetricsCounter {
+	if ctx == nil {
+		return nil
+	}
+
+	counter, _ := ctx.Value(requestMetricsContextKey{}).(*requestMetricsCounter)
+	return counter
+}
+
+// Since returns the difference between these metrics and an earlier snapshot
+func (m RequestMetrics) Since(earlier RequestMetrics) RequestMetrics {
+	return RequestMetrics{
+		Requests:           m.Requests - earlier.Requests,
+		RetryableResponses: m.RetryableResponses - earlier.RetryableResponses,
+		Throttled:          m.Throttled - earlier.Throttled,
+		RateLimitedWait:    m.RateLimitedWait - earlier.RateLimitedWait,
+	}
+}
+
+func (m RequestMetrics) String() string {
+	return fmt.Sprintf("%d requests, %d retryable responses (%d throttled), %s waiting on the rate limiter", m.Requests, m.RetryableResponses, m.Throttled, m.RateLimitedWait)
+}
+
+// recordRequestResponse records the response for a request in the totals for this Provider, and for the operation which
+// made the request when this is tracked via the context for the request
+func recordRequestResponse(r *http.Request, resp *http.Response) {
+	requestMetrics.recordResponse(resp)
+	if counter := requestMetricsCounterFromContext(r.Context()); counter != nil {
+		counter.recordResponse(resp)
+	}
+}
+
+// recordRequestRateLimited records the time a request waited for the rate limiter in the totals for this Provider,
+// and for the operation which made the request when this is tracked via the context for the request
+func recordRequestRateLimited(r *http.Request, waited time.Duration) {
+	requestMetrics.recordRateLimited(waited)
+	if counter := requestMetricsCounterFromContext(r.Context()); counter != nil {
+		counter.recordRateLimited(waited)
+	}
+}
+
+func (c *requestMetricsCounter) current() RequestMetrics {
+	return RequestMetrics{
+		Requests:           atomic.LoadInt64(&c.requests),
+		RetryableResponses: atomic.LoadInt64(&c.retryableResponses),
+		Throttled:          atomic.LoadInt64(&c.throttled),
+		RateLimitedWait:    time.Duration(atomic.LoadInt64(&c.rateLimitedWait)),
+	}
+}
+
+func (c *requestMetricsCounter) recordResponse(resp *http.Response) {
+	atomic.AddInt64(&c.requests, 1)
+	if resp == nil {
+		return
+	}
+
+	if autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+		atomic.AddInt64(&c.retryableResponses, 1)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&c.throttled, 1)
+	}
+}
+
+func (c *requestMetricsCounter) recordRateLimited(waited time.Duration) {
+	if waited > 0 {
+		atomic.AddInt64(&c.rateLimitedWait, int64(waited))
+	}
+}
@@ -0,0 +1,14 @@
+package common
+
+import "github.com/Azure/go-autorest/autorest"
+
+// RequestRecorder records (or replays) the requests made by the autorest clients, which allows the
+// Acceptance Tests to be run without access to Azure
+type RequestRecorder interface {
+	// Sender returns a Sender which records the requests sent via the specified Sender - or when
+	// replaying, which returns the recorded responses without sending the request
+	Sender(sender autorest.Sender) autorest.Sender
+
+	// Replaying returns whether responses are being replayed, rather than sent to Azure
+	Replaying() bool
+}
@@ -0,0 +1,106 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type BackoffStrategy string
+
+const (
+	// BackoffStrategyConstant waits for the Retry Delay between each retry
+	BackoffStrategyConstant BackoffStrategy = "Constant"
+
+	// BackoffStrategyExponential doubles the Retry Delay after each retry
+	BackoffStrategyExponential BackoffStrategy = "Exponential"
+)
+
+func PossibleValuesForBackoffStrategy() []string {
+	return []string{
+		string(BackoffStrategyConstant),
+		string(BackoffStrategyExponential),
+	}
+}
+
+// RetryOptions defines how requests made by the autorest clients should be retried and throttled
+type RetryOptions struct {
+	// MaxRetries is the maximum number of times a request should be retried when a retryable
+	// status code (e.g. 429 or 5xx) is returned
+	MaxRetries int
+
+	// BackoffStrategy defines how long to wait between retries when the API doesn't return a `Retry-After` header
+	BackoffStrategy BackoffStrategy
+
+	// RetryDelay is the (initial) duration to wait between retries
+	RetryDelay time.Duration
+
+	// MaxRequestsPerSecond is the maximum number of requests which should be sent to each Subscription
+	// per second - where 0 means that requests aren't rate limited
+	MaxRequestsPerSecond float64
+}
+
+// DefaultRetryOptions returns the RetryOptions matching the default autorest behaviour
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxRetries:      autorest.DefaultRetryAttempts,
+		BackoffStrategy: BackoffStrategyExponential,
+		RetryDelay:      autorest.DefaultRetryDuration,
+	}
+}
+
+func (o RetryOptions) configureClient(c *autorest.Client) {
+	// the retry options are optional (e.g. when building clients for the acceptance tests)
+	defaults := DefaultRetryOptions()
+	if o.MaxRetries < 1 {
+		o.MaxRetries = defaults.MaxRetries
+	}
+	if o.BackoffStrategy == "" {
+		o.BackoffStrategy = defaults.BackoffStrategy
+	}
+	if o.RetryDelay <= 0 {
+		o.RetryDelay = defaults.RetryDelay
+	}
+
+	// NOTE: the retries themselves are performed by autorest (via `azure.DoRetryWithRegistration` and the
+	// Long Running Operation pollers) - which honour the `Retry-After` header, so the Send Decorator below
+	// only needs to apply the Backoff Strategy and rate limiting
+	c.RetryAttempts = o.MaxRetries
+	c.RetryDuration = o.RetryDelay
+	c.Sender = autorest.DecorateSender(c.Sender, o.withThrottling())
+}
+
+func (o RetryOptions) withThrottling() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if o.MaxRequestsPerSecond > 0 {
+				key := rateLimiterKey(r)
+				waited, err := rateLimiterFor(key, o.MaxRequestsPerSecond).Wait(r.Context())
+				recordRequestRateLimited(r, waited)
+				if err != nil {
+					return nil, fmt.Errorf("waiting for the rate limiter for %q: %+v", key, err)
+				}
+				if waited > 0 {
+					log.Printf("[DEBUG] Request to %s was rate limited for %s", r.URL, waited)
+				}
+			}
+
+			resp, err := s.Do(r)
+			recordRequestResponse(r, resp)
+
+			if resp != nil && autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+				// autorest backs off exponentially when there's no `Retry-After` header - so to use a Constant
+				// Backoff Strategy we instead specify the delay as the `Retry-After` header, which autorest honours
+				if o.BackoffStrategy == BackoffStrategyConstant && resp.Header.Get("Retry-After") == "" {
+					resp.Header.Set("Retry-After", strconv.Itoa(int(o.RetryDelay.Seconds())))
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
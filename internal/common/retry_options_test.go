@@ -0,0 +1,198 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func senderReturningStatus(statusCode int) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Request:    r,
+		}, nil
+	})
+}
+
+func testRequest() *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	return req
+}
+
+func TestRateLimiterKey(t *testing.T) {
+	testData := []struct {
+		url      string
+		expected string
+	}{
+		{
+			url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1?api-version=2020-06-01",
+			expected: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			url:      "https://management.azure.com/Subscriptions/ABCDEF/providers/Microsoft.Web",
+			expected: "abcdef",
+		},
+		{
+			url:      "https://account1.blob.core.windows.net/container1/blob1",
+			expected: "account1.blob.core.windows.net",
+		},
+	}
+
+	for _, v := range testData {
+		u, err := url.Parse(v.url)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.url, err)
+		}
+
+		actual := rateLimiterKey(&http.Request{URL: u})
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q for %q", v.expected, actual, v.url)
+		}
+	}
+}
+
+func TestRateLimiterFor(t *testing.T) {
+	key := "rate-limiter-for"
+
+	if rateLimiterFor(key, 5) != rateLimiterFor(key, 5) {
+		t.Fatalf("expected a single rate limiter for the same key and rate")
+	}
+	if rateLimiterFor(key, 5) == rateLimiterFor(key, 10) {
+		t.Fatalf("expected a separate rate limiter for each rate")
+	}
+	if rateLimiterFor(key, 5) == rateLimiterFor("other-"+key, 5) {
+		t.Fatalf("expected a separate rate limiter for each key")
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(2)
+	limiter.last = now
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	// the burst allows the first 2 requests immediately
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("expected request %d not to be delayed but got %s", i, delay)
+		}
+	}
+
+	if delay := limiter.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("expected the third request to be delayed by 500ms but got %s", delay)
+	}
+
+	now = now.Add(5 * time.Second)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Fatalf("expected the bucket to have refilled but got a delay of %s", delay)
+	}
+}
+
+func TestWithThrottlingConstantBackoff(t *testing.T) {
+	sender := senderReturningStatus(http.StatusTooManyRequests)
+
+	options := RetryOptions{
+		MaxRetries:      1,
+		BackoffStrategy: BackoffStrategyConstant,
+		RetryDelay:      15 * time.Second,
+	}
+	before := CurrentRequestMetrics()
+
+	resp, err := autorest.DecorateSender(sender, options.withThrottling()).Do(testRequest())
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "15" {
+		t.Fatalf("expected the `Retry-After` header to be `15` but got %q", v)
+	}
+
+	metrics := CurrentRequestMetrics().Since(before)
+	if metrics.Requests != 1 || metrics.RetryableResponses != 1 || metrics.Throttled != 1 {
+		t.Fatalf("expected 1 throttled request but got %s", metrics)
+	}
+}
+
+func TestWithThrottlingRequestMetricsPerContext(t *testing.T) {
+	sender := autorest.DecorateSender(senderReturningStatus(http.StatusTooManyRequests), DefaultRetryOptions().withThrottling())
+
+	first := ContextWithRequestMetrics(context.TODO())
+	second := ContextWithRequestMetrics(context.TODO())
+	derived, cancel := context.WithCancel(ContextWithRequestMetricsFrom(context.TODO(), first))
+	defer cancel()
+
+	for _, ctx := range []context.Context{first, derived, context.TODO()} {
+		if _, err := sender.Do(testRequest().WithContext(ctx)); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	if metrics := RequestMetricsFromContext(first); metrics.Requests != 2 || metrics.Throttled != 2 {
+		t.Fatalf("expected 2 throttled requests for the first context but got %s", metrics)
+	}
+	if metrics := RequestMetricsFromContext(second); metrics.Requests != 0 {
+		t.Fatalf("expected no requests for the second context but got %s", metrics)
+	}
+	if metrics := RequestMetricsFromContext(context.TODO()); metrics.Requests != 0 {
+		t.Fatalf("expected no requests for an untracked context but got %s", metrics)
+	}
+}
+
+func TestWithThrottlingRequestMetricsForResourceData(t *testing.T) {
+	sender := autorest.DecorateSender(senderReturningStatus(http.StatusTooManyRequests), DefaultRetryOptions().withThrottling())
+
+	d := &pluginsdk.ResourceData{}
+	operation := ContextWithRequestMetrics(context.TODO())
+	untrack := TrackRequestMetricsForResourceData(d, operation)
+
+	if _, err := sender.Do(testRequest().WithContext(ContextWithRequestMetricsForResourceData(context.TODO(), d))); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	untrack()
+
+	// requests made once the operation has completed are no longer tracked
+	if _, err := sender.Do(testRequest().WithContext(ContextWithRequestMetricsForResourceData(context.TODO(), d))); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if metrics := RequestMetricsFromContext(operation); metrics.Requests != 1 || metrics.Throttled != 1 {
+		t.Fatalf("expected 1 throttled request for the operation but got %s", metrics)
+	}
+}
+
+func TestWithThrottlingExponentialBackoff(t *testing.T) {
+	sender := senderReturningStatus(http.StatusServiceUnavailable)
+
+	options := DefaultRetryOptions()
+	resp, err := autorest.DecorateSender(sender, options.withThrottling()).Do(testRequest())
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		t.Fatalf("expected no `Retry-After` header but got %q", v)
+	}
+}
+
+func TestRetryOptionsConfigureClientDefaults(t *testing.T) {
+	client := autorest.NewClientWithUserAgent("")
+	client.RetryAttempts = 0
+
+	RetryOptions{}.configureClient(&client)
+
+	if client.RetryAttempts != autorest.DefaultRetryAttempts {
+		t.Fatalf("expected %d retry attempts but got %d", autorest.DefaultRetryAttempts, client.RetryAttempts)
+	}
+	if client.RetryDuration != autorest.DefaultRetryDuration {
+		t.Fatalf("expected a retry duration of %s but got %s", autorest.DefaultRetryDuration, client.RetryDuration)
+	}
+}
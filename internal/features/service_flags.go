@@ -0,0 +1,83 @@
+package features
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// FeatureFlag defines a Feature Flag which a Service exposes within a block
+// in the `features` block of the Provider
+type FeatureFlag struct {
+	// Name is the name of this Feature Flag within the block, e.g. `purge_soft_delete_on_destroy`
+	Name string
+
+	// Type is the type of this Feature Flag - either TypeBool, TypeInt or TypeString
+	Type pluginsdk.ValueType
+
+	// Default is the value used when this Feature Flag isn't specified, which must match the Type
+	Default interface{}
+
+	// Description is a human-readable description of this Feature Flag
+	Description string
+}
+
+// Validate ensures that the Default for this Feature Flag matches the Type
+func (f FeatureFlag) Validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("a Feature Flag must have a Name")
+	}
+
+	valid := false
+	switch f.Type {
+	case pluginsdk.TypeBool:
+		_, valid = f.Default.(bool)
+	case pluginsdk.TypeInt:
+		_, valid = f.Default.(int)
+	case pluginsdk.TypeString:
+		_, valid = f.Default.(string)
+	default:
+		return fmt.Errorf("the Feature Flag %q has an unsupported Type %q", f.Name, f.Type.String())
+	}
+
+	if !valid {
+		return fmt.Errorf("the Default for the Feature Flag %q must be a %s but got %T", f.Name, f.Type.String(), f.Default)
+	}
+
+	return nil
+}
+
+// ServiceFeatures contains the values for the Feature Flags registered by Services - keyed by
+// the name of the block within the `features` block, and then the name of the Feature Flag
+type ServiceFeatures map[string]map[string]interface{}
+
+// Bool returns the value of the Boolean Feature Flag `name` within the block `block`
+func (f ServiceFeatures) Bool(block, name string) bool {
+	v, _ := f.value(block, name).(bool)
+	return v
+}
+
+// Int returns the value of the Integer Feature Flag `name` within the block `block`
+func (f ServiceFeatures) Int(block, name string) int {
+	v, _ := f.value(block, name).(int)
+	return v
+}
+
+// String returns the value of the String Feature Flag `name` within the block `block`
+func (f ServiceFeatures) String(block, name string) string {
+	v, _ := f.value(block, name).(string)
+	return v
+}
+
+func (f ServiceFeatures) value(block, name string) interface{} {
+	if f == nil {
+		return nil
+	}
+
+	values, ok := f[block]
+	if !ok {
+		return nil
+	}
+
+	return values[name]
+}
@@ -10,6 +10,9 @@ type UserFeatures struct {
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
+
+	// Services contains the values of the Feature Flags registered by Services
+	Services ServiceFeatures
 }
 
 type CognitiveAccountFeatures struct {
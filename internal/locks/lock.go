@@ -1,5 +1,11 @@
 package locks
 
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -7,27 +13,85 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the lock can't be acquired before
+// the context is cancelled - such as when the timeout for this operation (from `internal/timeouts`)
+// has been reached. The caller must call UnlockByID when this returns without an error.
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	armMutexKV.Lock(NameKey(name, resourceType))
+}
+
+// ByNameWithContext locks the specified Name for this Resource Type, returning an error if the lock
+// can't be acquired before the context is cancelled. The caller must call UnlockByName when this
+// returns without an error.
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	return armMutexKV.LockWithContext(ctx, NameKey(name, resourceType))
+}
+
+// NameKey returns the key used to lock the specified Name for this Resource Type, which can
+// be combined with Resource IDs when locking multiple keys via MultipleWithContext
+func NameKey(name string, resourceType string) string {
+	return resourceType + "." + name
 }
 
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
+	for _, key := range orderedKeys(keys) {
+		armMutexKV.Lock(key)
 	}
 }
 
+// MultipleByNameWithContext locks each of the specified Names for this Resource Type in a consistent
+// order, returning an error if all of the locks can't be acquired before the context is cancelled.
+// The caller must call UnlockMultipleByName when this returns without an error.
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+
+	return MultipleWithContext(ctx, keys)
+}
+
+// MultipleWithContext locks each of the specified keys (Resource IDs, or Names via NameKey), returning
+// an error if all of the locks can't be acquired before the context is cancelled.
+//
+// The keys are deduplicated and always acquired in the same (sorted) order, which ensures that two operations
+// locking an overlapping set of keys via this method can't deadlock one another. Keys which are also locked
+// individually elsewhere in a fixed order (e.g. a Virtual Network before its Subnets) must instead be locked
+// in that order. Should any lock not be acquired, any locks already acquired are released.
+// The caller must call UnlockMultiple when this returns without an error.
+func MultipleWithContext(ctx context.Context, keys []string) error {
+	acquired := make([]string, 0)
+	for _, key := range orderedKeys(keys) {
+		if err := armMutexKV.LockWithContext(ctx, key); err != nil {
+			for _, v := range acquired {
+				armMutexKV.Unlock(v)
+			}
+
+			return fmt.Errorf("acquiring locks: %+v", err)
+		}
+
+		acquired = append(acquired, key)
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(NameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
@@ -37,3 +101,18 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+// UnlockMultiple unlocks each of the keys locked via MultipleWithContext
+func UnlockMultiple(keys []string) {
+	for _, key := range orderedKeys(keys) {
+		armMutexKV.Unlock(key)
+	}
+}
+
+// orderedKeys returns the unique keys in a consistent order, so that multiple keys are always
+// acquired in the same order regardless of the order they're specified in
+func orderedKeys(keys []string) []string {
+	output := removeDuplicatesFromStringArray(keys)
+	sort.Strings(output)
+	return output
+}
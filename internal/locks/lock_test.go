@@ -0,0 +1,140 @@
+package locks
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockWithContextTimesOut(t *testing.T) {
+	mutexKV := NewMutexKV()
+	mutexKV.Lock("example")
+	defer mutexKV.Unlock("example")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mutexKV.LockWithContext(ctx, "example")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	// the error should include who's holding the lock
+	if !strings.Contains(err.Error(), "held by") || strings.Contains(err.Error(), `"unknown"`) {
+		t.Fatalf("expected the error to contain the holder of the lock but got: %+v", err)
+	}
+}
+
+func TestLockWithContextAcquiresOnceUnlocked(t *testing.T) {
+	mutexKV := NewMutexKV()
+	mutexKV.Lock("example")
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		mutexKV.Unlock("example")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := mutexKV.LockWithContext(ctx, "example"); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	mutexKV.Unlock("example")
+}
+
+func TestUnlockWhenNotLockedPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic but didn't get one")
+		}
+	}()
+
+	NewMutexKV().Unlock("example")
+}
+
+func TestOrderedKeys(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []string
+		Expected []string
+	}{
+		{
+			Name:     "unordered",
+			Input:    []string{"azurerm_subnet.subnet1", "azurerm_network_security_group.nsg1", "azurerm_route_table.rt1"},
+			Expected: []string{"azurerm_network_security_group.nsg1", "azurerm_route_table.rt1", "azurerm_subnet.subnet1"},
+		},
+		{
+			Name:     "duplicates",
+			Input:    []string{"b", "a", "b"},
+			Expected: []string{"a", "b"},
+		},
+		{
+			Name:     "empty",
+			Input:    []string{},
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := orderedKeys(tc.Input); !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestMultipleWithContextDoesNotDeadlock(t *testing.T) {
+	first := []string{"TestMultipleWithContext.subnet", "TestMultipleWithContext.nsg", "TestMultipleWithContext.route_table"}
+	second := []string{"TestMultipleWithContext.route_table", "TestMultipleWithContext.subnet", "TestMultipleWithContext.nsg"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		for _, keys := range [][]string{first, second} {
+			wg.Add(1)
+			go func(keys []string) {
+				defer wg.Done()
+				if err := MultipleWithContext(ctx, keys); err != nil {
+					errs <- err
+					return
+				}
+				UnlockMultiple(keys)
+			}(keys)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
+
+func TestMultipleWithContextReleasesAcquiredLocksOnError(t *testing.T) {
+	ByID("TestMultipleWithContextReleases.b")
+	defer UnlockByID("TestMultipleWithContextReleases.b")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	keys := []string{"TestMultipleWithContextReleases.a", "TestMultipleWithContextReleases.b"}
+	if err := MultipleWithContext(ctx, keys); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	// `a` should have been released, so we should be able to lock it
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	if err := ByIDWithContext(ctx2, "TestMultipleWithContextReleases.a"); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	UnlockByID("TestMultipleWithContextReleases.a")
+}
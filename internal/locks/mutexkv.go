@@ -1,48 +1,146 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"strings"
 	"sync"
+	"time"
 )
 
+// waitingLogInterval is how often we log that we're still waiting to acquire a lock
+var waitingLogInterval = 30 * time.Second
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a mutex which can be acquired with a context, and which tracks who's holding it
+type keyMutex struct {
+	// sem is a semaphore with a capacity of 1 - sending to it acquires the lock, receiving releases it
+	sem chan struct{}
+
+	lock       sync.Mutex
+	holder     string
+	acquiredAt time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a Background context can't be cancelled, so this can't return an error
+	_ = m.lockWithContext(context.Background(), key, callerName())
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context is
+// cancelled (or it's deadline passes) before the lock can be acquired. Caller is responsible
+// for calling Unlock for the same key when this returns without an error
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	return m.lockWithContext(ctx, key, callerName())
+}
+
+func (m *mutexKV) lockWithContext(ctx context.Context, key string, holder string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	mutex := m.get(key)
+
+	ticker := time.NewTicker(waitingLogInterval)
+	defer ticker.Stop()
+
+	waitingSince := time.Now()
+	for {
+		select {
+		case mutex.sem <- struct{}{}:
+			mutex.setHolder(holder)
+			log.Printf("[DEBUG] Locked %q (waited %s)", key, time.Since(waitingSince).Round(time.Millisecond))
+			return nil
+
+		case <-ticker.C:
+			currentHolder, heldFor := mutex.currentHolder()
+			log.Printf("[DEBUG] Still waiting to lock %q - held by %q for %s", key, currentHolder, heldFor)
+
+		case <-ctx.Done():
+			currentHolder, heldFor := mutex.currentHolder()
+			return fmt.Errorf("waiting to lock %q (held by %q for %s): %+v", key, currentHolder, heldFor, ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	mutex := m.get(key)
+	holder, heldFor := mutex.currentHolder()
+	mutex.setHolder("")
+
+	select {
+	case <-mutex.sem:
+	default:
+		panic(fmt.Sprintf("unlocking %q which isn't locked", key))
+	}
+	log.Printf("[DEBUG] Unlocked %q (held by %q for %s)", key, holder, heldFor)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			sem: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+func (k *keyMutex) setHolder(holder string) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.holder = holder
+	k.acquiredAt = time.Now()
+}
+
+func (k *keyMutex) currentHolder() (string, time.Duration) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.holder == "" {
+		return "unknown", 0
+	}
+
+	return k.holder, time.Since(k.acquiredAt).Round(time.Millisecond)
+}
+
+// callerName returns the name of the first function outside of this package in the call stack,
+// which is used to identify who's holding a lock
+func callerName() string {
+	pcs := make([]uintptr, 10)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/internal/locks.") {
+			return frame.Function
+		}
+		if !more {
+			break
+		}
+	}
+
+	return "unknown"
+}
+
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -211,6 +214,14 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 		}
 	}
 
+	for blockName, block := range schemaServiceFeatures(serviceFeatureFlags()) {
+		if _, exists := featuresMap[blockName]; exists {
+			panic(fmt.Sprintf("the features block %q registered by a Service is already defined by the Provider", blockName))
+		}
+
+		featuresMap[blockName] = block
+	}
+
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
 	// rather than doing it as a big-bang and breaking all open PR's
 	if supportLegacyTestSuite {
@@ -237,12 +248,15 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 func expandFeatures(input []interface{}) features.UserFeatures {
 	// these are the defaults if omitted from the config
 	featuresMap := features.Default()
+	serviceFlags := serviceFeatureFlags()
 
 	if len(input) == 0 || input[0] == nil {
+		featuresMap.Services = expandServiceFeatures(map[string]interface{}{}, serviceFlags)
 		return featuresMap
 	}
 
 	val := input[0].(map[string]interface{})
+	featuresMap.Services = expandServiceFeatures(val, serviceFlags)
 
 	if raw, ok := val["api_management"]; ok {
 		items := raw.([]interface{})
@@ -382,3 +396,104 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 
 	return featuresMap
 }
+
+// serviceFeatureFlags returns the Feature Flags registered by the Typed and Untyped Services,
+// keyed by the name of the block within the `features` block
+func serviceFeatureFlags() map[string][]features.FeatureFlag {
+	registrations := make([]sdk.ServiceRegistrationWithFeatureFlags, 0)
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithFeatureFlags); ok {
+			registrations = append(registrations, v)
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithFeatureFlags); ok {
+			registrations = append(registrations, v)
+		}
+	}
+
+	out := make(map[string][]features.FeatureFlag)
+	registeredBy := make(map[string]string)
+	for _, registration := range registrations {
+		for blockName, flags := range registration.FeatureFlags() {
+			// Services which are both Typed and Untyped will be returned twice
+			if existing, ok := registeredBy[blockName]; ok {
+				if existing == registration.Name() {
+					continue
+				}
+
+				panic(fmt.Sprintf("the features block %q is registered by both %q and %q", blockName, existing, registration.Name()))
+			}
+
+			registeredBy[blockName] = registration.Name()
+			out[blockName] = flags
+		}
+	}
+
+	return out
+}
+
+func schemaServiceFeatures(input map[string][]features.FeatureFlag) map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+
+	for blockName, flags := range input {
+		blockSchema := make(map[string]*pluginsdk.Schema)
+		for _, flag := range flags {
+			if err := flag.Validate(); err != nil {
+				panic(fmt.Sprintf("validating the Feature Flags for the features block %q: %+v", blockName, err))
+			}
+
+			if _, exists := blockSchema[flag.Name]; exists {
+				panic(fmt.Sprintf("the Feature Flag %q is defined multiple times within the features block %q", flag.Name, blockName))
+			}
+
+			blockSchema[flag.Name] = &pluginsdk.Schema{
+				Type:        flag.Type,
+				Optional:    true,
+				Default:     flag.Default,
+				Description: flag.Description,
+			}
+		}
+
+		out[blockName] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: blockSchema,
+			},
+		}
+	}
+
+	return out
+}
+
+func expandServiceFeatures(input map[string]interface{}, flags map[string][]features.FeatureFlag) features.ServiceFeatures {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	out := make(features.ServiceFeatures)
+	for blockName, blockFlags := range flags {
+		values := make(map[string]interface{})
+		for _, flag := range blockFlags {
+			values[flag.Name] = flag.Default
+		}
+
+		if raw, ok := input[blockName]; ok {
+			items := raw.([]interface{})
+			if len(items) > 0 && items[0] != nil {
+				blockRaw := items[0].(map[string]interface{})
+				for _, flag := range blockFlags {
+					if v, ok := blockRaw[flag.Name]; ok {
+						values[flag.Name] = v
+					}
+				}
+			}
+		}
+
+		out[blockName] = values
+	}
+
+	return out
+}
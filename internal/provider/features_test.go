@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestExpandFeatures(t *testing.T) {
@@ -56,6 +57,19 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Services: features.ServiceFeatures{
+					"app_configuration": {
+						"purge_soft_delete_on_destroy": false,
+						"recover_soft_deleted":         true,
+					},
+					"recovery_service": {
+						"purge_protected_items_from_vault_on_destroy": false,
+						"recover_soft_deleted_backup_protected_vm":    true,
+					},
+					"storage": {
+						"recover_soft_deleted_containers": false,
+					},
+				},
 			},
 		},
 		{
@@ -67,6 +81,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
@@ -94,11 +114,22 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": true,
 						},
 					},
+					"recovery_service": []interface{}{
+						map[string]interface{}{
+							"purge_protected_items_from_vault_on_destroy": true,
+							"recover_soft_deleted_backup_protected_vm":    true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -146,6 +177,19 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Services: features.ServiceFeatures{
+					"app_configuration": {
+						"purge_soft_delete_on_destroy": true,
+						"recover_soft_deleted":         true,
+					},
+					"recovery_service": {
+						"purge_protected_items_from_vault_on_destroy": true,
+						"recover_soft_deleted_backup_protected_vm":    true,
+					},
+					"storage": {
+						"recover_soft_deleted_containers": true,
+					},
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -170,6 +214,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
@@ -197,11 +247,22 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": false,
 						},
 					},
+					"recovery_service": []interface{}{
+						map[string]interface{}{
+							"purge_protected_items_from_vault_on_destroy": false,
+							"recover_soft_deleted_backup_protected_vm":    false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -249,6 +310,19 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Services: features.ServiceFeatures{
+					"app_configuration": {
+						"purge_soft_delete_on_destroy": false,
+						"recover_soft_deleted":         false,
+					},
+					"recovery_service": {
+						"purge_protected_items_from_vault_on_destroy": false,
+						"recover_soft_deleted_backup_protected_vm":    false,
+					},
+					"storage": {
+						"recover_soft_deleted_containers": false,
+					},
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -995,3 +1069,96 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandServiceFeatures(t *testing.T) {
+	flags := map[string][]features.FeatureFlag{
+		"example": {
+			{
+				Name:    "purge_soft_delete_on_destroy",
+				Type:    pluginsdk.TypeBool,
+				Default: true,
+			},
+			{
+				Name:    "retention_in_days",
+				Type:    pluginsdk.TypeInt,
+				Default: 7,
+			},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected features.ServiceFeatures
+	}{
+		{
+			Name:  "Empty Block",
+			Input: map[string]interface{}{},
+			Expected: features.ServiceFeatures{
+				"example": {
+					"purge_soft_delete_on_destroy": true,
+					"retention_in_days":            7,
+				},
+			},
+		},
+		{
+			Name: "Nil Block",
+			Input: map[string]interface{}{
+				"example": []interface{}{nil},
+			},
+			Expected: features.ServiceFeatures{
+				"example": {
+					"purge_soft_delete_on_destroy": true,
+					"retention_in_days":            7,
+				},
+			},
+		},
+		{
+			Name: "Overridden",
+			Input: map[string]interface{}{
+				"example": []interface{}{
+					map[string]interface{}{
+						"purge_soft_delete_on_destroy": false,
+						"retention_in_days":            30,
+					},
+				},
+			},
+			Expected: features.ServiceFeatures{
+				"example": {
+					"purge_soft_delete_on_destroy": false,
+					"retention_in_days":            30,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandServiceFeatures(testCase.Input, flags)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+
+		if result.Bool("example", "purge_soft_delete_on_destroy") != testCase.Expected["example"]["purge_soft_delete_on_destroy"] {
+			t.Fatalf("Expected `Bool` to return the value of `purge_soft_delete_on_destroy`")
+		}
+	}
+}
+
+func TestSchemaServiceFeaturesInvalidDefault(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Expected a panic for a Default which doesn't match the Type")
+		}
+	}()
+
+	schemaServiceFeatures(map[string][]features.FeatureFlag{
+		"example": {
+			{
+				Name:    "purge_soft_delete_on_destroy",
+				Type:    pluginsdk.TypeBool,
+				Default: "true",
+			},
+		},
+	})
+}
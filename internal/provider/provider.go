@@ -6,12 +6,15 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,6 +29,14 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// TestAzureProviderWithRecorder returns the Provider used for the Acceptance Tests, where the requests
+// made are recorded (or replayed) by the specified Recorder
+func TestAzureProviderWithRecorder(recorder common.RequestRecorder) *schema.Provider {
+	p := azureProvider(true)
+	p.ConfigureContextFunc = providerConfigure(p, recorder)
+	return p
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
@@ -214,6 +225,43 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			// Retries & Throttling
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", autorest.DefaultRetryAttempts),
+				ValidateFunc: validation.IntBetween(1, 20),
+				Description:  "The maximum number of times a request should be retried when a retryable status code (such as a 429 or 5xx) is returned.",
+			},
+
+			"retry_backoff_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_STRATEGY", string(common.BackoffStrategyExponential)),
+				ValidateFunc: validation.StringInSlice(common.PossibleValuesForBackoffStrategy(), false),
+				Description:  "The strategy used to determine how long to wait between retries when the API doesn't return a Retry-After header.",
+			},
+
+			"retry_delay_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_DELAY_IN_SECONDS", int(autorest.DefaultRetryDuration.Seconds())),
+				ValidateFunc: validation.IntBetween(1, 300),
+				Description:  "The (initial) number of seconds to wait between retries.",
+			},
+
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum number of requests per second which should be sent to each Subscription. Defaults to `0`, which means requests aren't rate limited.",
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -234,6 +282,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		ResourcesMap:   resources,
 	}
 
+	for name, resource := range p.ResourcesMap {
+		withRequestMetrics(name, resource)
+	}
+	for name, dataSource := range p.DataSourcesMap {
+		withRequestMetrics(name, dataSource)
+	}
+
 	if !features.ThreePointOh() {
 		p.Schema["skip_credentials_validation"] = &schema.Schema{
 			Type:        schema.TypeBool,
@@ -243,12 +298,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, nil)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, recorder common.RequestRecorder) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -315,6 +370,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			RetryOptions: common.RetryOptions{
+				MaxRetries:           d.Get("max_retries").(int),
+				BackoffStrategy:      common.BackoffStrategy(d.Get("retry_backoff_strategy").(string)),
+				RetryDelay:           time.Duration(d.Get("retry_delay_in_seconds").(int)) * time.Second,
+				MaxRequestsPerSecond: d.Get("max_requests_per_second").(float64),
+			},
+			Recorder: recorder,
+			Tags:     expandProviderTags(d.Get("default_tags").([]interface{}), d.Get("ignore_tags").([]interface{})),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
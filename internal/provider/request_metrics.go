@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// withRequestMetrics wraps the CRUD functions for this Resource/Data Source so that a summary of the requests
+// made by each operation (including retries, throttled responses and time spent waiting on the rate limiter)
+// is logged once the operation completes.
+func withRequestMetrics(name string, resource *schema.Resource) {
+	if resource.Create != nil {
+		resource.Create = requestMetricsFunc(name, "Create", resource.Create)
+	}
+	if resource.Read != nil {
+		resource.Read = requestMetricsFunc(name, "Read", resource.Read)
+	}
+	if resource.Update != nil {
+		resource.Update = requestMetricsFunc(name, "Update", resource.Update)
+	}
+	if resource.Delete != nil {
+		resource.Delete = requestMetricsFunc(name, "Delete", resource.Delete)
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = requestMetricsContextFunc(name, "Create", resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = requestMetricsContextFunc(name, "Read", resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = requestMetricsContextFunc(name, "Update", resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = requestMetricsContextFunc(name, "Delete", resource.DeleteContext)
+	}
+}
+
+func requestMetricsFunc(name, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		// the context for the requests is derived from the StopContext, so these are tracked via the timeouts for the ResourceData
+		ctx := common.ContextWithRequestMetrics(context.Background())
+		untrack := common.TrackRequestMetricsForResourceData(d, ctx)
+		defer func() {
+			untrack()
+			logRequestMetrics(ctx, name, operation, d.Id())
+		}()
+
+		return f(d, meta)
+	}
+}
+
+func requestMetricsContextFunc(name, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// the requests are tracked both via the context for this operation and (for Resources which derive the context for
+		// their requests from the StopContext) via the timeouts for the ResourceData
+		ctx = common.ContextWithRequestMetrics(ctx)
+		untrack := common.TrackRequestMetricsForResourceData(d, ctx)
+		defer func() {
+			untrack()
+			logRequestMetrics(ctx, name, operation, d.Id())
+		}()
+
+		return f(ctx, d, meta)
+	}
+}
+
+func logRequestMetrics(ctx context.Context, name, operation, id string) {
+	metrics := common.RequestMetricsFromContext(ctx)
+	log.Printf("[DEBUG] Request Metrics for %s of %s %q: %s", operation, name, id, metrics)
+}
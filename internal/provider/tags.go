@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be applied to all Resources which support Tags.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Required:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be ignored when reading Resources, for example those added by Azure Policy.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:         pluginsdk.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"key_prefixes": {
+					Type:         pluginsdk.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandProviderTags(defaultTags []interface{}, ignoreTags []interface{}) tags.ProviderConfiguration {
+	config := tags.ProviderConfiguration{
+		DefaultTags:       map[string]string{},
+		IgnoreKeys:        []string{},
+		IgnoreKeyPrefixes: []string{},
+	}
+
+	if len(defaultTags) > 0 && defaultTags[0] != nil {
+		raw := defaultTags[0].(map[string]interface{})
+		for k, v := range raw["tags"].(map[string]interface{}) {
+			value, _ := tags.TagValueToString(v)
+			config.DefaultTags[k] = value
+		}
+	}
+
+	if len(ignoreTags) > 0 && ignoreTags[0] != nil {
+		raw := ignoreTags[0].(map[string]interface{})
+		for _, v := range raw["keys"].(*pluginsdk.Set).List() {
+			config.IgnoreKeys = append(config.IgnoreKeys, v.(string))
+		}
+		for _, v := range raw["key_prefixes"].(*pluginsdk.Set).List() {
+			config.IgnoreKeyPrefixes = append(config.IgnoreKeyPrefixes, v.(string))
+		}
+	}
+
+	return config
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -63,6 +64,11 @@ type Resource interface {
 	IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface will have their Terraform State upgraded
+// using the specified StateUpgraders - where only the format of the Resource ID
+// has changed, the ResourceIDStateUpgrade can be used for each version.
 type ResourceWithStateMigration interface {
 	Resource
 	StateUpgraders() StateUpgradeData
@@ -73,8 +79,6 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
@@ -159,6 +163,11 @@ func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
 	return nil
 }
 
+// FeatureFlags returns the values of the Feature Flags registered by Services within the `features` block
+func (rmd ResourceMetaData) FeatureFlags() features.ServiceFeatures {
+	return rmd.Client.Features.Services
+}
+
 // ResourceRequiresImport returns an error saying that this resource must be imported with instructions
 // on how to do this (namely, using `terraform import`
 func (rmd ResourceMetaData) ResourceRequiresImport(resourceName string, idFormatter resourceid.Formatter) error {
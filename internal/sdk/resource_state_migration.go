@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDParserFunc parses a Resource ID in an older format and returns a Formatter
+// which outputs this Resource ID in the current format - for example an "Insensitive"
+// parser generated by `generator-resource-id`, or a function converting between two ID types
+type ResourceIDParserFunc func(input string) (resourceid.Formatter, error)
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic State Upgrade which rewrites the `id` of a Resource
+// (and optionally any other top-level fields containing Resource IDs) from an older format
+// into the current format - for example when the casing of a segment has been fixed, or a
+// segment has been renamed.
+//
+// Both string and list/set of string fields are supported, empty values are left as-is.
+type ResourceIDStateUpgrade struct {
+	// OldSchema is a point-in-time reference to the Schema at the time of the old version
+	OldSchema map[string]*pluginsdk.Schema
+
+	// IDParser is used to parse the `id` field in the old format
+	IDParser ResourceIDParserFunc
+
+	// Fields is an optional map of other top-level fields containing Resource IDs
+	// to the parser which should be used to rewrite them
+	Fields map[string]ResourceIDParserFunc
+}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.OldSchema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if u.IDParser == nil {
+			return rawState, fmt.Errorf("an IDParser must be specified to upgrade the `id` field")
+		}
+
+		fields := map[string]ResourceIDParserFunc{
+			"id": u.IDParser,
+		}
+		for k, v := range u.Fields {
+			fields[k] = v
+		}
+
+		for field, parser := range fields {
+			raw, ok := rawState[field]
+			if !ok || raw == nil {
+				continue
+			}
+
+			switch v := raw.(type) {
+			case string:
+				updated, err := rewriteResourceID(field, v, parser)
+				if err != nil {
+					return rawState, err
+				}
+				rawState[field] = updated
+
+			case []interface{}:
+				out := make([]interface{}, 0, len(v))
+				for _, item := range v {
+					value, ok := item.(string)
+					if !ok {
+						return rawState, fmt.Errorf("expected the items within %q to be strings but got %T", field, item)
+					}
+
+					updated, err := rewriteResourceID(field, value, parser)
+					if err != nil {
+						return rawState, err
+					}
+					out = append(out, updated)
+				}
+				rawState[field] = out
+
+			default:
+				return rawState, fmt.Errorf("expected %q to be a string or a list of strings but got %T", field, raw)
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+func rewriteResourceID(field, input string, parser ResourceIDParserFunc) (string, error) {
+	if input == "" {
+		return input, nil
+	}
+
+	parsed, err := parser(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing existing Resource ID %q for %q: %+v", input, field, err)
+	}
+
+	return parsed.ID(), nil
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+type exampleResourceGroupId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func (id exampleResourceGroupId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.Name)
+}
+
+func parseExampleResourceGroupIdInsensitively(input string) (resourceid.Formatter, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return nil, fmt.Errorf("%q is not a Resource Group ID", input)
+	}
+
+	return exampleResourceGroupId{
+		SubscriptionId: segments[1],
+		Name:           segments[3],
+	}, nil
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	upgrade := ResourceIDStateUpgrade{
+		IDParser: parseExampleResourceGroupIdInsensitively,
+		Fields: map[string]ResourceIDParserFunc{
+			"parent_id":  parseExampleResourceGroupIdInsensitively,
+			"linked_ids": parseExampleResourceGroupIdInsensitively,
+		},
+	}
+
+	testData := []struct {
+		name     string
+		input    map[string]interface{}
+		expected map[string]interface{}
+		error    bool
+	}{
+		{
+			name: "old id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+		},
+		{
+			name: "new id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+		},
+		{
+			name: "old id and fields",
+			input: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-5678-1234-123456789012/RESOURCEGROUPS/group1",
+				"parent_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group2",
+				"linked_ids": []interface{}{
+					"/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group3",
+					"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group4",
+				},
+				"name": "unchanged",
+			},
+			expected: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+				"parent_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group2",
+				"linked_ids": []interface{}{
+					"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group3",
+					"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group4",
+				},
+				"name": "unchanged",
+			},
+		},
+		{
+			name: "empty optional field",
+			input: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+				"parent_id": "",
+			},
+			expected: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+				"parent_id": "",
+			},
+		},
+		{
+			name: "invalid id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012",
+			},
+			error: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.name)
+
+		actual, err := upgrade.UpgradeFunc()(context.TODO(), test.input, nil)
+		if err != nil {
+			if test.error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if test.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(test.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", test.expected, actual)
+		}
+	}
+}
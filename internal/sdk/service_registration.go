@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -37,3 +38,17 @@ type UntypedServiceRegistration interface {
 	// SupportedResources returns the supported Resources supported by this Service
 	SupportedResources() map[string]*pluginsdk.Resource
 }
+
+// ServiceRegistrationWithFeatureFlags is an optional interface which can be implemented by both
+// Typed and Untyped Service Registrations to expose Feature Flags within the `features` block
+//
+// These are exposed in the Provider Schema, expanded and then made available to Resources via
+// `ResourceMetaData.FeatureFlags()` (or `Client.Features.Services` for Untyped Resources)
+type ServiceRegistrationWithFeatureFlags interface {
+	// Name is the name of this Service
+	Name() string
+
+	// FeatureFlags returns the Feature Flags for this Service, keyed by the name
+	// of the block within the `features` block (e.g. `app_configuration`)
+	FeatureFlags() map[string][]features.FeatureFlag
+}
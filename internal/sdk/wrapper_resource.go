@@ -153,7 +153,6 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.SchemaVersion = stateUpgradeData.SchemaVersion
 		resource.StateUpgraders = pluginsdk.StateUpgrades(stateUpgradeData.Upgraders)
 	}
-	// TODO: State Migrations
 
 	return &resource, nil
 }
@@ -162,7 +162,7 @@ func resourceAnalysisServicesServerCreate(d *pluginsdk.ResourceData, meta interf
 			Name: d.Get("sku").(string),
 		},
 		Properties: serverProperties,
-		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateThenPoll(ctx, id, analysisServicesServer); err != nil {
@@ -225,7 +225,7 @@ func resourceAnalysisServicesServerRead(d *pluginsdk.ResourceData, meta interfac
 			}
 		}
 
-		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
 			return err
 		}
 	}
@@ -278,7 +278,7 @@ func resourceAnalysisServicesServerUpdate(d *pluginsdk.ResourceData, meta interf
 		Sku: &servers.ResourceSku{
 			Name: sku,
 		},
-		Tags:       tagsHelper.Expand(t),
+		Tags:       tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 		Properties: serverProperties,
 	}
 
@@ -269,7 +269,7 @@ func dataSourceApiManagementRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 	d.Set("sku_name", flattenApiManagementServiceSkuName(resp.Sku))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenDataSourceApiManagementHostnameConfigurations(input *[]apimanagement.HostnameConfiguration) []interface{} {
@@ -663,7 +663,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 			CustomProperties: customProperties,
 			Certificates:     certificates,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 		Sku:  sku,
 	}
 
@@ -943,7 +943,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApiManagementServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -191,7 +191,7 @@ func (k FeatureResource) Create() sdk.ResourceFunc {
 				return tf.ImportAsExistsError(k.ResourceType(), appCfgFeatureResourceID.ID())
 			}
 
-			err = createOrUpdateFeature(ctx, client, model)
+			err = createOrUpdateFeature(ctx, client, metadata.Client.Tags, model)
 			if err != nil {
 				return fmt.Errorf("while creating feature: %+v", err)
 			}
@@ -251,7 +251,7 @@ func (k FeatureResource) Read() sdk.ResourceFunc {
 				Enabled:              fv.Enabled,
 				Name:                 fv.ID,
 				Label:                utils.NormalizeNilableString(kv.Label),
-				Tags:                 tags.Flatten(kv.Tags),
+				Tags:                 tags.Flatten(metadata.Client.Tags, kv.Tags),
 			}
 
 			if kv.Locked != nil {
@@ -305,7 +305,7 @@ func (k FeatureResource) Update() sdk.ResourceFunc {
 				if _, err = client.DeleteLock(ctx, featureKey, resourceID.Label, "", ""); err != nil {
 					return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", resourceID.Name, resourceID.Label, err)
 				}
-				err = createOrUpdateFeature(ctx, client, model)
+				err = createOrUpdateFeature(ctx, client, metadata.Client.Tags, model)
 				if err != nil {
 					return fmt.Errorf("while updating feature: %+v", err)
 				}
@@ -360,12 +360,12 @@ func (k FeatureResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.AppConfigurationFeatureID
 }
 
-func createOrUpdateFeature(ctx context.Context, client *appconfiguration.BaseClient, model FeatureResourceModel) error {
+func createOrUpdateFeature(ctx context.Context, client *appconfiguration.BaseClient, tagsConfig tags.ProviderConfiguration, model FeatureResourceModel) error {
 	featureKey := fmt.Sprintf("%s/%s", FeatureKeyPrefix, model.Name)
 	entity := appconfiguration.KeyValue{
 		Key:         utils.String(featureKey),
 		Label:       utils.String(model.Label),
-		Tags:        tags.Expand(model.Tags),
+		Tags:        tags.Expand(tagsConfig, model.Tags),
 		ContentType: utils.String(FeatureKeyContentType),
 		Locked:      utils.Bool(model.Locked),
 	}
@@ -149,7 +149,7 @@ func (k KeyResource) Create() sdk.ResourceFunc {
 			entity := appconfiguration.KeyValue{
 				Key:   utils.String(model.Key),
 				Label: utils.String(model.Label),
-				Tags:  tags.Expand(model.Tags),
+				Tags:  tags.Expand(metadata.Client.Tags, model.Tags),
 			}
 
 			switch model.Type {
@@ -230,7 +230,7 @@ func (k KeyResource) Read() sdk.ResourceFunc {
 				ContentType:          utils.NormalizeNilableString(kv.ContentType),
 				Etag:                 utils.NormalizeNilableString(kv.Etag),
 				Label:                utils.NormalizeNilableString(kv.Label),
-				Tags:                 tags.Flatten(kv.Tags),
+				Tags:                 tags.Flatten(metadata.Client.Tags, kv.Tags),
 			}
 
 			if utils.NormalizeNilableString(kv.ContentType) != VaultKeyContentType {
@@ -281,7 +281,7 @@ func (k KeyResource) Update() sdk.ResourceFunc {
 				entity := appconfiguration.KeyValue{
 					Key:   utils.String(model.Key),
 					Label: utils.String(model.Label),
-					Tags:  tags.Expand(model.Tags),
+					Tags:  tags.Expand(metadata.Client.Tags, model.Tags),
 				}
 
 				switch model.Type {
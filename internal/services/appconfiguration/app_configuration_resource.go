@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -13,12 +14,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2021-03-01-preview/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type appConfigurationIdentityType = identity.SystemAssignedUserAssigned
@@ -195,12 +197,43 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_app_configuration", resourceId.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the App Configuration exists in the soft delete state
+	deletedId := configurationstores.NewDeletedConfigurationStoreID(subscriptionId, location, name)
+	softDeleted, err := client.GetDeleted(ctx, deletedId)
+	if err != nil {
+		// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+		if !response.WasNotFound(softDeleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: softDeleted.HttpResponse}) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted %s: %+v", deletedId, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	recoverSoftDeleted := false
+	if err == nil && softDeleted.Model != nil {
+		if !meta.(*clients.Client).Features.Services.Bool(featuresBlockName, featureRecoverSoftDeletedConfigStore) {
+			// this exists but the users opted out so they must import this it out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
 	parameters := configurationstores.ConfigurationStore{
-		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Location: location,
 		Sku: configurationstores.Sku{
 			Name: d.Get("sku").(string),
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if recoverSoftDeleted {
+		log.Printf("[DEBUG] Recovering Soft-Deleted %s..", deletedId)
+		createMode := configurationstores.CreateModeRecover
+		parameters.Properties = &configurationstores.ConfigurationStoreProperties{
+			CreateMode: &createMode,
+		}
 	}
 
 	identity, err := expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
@@ -232,7 +265,7 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		Sku: &configurationstores.Sku{
 			Name: d.Get("sku").(string),
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if d.HasChange("identity") {
@@ -296,7 +329,7 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 
 	return nil
@@ -312,13 +345,69 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	purgeProtectionEnabled := false
+	if props := existing.Model.Properties; props != nil && props.EnablePurgeProtection != nil {
+		purgeProtectionEnabled = *props.EnablePurgeProtection
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
+	// Purge the soft deleted App Configuration permanently if the feature flag is enabled
+	if meta.(*clients.Client).Features.Services.Bool(featuresBlockName, featurePurgeSoftDeleteOnDestroy) {
+		deletedId := configurationstores.NewDeletedConfigurationStoreID(id.SubscriptionId, azure.NormalizeLocation(existing.Model.Location), id.ConfigStoreName)
+
+		// App Configurations with Purge Protection Enabled cannot be purged unless done by Azure
+		if purgeProtectionEnabled {
+			log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", *id)
+			return nil
+		}
+
+		// App Configurations using the Free SKU don't support soft delete, so won't be present
+		deleted, err := client.GetDeleted(ctx, deletedId)
+		if err != nil {
+			if response.WasNotFound(deleted.HttpResponse) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Soft-Deleted %s: %+v", deletedId, err)
+		}
+
+		log.Printf("[DEBUG] %s marked for purge - executing purge", deletedId)
+		if err := client.PurgeDeletedThenPoll(ctx, deletedId); err != nil {
+			return fmt.Errorf("purging %s: %+v", deletedId, err)
+		}
+		log.Printf("[DEBUG] Purged %s.", deletedId)
+	}
+
 	return nil
 }
 
+func optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted App Configuration exists with the Name %q in the location %q, however
+automatically recovering this App Configuration has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted App Configuration when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
+
 type flattenedAccessKeys struct {
 	primaryReadKey    []interface{}
 	primaryWriteKey   []interface{}
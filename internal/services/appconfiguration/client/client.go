@@ -8,10 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2021-03-01-preview/configurationstores"
 )
 
 type Client struct {
+	// API Version 2021-03-01-preview is used since the GA API Version (2020-06-01) doesn't support Soft Delete - which
+	// is required to check for, recover and purge Soft-Deleted Configuration Stores
 	ConfigurationStoresClient *configurationstores.ConfigurationStoresClient
 	tokenFunc                 func(endpoint string) (autorest.Authorizer, error)
 	configureClientFunc       func(c *autorest.Client, authorizer autorest.Authorizer)
@@ -1,6 +1,7 @@
 package appconfiguration
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -9,6 +10,13 @@ type Registration struct{}
 
 var _ sdk.TypedServiceRegistration = Registration{}
 var _ sdk.UntypedServiceRegistration = Registration{}
+var _ sdk.ServiceRegistrationWithFeatureFlags = Registration{}
+
+const (
+	featuresBlockName                    = "app_configuration"
+	featurePurgeSoftDeleteOnDestroy      = "purge_soft_delete_on_destroy"
+	featureRecoverSoftDeletedConfigStore = "recover_soft_deleted"
+)
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{}
@@ -46,3 +54,23 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_app_configuration": resourceAppConfiguration(),
 	}
 }
+
+// FeatureFlags returns the Feature Flags exposed by this Service within the `features` block
+func (r Registration) FeatureFlags() map[string][]features.FeatureFlag {
+	return map[string][]features.FeatureFlag{
+		featuresBlockName: {
+			{
+				Name:        featurePurgeSoftDeleteOnDestroy,
+				Type:        pluginsdk.TypeBool,
+				Default:     false,
+				Description: "Should the `azurerm_app_configuration` resources be permanently deleted (e.g. purged) when destroyed?",
+			},
+			{
+				Name:        featureRecoverSoftDeletedConfigStore,
+				Type:        pluginsdk.TypeBool,
+				Default:     true,
+				Description: "Should the `azurerm_app_configuration` resources recover a Soft-Deleted App Configuration with the same name and location?",
+			},
+		},
+	}
+}
@@ -0,0 +1,8 @@
+package configurationstores
+
+type ConfigurationStorePropertiesUpdateParameters struct {
+	DisableLocalAuth      *bool                 `json:"disableLocalAuth,omitempty"`
+	EnablePurgeProtection *bool                 `json:"enablePurgeProtection,omitempty"`
+	Encryption            *EncryptionProperties `json:"encryption,omitempty"`
+	PublicNetworkAccess   *PublicNetworkAccess  `json:"publicNetworkAccess,omitempty"`
+}
@@ -65,3 +65,26 @@ func (p ConfigurationStorePredicate) Matches(input ConfigurationStore) bool {
 
 	return true
 }
+
+type DeletedConfigurationStorePredicate struct {
+	Id   *string
+	Name *string
+	Type *string
+}
+
+func (p DeletedConfigurationStorePredicate) Matches(input DeletedConfigurationStore) bool {
+
+	if p.Id != nil && (input.Id == nil && *p.Id != *input.Id) {
+		return false
+	}
+
+	if p.Name != nil && (input.Name == nil && *p.Name != *input.Name) {
+		return false
+	}
+
+	if p.Type != nil && (input.Type == nil && *p.Type != *input.Type) {
+		return false
+	}
+
+	return true
+}
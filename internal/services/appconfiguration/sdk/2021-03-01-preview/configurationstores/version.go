@@ -2,7 +2,7 @@ package configurationstores
 
 import "fmt"
 
-const defaultApiVersion = "2020-06-01"
+const defaultApiVersion = "2021-03-01-preview"
 
 func userAgent() string {
 	return fmt.Sprintf("pandora/configurationstores/%s", defaultApiVersion)
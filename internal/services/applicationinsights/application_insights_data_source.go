@@ -116,5 +116,5 @@ func dataSourceArmApplicationInsightsRead(d *pluginsdk.ResourceData, meta interf
 		}
 		d.Set("workspace_id", workspaceId)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -226,7 +226,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Location:                               &location,
 		Kind:                                   &applicationType,
 		ApplicationInsightsComponentProperties: &applicationInsightsComponentProperties,
-		Tags:                                   tags.Expand(t),
+		Tags:                                   tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, insightProperties)
@@ -337,7 +337,7 @@ func resourceApplicationInsightsRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("daily_data_cap_notifications_disabled", billingProps.StopSendNotificationWhenHitCap)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApplicationInsightsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -194,7 +194,7 @@ func resourceApplicationInsightsWebTestsCreateUpdate(d *pluginsdk.ResourceData,
 				WebTest: &testConf,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	_, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, webTest)
@@ -265,7 +265,7 @@ func resourceApplicationInsightsWebTestsRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApplicationInsightsWebTestsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -334,6 +334,644 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 	}
 }
 
+func SiteConfigSchemaLinuxFunctionAppComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"always_on": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"api_management_api_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"api_definition_url": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"app_command_line": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"app_scale_limit": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"application_insights_key": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+
+				"application_insights_connection_string": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+
+				"application_stack": linuxFunctionAppStackSchemaComputed(),
+
+				"app_service_logs": FunctionAppAppServiceLogsSchemaComputed(),
+
+				"container_registry_use_managed_identity": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"container_registry_managed_identity_client_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"default_documents": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"elastic_instance_minimum": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"http2_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ip_restriction": IpRestrictionSchemaComputed(),
+
+				"scm_use_main_ip_restriction": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"scm_ip_restriction": IpRestrictionSchemaComputed(),
+
+				"load_balancing_mode": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"managed_pipeline_mode": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"pre_warmed_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"remote_debugging": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"remote_debugging_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"runtime_scale_monitoring_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"scm_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"use_32_bit_worker": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"websockets_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ftps_state": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"health_check_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"health_check_eviction_time_in_min": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"number_of_workers": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"scm_minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"cors": CorsSettingsSchemaComputed(),
+
+				"vnet_route_all_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"detailed_error_logging": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"linux_fx_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+type SiteConfigWindowsFunctionApp struct {
+	AlwaysOn                      bool                                 `tfschema:"always_on"`
+	ApiDefinition                 string                               `tfschema:"api_definition_url"`
+	ApiManagementConfigId         string                               `tfschema:"api_management_api_id"`
+	AppInsightsInstrumentationKey string                               `tfschema:"application_insights_key"` // App Insights Instrumentation Key
+	AppInsightsConnectionString   string                               `tfschema:"application_insights_connection_string"`
+	AppScaleLimit                 int                                  `tfschema:"app_scale_limit"`
+	AppServiceLogs                []FunctionAppAppServiceLogs          `tfschema:"app_service_logs"`
+	DefaultDocuments              []string                             `tfschema:"default_documents"`
+	ElasticInstanceMinimum        int                                  `tfschema:"elastic_instance_minimum"`
+	Http2Enabled                  bool                                 `tfschema:"http2_enabled"`
+	IpRestriction                 []IpRestriction                      `tfschema:"ip_restriction"`
+	LoadBalancing                 string                               `tfschema:"load_balancing_mode"`
+	ManagedPipelineMode           string                               `tfschema:"managed_pipeline_mode"`
+	PreWarmedInstanceCount        int                                  `tfschema:"pre_warmed_instance_count"`
+	RemoteDebugging               bool                                 `tfschema:"remote_debugging"`
+	RemoteDebuggingVersion        string                               `tfschema:"remote_debugging_version"`
+	RuntimeScaleMonitoring        bool                                 `tfschema:"runtime_scale_monitoring_enabled"`
+	ScmIpRestriction              []IpRestriction                      `tfschema:"scm_ip_restriction"`
+	ScmType                       string                               `tfschema:"scm_type"`
+	ScmUseMainIpRestriction       bool                                 `tfschema:"scm_use_main_ip_restriction"`
+	Use32BitWorker                bool                                 `tfschema:"use_32_bit_worker"`
+	WebSockets                    bool                                 `tfschema:"websockets_enabled"`
+	FtpsState                     string                               `tfschema:"ftps_state"`
+	HealthCheckPath               string                               `tfschema:"health_check_path"`
+	HealthCheckEvictionTime       int                                  `tfschema:"health_check_eviction_time_in_min"`
+	NumberOfWorkers               int                                  `tfschema:"number_of_workers"`
+	ApplicationStack              []ApplicationStackWindowsFunctionApp `tfschema:"application_stack"`
+	MinTlsVersion                 string                               `tfschema:"minimum_tls_version"`
+	ScmMinTlsVersion              string                               `tfschema:"scm_minimum_tls_version"`
+	Cors                          []CorsSetting                        `tfschema:"cors"`
+	DetailedErrorLogging          bool                                 `tfschema:"detailed_error_logging"`
+	WindowsFxVersion              string                               `tfschema:"windows_fx_version"`
+	VnetRouteAllEnabled           bool                                 `tfschema:"vnet_route_all_enabled"` // Not supported in Dynamic plans
+}
+
+func SiteConfigSchemaWindowsFunctionApp() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"always_on": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Computed:    true, // Note - several factors change the default for this, so needs to be computed.
+					Description: "If this Windows Function App is Always On enabled. Defaults to `false`.",
+				},
+
+				"api_management_api_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: apimValidate.ApiID,
+					Description:  "The ID of the API Management API for this Windows Function App.",
+				},
+
+				"api_definition_url": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The URL of the API definition that describes this Windows Function App.",
+				},
+
+				"app_scale_limit": {
+					Type:        pluginsdk.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
+					// TODO Validation?
+				},
+
+				"application_insights_key": {
+					Type:      pluginsdk.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					RequiredWith: []string{
+						"site_config.0.application_insights_connection_string",
+					},
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Instrumentation Key for connecting the Windows Function App to Application Insights.",
+				},
+
+				"application_insights_connection_string": {
+					Type:      pluginsdk.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					RequiredWith: []string{
+						"site_config.0.application_insights_key",
+					},
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Connection String for linking the Windows Function App to Application Insights.",
+				},
+
+				"application_stack": windowsFunctionAppStackSchema(),
+
+				"app_service_logs": FunctionAppAppServiceLogsSchema(),
+
+				"default_documents": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "Specifies a list of Default Documents for the Windows Function App.",
+				},
+
+				"elastic_instance_minimum": {
+					Type:        pluginsdk.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "The number of minimum instances for this Windows Function App. Only affects apps on Elastic Premium plans.",
+				},
+
+				"http2_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Specifies if the http2 protocol should be enabled. Defaults to `false`.",
+				},
+
+				"ip_restriction": IpRestrictionSchema(),
+
+				"scm_use_main_ip_restriction": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the Windows Function App `ip_restriction` configuration be used for the SCM also.",
+				},
+
+				"scm_ip_restriction": IpRestrictionSchema(),
+
+				"load_balancing_mode": { // Supported on Function Apps?
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  "LeastRequests",
+					ValidateFunc: validation.StringInSlice([]string{
+						"LeastRequests", // Service default
+						"WeightedRoundRobin",
+						"LeastResponseTime",
+						"WeightedTotalTraffic",
+						"RequestHash",
+						"PerSiteRoundRobin",
+					}, false),
+					Description: "The Site load balancing mode. Possible values include: `WeightedRoundRobin`, `LeastRequests`, `LeastResponseTime`, `WeightedTotalTraffic`, `RequestHash`, `PerSiteRoundRobin`. Defaults to `LeastRequests` if omitted.",
+				},
+
+				"managed_pipeline_mode": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.ManagedPipelineModeIntegrated),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.ManagedPipelineModeClassic),
+						string(web.ManagedPipelineModeIntegrated),
+					}, false),
+					Description: "The Managed Pipeline mode. Possible values include: `Integrated`, `Classic`. Defaults to `Integrated`.",
+				},
+
+				"pre_warmed_instance_count": {
+					Type:        pluginsdk.TypeInt,
+					Optional:    true,
+					Computed:    true, // Variable defaults depending on plan etc
+					Description: "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
+				},
+
+				"remote_debugging": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should Remote Debugging be enabled. Defaults to `false`.",
+				},
+
+				"remote_debugging_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"VS2017",
+						"VS2019",
+					}, false),
+					Description: "The Remote Debugging Version. Possible values include `VS2017` and `VS2019`",
+				},
+
+				"runtime_scale_monitoring_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Description: "Should Functions Runtime Scale Monitoring be enabled.",
+				},
+
+				"scm_type": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The SCM Type in use by the Windows Function App.",
+				},
+
+				"use_32_bit_worker": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the Windows Function App use a 32-bit worker process. Defaults to `true`.",
+				},
+
+				"websockets_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should Web Sockets be enabled. Defaults to `false`.",
+				},
+
+				"ftps_state": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.FtpsStateDisabled),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.FtpsStateAllAllowed),
+						string(web.FtpsStateDisabled),
+						string(web.FtpsStateFtpsOnly),
+					}, false),
+					Description: "State of FTP / FTPS service for this function app. Possible values include: `AllAllowed`, `FtpsOnly` and `Disabled`. Defaults to `Disabled`.",
+				},
+
+				"health_check_path": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Description: "The path to be checked for this function app health.",
+				},
+
+				"health_check_eviction_time_in_min": { // NOTE: Will evict the only node in single node configurations.
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(2, 10),
+					Description:  "The amount of time in minutes that a node is unhealthy before being removed from the load balancer. Possible values are between `2` and `10`. Defaults to `10`. Only valid in conjunction with `health_check_path`",
+				},
+
+				"number_of_workers": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+					Description:  "The number of Workers for this Windows Function App.",
+				},
+
+				"minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.SupportedTLSVersionsOneFullStopTwo),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.SupportedTLSVersionsOneFullStopZero),
+						string(web.SupportedTLSVersionsOneFullStopOne),
+						string(web.SupportedTLSVersionsOneFullStopTwo),
+					}, false),
+					Description: "The configures the minimum version of TLS required for SSL requests. Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
+				},
+
+				"scm_minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.SupportedTLSVersionsOneFullStopTwo),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.SupportedTLSVersionsOneFullStopZero),
+						string(web.SupportedTLSVersionsOneFullStopOne),
+						string(web.SupportedTLSVersionsOneFullStopTwo),
+					}, false),
+					Description: "Configures the minimum version of TLS required for SSL requests to the SCM site Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
+				},
+
+				"cors": CorsSettingsSchema(),
+
+				"vnet_route_all_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should all outbound traffic to have Virtual Network Security Groups and User Defined Routes applied? Defaults to `false`.",
+				},
+
+				"detailed_error_logging": {
+					Type:        pluginsdk.TypeBool,
+					Computed:    true,
+					Description: "Is detailed error logging enabled",
+				},
+
+				"windows_fx_version": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The Windows FX Version string.",
+				},
+			},
+		},
+	}
+}
+
+func SiteConfigSchemaWindowsFunctionAppComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"always_on": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"api_management_api_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"api_definition_url": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"app_scale_limit": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"application_insights_key": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+
+				"application_insights_connection_string": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+
+				"application_stack": windowsFunctionAppStackSchemaComputed(),
+
+				"app_service_logs": FunctionAppAppServiceLogsSchemaComputed(),
+
+				"default_documents": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"elastic_instance_minimum": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"http2_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ip_restriction": IpRestrictionSchemaComputed(),
+
+				"scm_use_main_ip_restriction": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"scm_ip_restriction": IpRestrictionSchemaComputed(),
+
+				"load_balancing_mode": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"managed_pipeline_mode": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"pre_warmed_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"remote_debugging": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"remote_debugging_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"runtime_scale_monitoring_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"scm_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"use_32_bit_worker": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"websockets_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ftps_state": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"health_check_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"health_check_eviction_time_in_min": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"number_of_workers": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"scm_minimum_tls_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"cors": CorsSettingsSchemaComputed(),
+
+				"vnet_route_all_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"detailed_error_logging": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"windows_fx_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 type ApplicationStackLinuxFunctionApp struct {
 	// Note - Function Apps differ to Web Apps here. They do not use the named properties in the SiteConfig block and exclusively use the app_settings map
 	DotNetVersion string                   `tfschema:"dotnet_version"`     // Supported values `3.1`. Version 6 is in preview on Windows Only
@@ -502,6 +1140,210 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 	}
 }
 
+func linuxFunctionAppStackSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"python_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"docker": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*schema.Schema{
+							"registry_url": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"registry_username": {
+								Type:      pluginsdk.TypeString,
+								Computed:  true,
+								Sensitive: true,
+							},
+
+							"registry_password": {
+								Type:      pluginsdk.TypeString,
+								Computed:  true,
+								Sensitive: true,
+							},
+
+							"image_name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"image_tag": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+
+				"use_custom_runtime": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+type ApplicationStackWindowsFunctionApp struct {
+	// Note - Unlike Linux, the Windows stack is not encoded in an FX string, the runtime is read from the FUNCTIONS_WORKER_RUNTIME app_setting
+	DotNetVersion         string `tfschema:"dotnet_version"`          // Supported values `3.1` and `6`
+	NodeVersion           string `tfschema:"node_version"`            // Supported values `12`, `14`
+	JavaVersion           string `tfschema:"java_version"`            // Supported values `1.8`, `11`
+	PowerShellCoreVersion string `tfschema:"powershell_core_version"` // Supported values `7`
+	CustomHandler         bool   `tfschema:"use_custom_runtime"`      // Supported values `true`
+}
+
+func windowsFunctionAppStackSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.1",
+						"6",
+					}, false),
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+					Description: "The version of .Net. Possible values are `3.1` and `6`",
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"12",
+						"14",
+					}, false),
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+					Description: "The version of Node to use. Possible values include `12`, and `14`",
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.8",
+						"11",
+					}, false),
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+					Description: "The version of Java to use. Possible values are `1.8`, and `11`",
+				},
+
+				"powershell_core_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"7",
+					}, false),
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+					Description: "The version of PowerShell Core to use. Possibles values are `7`.",
+				},
+
+				"use_custom_runtime": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+				},
+			},
+		},
+	}
+}
+
+func windowsFunctionAppStackSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"powershell_core_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"use_custom_runtime": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 type FunctionAppAppServiceLogs struct {
 	DiskQuotaMB         int `tfschema:"disk_quota_mb"`
 	RetentionPeriodDays int `tfschema:"retention_period_days"`
@@ -530,6 +1372,25 @@ func FunctionAppAppServiceLogsSchema() *pluginsdk.Schema {
 	}
 }
 
+func FunctionAppAppServiceLogsSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk_quota_mb": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"retention_period_days": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool) (*web.SiteConfig, error) {
 	if len(siteConfig) == 0 {
 		return nil, nil
@@ -861,6 +1722,321 @@ func FlattenSiteConfigLinuxFunctionApp(functionAppSiteConfig *web.SiteConfig) (*
 	return result, nil
 }
 
+func ExpandSiteConfigWindowsFunctionApp(siteConfig []SiteConfigWindowsFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool) (*web.SiteConfig, error) {
+	if len(siteConfig) == 0 {
+		return nil, nil
+	}
+	expanded := &web.SiteConfig{}
+	if existing != nil {
+		expanded = existing
+	}
+
+	appSettings := make([]web.NameValuePair, 0)
+
+	appSettings = append(appSettings, web.NameValuePair{
+		Name:  utils.String("FUNCTIONS_EXTENSION_VERSION"),
+		Value: utils.String(version),
+	})
+
+	if storageUsesMSI {
+		appSettings = append(appSettings, web.NameValuePair{
+			Name:  utils.String("AzureWebJobsStorage__accountName"),
+			Value: utils.String(storageString),
+		})
+	} else {
+		appSettings = append(appSettings, web.NameValuePair{
+			Name:  utils.String("AzureWebJobsStorage"),
+			Value: utils.String(storageString),
+		})
+	}
+
+	windowsSiteConfig := siteConfig[0]
+
+	if metadata.ResourceData.HasChange("site_config.0.health_check_path") {
+		if windowsSiteConfig.HealthCheckPath != "" && metadata.ResourceData.HasChange("site_config.0.health_check_eviction_time_in_min") {
+			v := strconv.Itoa(windowsSiteConfig.HealthCheckEvictionTime)
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("WEBSITE_HEALTHCHECK_MAXPINGFAILURES"),
+				Value: utils.String(v),
+			})
+		}
+	}
+
+	expanded.AlwaysOn = utils.Bool(windowsSiteConfig.AlwaysOn)
+
+	if metadata.ResourceData.HasChange("site_config.0.app_scale_limit") {
+		expanded.FunctionAppScaleLimit = utils.Int32(int32(windowsSiteConfig.AppScaleLimit))
+	}
+
+	if windowsSiteConfig.AppInsightsConnectionString != "" {
+		appSettings = append(appSettings, web.NameValuePair{
+			Name:  utils.String("APPLICATIONINSIGHTS_CONNECTION_STRING"),
+			Value: utils.String(windowsSiteConfig.AppInsightsConnectionString),
+		})
+	}
+
+	if windowsSiteConfig.AppInsightsInstrumentationKey != "" {
+		appSettings = append(appSettings, web.NameValuePair{
+			Name:  utils.String("APPINSIGHTS_INSTRUMENTATIONKEY"),
+			Value: utils.String(windowsSiteConfig.AppInsightsInstrumentationKey),
+		})
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.api_management_api_id") {
+		expanded.APIManagementConfig = &web.APIManagementConfig{
+			ID: utils.String(windowsSiteConfig.ApiManagementConfigId),
+		}
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.api_definition_url") {
+		expanded.APIDefinition = &web.APIDefinitionInfo{
+			URL: utils.String(windowsSiteConfig.ApiDefinition),
+		}
+	}
+
+	// Note - the worker runtime is sent every time as it's part of the app_settings, which are always sent in full
+	if len(windowsSiteConfig.ApplicationStack) > 0 {
+		windowsAppStack := windowsSiteConfig.ApplicationStack[0]
+		if windowsAppStack.DotNetVersion != "" {
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+				Value: utils.String("dotnet"),
+			})
+			expanded.NetFrameworkVersion = utils.String("v4.0")
+			if windowsAppStack.DotNetVersion == "6" {
+				expanded.NetFrameworkVersion = utils.String("v6.0")
+			}
+		}
+
+		if windowsAppStack.NodeVersion != "" {
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+				Value: utils.String("node"),
+			})
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("WEBSITE_NODE_DEFAULT_VERSION"),
+				Value: utils.String(fmt.Sprintf("~%s", windowsAppStack.NodeVersion)),
+			})
+		}
+
+		if windowsAppStack.JavaVersion != "" {
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+				Value: utils.String("java"),
+			})
+			expanded.JavaVersion = utils.String(windowsAppStack.JavaVersion)
+		}
+
+		if windowsAppStack.PowerShellCoreVersion != "" {
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+				Value: utils.String("powershell"),
+			})
+			expanded.PowerShellVersion = utils.String(fmt.Sprintf("~%s", windowsAppStack.PowerShellCoreVersion))
+		}
+
+		if windowsAppStack.CustomHandler {
+			appSettings = append(appSettings, web.NameValuePair{
+				Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+				Value: utils.String("custom"),
+			})
+		}
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.vnet_route_all_enabled") {
+		expanded.VnetRouteAllEnabled = utils.Bool(windowsSiteConfig.VnetRouteAllEnabled)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.default_documents") {
+		expanded.DefaultDocuments = &windowsSiteConfig.DefaultDocuments
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.http2_enabled") {
+		expanded.HTTP20Enabled = utils.Bool(windowsSiteConfig.Http2Enabled)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.ip_restriction") {
+		ipRestrictions, err := ExpandIpRestrictions(windowsSiteConfig.IpRestriction)
+		if err != nil {
+			return nil, err
+		}
+		expanded.IPSecurityRestrictions = ipRestrictions
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.scm_use_main_ip_restriction") {
+		expanded.ScmIPSecurityRestrictionsUseMain = utils.Bool(windowsSiteConfig.ScmUseMainIpRestriction)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.scm_ip_restriction") {
+		scmIpRestrictions, err := ExpandIpRestrictions(windowsSiteConfig.ScmIpRestriction)
+		if err != nil {
+			return nil, err
+		}
+		expanded.ScmIPSecurityRestrictions = scmIpRestrictions
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.load_balancing_mode") {
+		expanded.LoadBalancing = web.SiteLoadBalancing(windowsSiteConfig.LoadBalancing)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.managed_pipeline_mode") {
+		expanded.ManagedPipelineMode = web.ManagedPipelineMode(windowsSiteConfig.ManagedPipelineMode)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.remote_debugging") {
+		expanded.RemoteDebuggingEnabled = utils.Bool(windowsSiteConfig.RemoteDebugging)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.remote_debugging_version") {
+		expanded.RemoteDebuggingVersion = utils.String(windowsSiteConfig.RemoteDebuggingVersion)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.runtime_scale_monitoring_enabled") {
+		expanded.FunctionsRuntimeScaleMonitoringEnabled = utils.Bool(windowsSiteConfig.RuntimeScaleMonitoring)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.use_32_bit_worker") {
+		expanded.Use32BitWorkerProcess = utils.Bool(windowsSiteConfig.Use32BitWorker)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.websockets_enabled") {
+		expanded.WebSocketsEnabled = utils.Bool(windowsSiteConfig.WebSockets)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.ftps_state") {
+		expanded.FtpsState = web.FtpsState(windowsSiteConfig.FtpsState)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.health_check_path") {
+		expanded.HealthCheckPath = utils.String(windowsSiteConfig.HealthCheckPath)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.number_of_workers") {
+		expanded.NumberOfWorkers = utils.Int32(int32(windowsSiteConfig.NumberOfWorkers))
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.minimum_tls_version") {
+		expanded.MinTLSVersion = web.SupportedTLSVersions(windowsSiteConfig.MinTlsVersion)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.scm_minimum_tls_version") {
+		expanded.ScmMinTLSVersion = web.SupportedTLSVersions(windowsSiteConfig.ScmMinTlsVersion)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.cors") {
+		cors := ExpandCorsSettings(windowsSiteConfig.Cors)
+		expanded.Cors = cors
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.pre_warmed_instance_count") {
+		expanded.PreWarmedInstanceCount = utils.Int32(int32(windowsSiteConfig.PreWarmedInstanceCount))
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = utils.Int32(int32(windowsSiteConfig.ElasticInstanceMinimum))
+	}
+
+	expanded.AppSettings = &appSettings
+
+	return expanded, nil
+}
+
+func FlattenSiteConfigWindowsFunctionApp(functionAppSiteConfig *web.SiteConfig) (*SiteConfigWindowsFunctionApp, error) {
+	if functionAppSiteConfig == nil {
+		return nil, fmt.Errorf("flattening site config: SiteConfig was nil")
+	}
+
+	result := &SiteConfigWindowsFunctionApp{
+		AlwaysOn:                utils.NormaliseNilableBool(functionAppSiteConfig.AlwaysOn),
+		AppScaleLimit:           int(utils.NormaliseNilableInt32(functionAppSiteConfig.FunctionAppScaleLimit)),
+		DetailedErrorLogging:    utils.NormaliseNilableBool(functionAppSiteConfig.DetailedErrorLoggingEnabled),
+		HealthCheckPath:         utils.NormalizeNilableString(functionAppSiteConfig.HealthCheckPath),
+		Http2Enabled:            utils.NormaliseNilableBool(functionAppSiteConfig.HTTP20Enabled),
+		WindowsFxVersion:        utils.NormalizeNilableString(functionAppSiteConfig.WindowsFxVersion),
+		LoadBalancing:           string(functionAppSiteConfig.LoadBalancing),
+		ManagedPipelineMode:     string(functionAppSiteConfig.ManagedPipelineMode),
+		NumberOfWorkers:         int(utils.NormaliseNilableInt32(functionAppSiteConfig.NumberOfWorkers)),
+		ScmType:                 string(functionAppSiteConfig.ScmType),
+		FtpsState:               string(functionAppSiteConfig.FtpsState),
+		RuntimeScaleMonitoring:  utils.NormaliseNilableBool(functionAppSiteConfig.FunctionsRuntimeScaleMonitoringEnabled),
+		MinTlsVersion:           string(functionAppSiteConfig.MinTLSVersion),
+		ScmMinTlsVersion:        string(functionAppSiteConfig.ScmMinTLSVersion),
+		PreWarmedInstanceCount:  int(utils.NormaliseNilableInt32(functionAppSiteConfig.PreWarmedInstanceCount)),
+		ElasticInstanceMinimum:  int(utils.NormaliseNilableInt32(functionAppSiteConfig.MinimumElasticInstanceCount)),
+		Use32BitWorker:          utils.NormaliseNilableBool(functionAppSiteConfig.Use32BitWorkerProcess),
+		WebSockets:              utils.NormaliseNilableBool(functionAppSiteConfig.WebSocketsEnabled),
+		ScmUseMainIpRestriction: utils.NormaliseNilableBool(functionAppSiteConfig.ScmIPSecurityRestrictionsUseMain),
+		RemoteDebugging:         utils.NormaliseNilableBool(functionAppSiteConfig.RemoteDebuggingEnabled),
+		RemoteDebuggingVersion:  strings.ToUpper(utils.NormalizeNilableString(functionAppSiteConfig.RemoteDebuggingVersion)),
+		VnetRouteAllEnabled:     utils.NormaliseNilableBool(functionAppSiteConfig.VnetRouteAllEnabled),
+	}
+
+	if v := functionAppSiteConfig.APIDefinition; v != nil && v.URL != nil {
+		result.ApiDefinition = *v.URL
+	}
+
+	if v := functionAppSiteConfig.APIManagementConfig; v != nil && v.ID != nil {
+		result.ApiManagementConfigId = *v.ID
+	}
+
+	if functionAppSiteConfig.IPSecurityRestrictions != nil {
+		result.IpRestriction = FlattenIpRestrictions(functionAppSiteConfig.IPSecurityRestrictions)
+	}
+
+	if functionAppSiteConfig.ScmIPSecurityRestrictions != nil {
+		result.ScmIpRestriction = FlattenIpRestrictions(functionAppSiteConfig.ScmIPSecurityRestrictions)
+	}
+
+	if v := functionAppSiteConfig.DefaultDocuments; v != nil {
+		result.DefaultDocuments = *v
+	}
+
+	if functionAppSiteConfig.Cors != nil {
+		corsSettings := functionAppSiteConfig.Cors
+		cors := CorsSetting{}
+		if corsSettings.SupportCredentials != nil {
+			cors.SupportCredentials = *corsSettings.SupportCredentials
+		}
+
+		if corsSettings.AllowedOrigins != nil && len(*corsSettings.AllowedOrigins) != 0 {
+			cors.AllowedOrigins = *corsSettings.AllowedOrigins
+			result.Cors = []CorsSetting{cors}
+		}
+	}
+
+	return result, nil
+}
+
+// FlattenApplicationStackWindowsFunctionApp determines the application_stack for a Windows Function App. The Site Config
+// always contains values for the .Net, Java and PowerShell versions, so the `FUNCTIONS_WORKER_RUNTIME` app setting is
+// used to decide which (if any) of them is in use.
+func FlattenApplicationStackWindowsFunctionApp(functionAppSiteConfig *web.SiteConfig, workerRuntime string, nodeVersion string) []ApplicationStackWindowsFunctionApp {
+	appStack := ApplicationStackWindowsFunctionApp{}
+	switch strings.ToLower(workerRuntime) {
+	case "dotnet":
+		appStack.DotNetVersion = "3.1"
+		if functionAppSiteConfig != nil && strings.EqualFold(utils.NormalizeNilableString(functionAppSiteConfig.NetFrameworkVersion), "v6.0") {
+			appStack.DotNetVersion = "6"
+		}
+	case "node":
+		appStack.NodeVersion = strings.TrimPrefix(nodeVersion, "~")
+	case "java":
+		if functionAppSiteConfig != nil {
+			appStack.JavaVersion = utils.NormalizeNilableString(functionAppSiteConfig.JavaVersion)
+		}
+	case "powershell":
+		if functionAppSiteConfig != nil {
+			appStack.PowerShellCoreVersion = strings.TrimPrefix(utils.NormalizeNilableString(functionAppSiteConfig.PowerShellVersion), "~")
+		}
+	case "custom":
+		appStack.CustomHandler = true
+	default:
+		return nil
+	}
+
+	return []ApplicationStackWindowsFunctionApp{appStack}
+}
+
 func ParseWebJobsStorageString(input *string) (name, key string) {
 	if input == nil {
 		return
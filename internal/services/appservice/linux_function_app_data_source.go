@@ -0,0 +1,322 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinuxFunctionAppDataSource struct{}
+
+type LinuxFunctionAppDataSourceModel struct {
+	Name               string `tfschema:"name"`
+	ResourceGroup      string `tfschema:"resource_group_name"`
+	Location           string `tfschema:"location"`
+	ServicePlanId      string `tfschema:"service_plan_id"`
+	StorageAccountName string `tfschema:"storage_account_name"`
+
+	StorageAccountKey string `tfschema:"storage_account_access_key"`
+	StorageUsesMSI    bool   `tfschema:"storage_uses_managed_identity"`
+
+	AppSettings               map[string]string                    `tfschema:"app_settings"`
+	AuthSettings              []helpers.AuthSettings               `tfschema:"auth_settings"`
+	Backup                    []helpers.Backup                     `tfschema:"backup"`
+	BuiltinLogging            bool                                 `tfschema:"builtin_logging_enabled"`
+	ClientCertEnabled         bool                                 `tfschema:"client_cert_enabled"`
+	ClientCertMode            string                               `tfschema:"client_cert_mode"`
+	ConnectionStrings         []helpers.ConnectionString           `tfschema:"connection_string"`
+	DailyMemoryTimeQuota      int                                  `tfschema:"daily_memory_time_quota"`
+	Enabled                   bool                                 `tfschema:"enabled"`
+	FunctionExtensionsVersion string                               `tfschema:"functions_extension_version"`
+	ForceDisableContentShare  bool                                 `tfschema:"content_share_force_disabled"`
+	HttpsOnly                 bool                                 `tfschema:"https_only"`
+	Identity                  []helpers.Identity                   `tfschema:"identity"`
+	SiteConfig                []helpers.SiteConfigLinuxFunctionApp `tfschema:"site_config"`
+	Tags                      map[string]string                    `tfschema:"tags"`
+
+	CustomDomainVerificationId    string   `tfschema:"custom_domain_verification_id"`
+	DefaultHostname               string   `tfschema:"default_hostname"`
+	Kind                          string   `tfschema:"kind"`
+	OutboundIPAddresses           string   `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
+
+	SiteCredentials []helpers.SiteCredential `tfschema:"site_credential"`
+}
+
+var _ sdk.DataSource = LinuxFunctionAppDataSource{}
+
+func (d LinuxFunctionAppDataSource) ModelObject() interface{} {
+	return &LinuxFunctionAppDataSourceModel{}
+}
+
+func (d LinuxFunctionAppDataSource) ResourceType() string {
+	return "azurerm_linux_function_app"
+}
+
+func (d LinuxFunctionAppDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.WebAppName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+	}
+}
+
+func (d LinuxFunctionAppDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": location.SchemaComputed(),
+
+		"service_plan_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"storage_account_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"storage_account_access_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"storage_uses_managed_identity": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"auth_settings": helpers.AuthSettingsSchemaComputed(),
+
+		"backup": helpers.BackupSchemaComputed(),
+
+		"builtin_logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"client_cert_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"client_cert_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"connection_string": helpers.ConnectionStringSchemaComputed(),
+
+		"content_share_force_disabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"daily_memory_time_quota": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"functions_extension_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"identity": helpers.IdentitySchemaComputed(),
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_config": helpers.SiteConfigSchemaLinuxFunctionAppComputed(),
+
+		"site_credential": helpers.SiteCredentialSchema(),
+
+		"tags": tags.SchemaDataSource(),
+	}
+}
+
+func (d LinuxFunctionAppDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var functionApp LinuxFunctionAppModel
+			if err := metadata.Decode(&functionApp); err != nil {
+				return err
+			}
+
+			id := parse.NewFunctionAppID(subscriptionId, functionApp.ResourceGroup, functionApp.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("Linux %s not found", id)
+				}
+				return fmt.Errorf("checking for presence of existing Linux %s: %+v", id, err)
+			}
+
+			appSettingsResp, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
+			}
+
+			connectionStrings, err := client.ListConnectionStrings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
+			}
+
+			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
+			}
+
+			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for Site Publishing Credential information for Linux %s: %+v", id, err)
+			}
+			siteCredentials, err := siteCredentialsFuture.Result(*client)
+			if err != nil {
+				return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+			}
+
+			auth, err := client.GetAuthSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
+			}
+
+			backup, err := client.GetBackupConfiguration(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(backup.Response) {
+					return fmt.Errorf("reading Backup Settings for Linux %s: %+v", id, err)
+				}
+			}
+
+			logs, err := client.GetDiagnosticLogsConfiguration(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading logs configuration for Linux %s: %+v", id, err)
+			}
+
+			configResp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Site Config for Linux %s: %+v", id, err)
+			}
+
+			siteConfig, err := helpers.FlattenSiteConfigLinuxFunctionApp(configResp.SiteConfig)
+			if err != nil {
+				return fmt.Errorf("reading Site Config for Linux %s: %+v", id, err)
+			}
+			functionApp.SiteConfig = []helpers.SiteConfigLinuxFunctionApp{*siteConfig}
+
+			functionApp.unpackLinuxFunctionAppSettings(appSettingsResp)
+
+			functionApp.Kind = utils.NormalizeNilableString(existing.Kind)
+			functionApp.Location = location.NormalizeNilable(existing.Location)
+			functionApp.Tags = tags.ToTypedObject(metadata.Client.Tags, existing.Tags, nil)
+			if props := existing.SiteProperties; props != nil {
+				functionApp.ClientCertEnabled = utils.NormaliseNilableBool(props.ClientCertEnabled)
+				functionApp.ClientCertMode = string(props.ClientCertMode)
+				functionApp.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationID)
+				functionApp.DailyMemoryTimeQuota = int(utils.NormaliseNilableInt32(props.DailyMemoryTimeQuota))
+				functionApp.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostName)
+				functionApp.Enabled = utils.NormaliseNilableBool(props.Enabled)
+				functionApp.HttpsOnly = utils.NormaliseNilableBool(props.HTTPSOnly)
+				functionApp.ServicePlanId = utils.NormalizeNilableString(props.ServerFarmID)
+				functionApp.OutboundIPAddresses = utils.NormalizeNilableString(props.OutboundIPAddresses)
+				functionApp.OutboundIPAddressList = strings.Split(functionApp.OutboundIPAddresses, ",")
+				functionApp.PossibleOutboundIPAddresses = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+				functionApp.PossibleOutboundIPAddressList = strings.Split(functionApp.PossibleOutboundIPAddresses, ",")
+			}
+
+			functionApp.Identity = helpers.FlattenIdentity(existing.Identity)
+
+			functionApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
+
+			functionApp.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
+
+			functionApp.AuthSettings = helpers.FlattenAuthSettings(auth)
+
+			functionApp.Backup = helpers.FlattenBackupConfig(backup)
+
+			functionApp.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&functionApp)
+		},
+	}
+}
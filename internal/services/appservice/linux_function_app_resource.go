@@ -387,7 +387,7 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: utils.String(functionApp.Location),
-				Tags:     tags.FromTypedObject(functionApp.Tags),
+				Tags:     tags.FromTypedObject(metadata.Client.Tags, functionApp.Tags),
 				Kind:     utils.String("functionapp,linux"),
 				Identity: helpers.ExpandIdentity(functionApp.Identity),
 				SiteProperties: &web.SiteProperties{
@@ -522,7 +522,7 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 				Enabled:              utils.NormaliseNilableBool(functionApp.Enabled),
 				ClientCertMode:       string(functionApp.ClientCertMode),
 				DailyMemoryTimeQuota: int(utils.NormaliseNilableInt32(props.DailyMemoryTimeQuota)),
-				Tags:                 tags.ToTypedObject(functionApp.Tags),
+				Tags:                 tags.ToTypedObject(metadata.Client.Tags, functionApp.Tags, metadata.ResourceData.Get("tags").(map[string]interface{})),
 				Kind:                 utils.NormalizeNilableString(functionApp.Kind),
 			}
 
@@ -630,7 +630,7 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+				existing.Tags = tags.FromTypedObject(metadata.Client.Tags, state.Tags)
 			}
 
 			storageString := ""
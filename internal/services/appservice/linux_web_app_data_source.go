@@ -259,7 +259,7 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 			webApp.AppSettings, healthCheckCount = helpers.FlattenAppSettings(appSettings)
 			webApp.Kind = utils.NormalizeNilableString(existing.Kind)
 			webApp.Location = location.NormalizeNilable(existing.Location)
-			webApp.Tags = tags.ToTypedObject(existing.Tags)
+			webApp.Tags = tags.ToTypedObject(metadata.Client.Tags, existing.Tags, nil)
 			if props := existing.SiteProperties; props != nil {
 				if props.ClientAffinityEnabled != nil {
 					webApp.ClientAffinityEnabled = *props.ClientAffinityEnabled
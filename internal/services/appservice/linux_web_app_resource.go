@@ -283,7 +283,7 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
 				Identity: helpers.ExpandIdentity(webApp.Identity),
-				Tags:     tags.FromTypedObject(webApp.Tags),
+				Tags:     tags.FromTypedObject(metadata.Client.Tags, webApp.Tags),
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          utils.String(webApp.ServicePlanId),
 					Enabled:               utils.Bool(webApp.Enabled),
@@ -437,7 +437,7 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				Name:          id.SiteName,
 				ResourceGroup: id.ResourceGroup,
 				Location:      location.NormalizeNilable(webApp.Location),
-				Tags:          tags.ToTypedObject(webApp.Tags),
+				Tags:          tags.ToTypedObject(metadata.Client.Tags, webApp.Tags, metadata.ResourceData.Get("tags").(map[string]interface{})),
 			}
 
 			var healthCheckCount *int
@@ -585,7 +585,7 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+				existing.Tags = tags.FromTypedObject(metadata.Client.Tags, state.Tags)
 			}
 
 			if metadata.ResourceData.HasChange("site_config") {
@@ -25,8 +25,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 	if features.ThreePointOhBetaResources() {
 		return []sdk.DataSource{
 			AppServiceSourceControlTokenDataSource{},
+			LinuxFunctionAppDataSource{},
 			LinuxWebAppDataSource{},
 			ServicePlanDataSource{},
+			WindowsFunctionAppDataSource{},
 			WindowsWebAppDataSource{},
 		}
 	}
@@ -39,9 +41,14 @@ func (r Registration) Resources() []sdk.Resource {
 			AppServiceSourceControlResource{},
 			AppServiceSourceControlTokenResource{},
 			LinuxFunctionAppResource{},
+			LinuxFunctionAppSlotResource{},
 			LinuxWebAppResource{},
+			LinuxWebAppSlotResource{},
 			ServicePlanResource{},
+			WindowsFunctionAppResource{},
+			WindowsFunctionAppSlotResource{},
 			WindowsWebAppResource{},
+			WindowsWebAppSlotResource{},
 		}
 	}
 	return []sdk.Resource{}
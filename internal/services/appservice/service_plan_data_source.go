@@ -157,7 +157,7 @@ func (r ServicePlanDataSource) Read() sdk.ResourceFunc {
 
 				servicePlan.MaximumElasticWorkerCount = int(utils.NormaliseNilableInt32(props.MaximumElasticWorkerCount))
 			}
-			servicePlan.Tags = tags.ToTypedObject(existing.Tags)
+			servicePlan.Tags = tags.ToTypedObject(metadata.Client.Tags, existing.Tags, nil)
 
 			metadata.SetID(id)
 
@@ -180,7 +180,7 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 					Name: utils.String(servicePlan.Sku),
 				},
 				Location: utils.String(location.Normalize(servicePlan.Location)),
-				Tags:     tags.FromTypedObject(servicePlan.Tags),
+				Tags:     tags.FromTypedObject(metadata.Client.Tags, servicePlan.Tags),
 			}
 
 			if servicePlan.AppServiceEnvironmentId != "" {
@@ -278,7 +278,7 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 
 				state.MaximumElasticWorkerCount = int(utils.NormaliseNilableInt32(props.MaximumElasticWorkerCount))
 			}
-			state.Tags = tags.ToTypedObject(servicePlan.Tags)
+			state.Tags = tags.ToTypedObject(metadata.Client.Tags, servicePlan.Tags, metadata.ResourceData.Get("tags").(map[string]interface{}))
 
 			return metadata.Encode(&state)
 		},
@@ -338,7 +338,7 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				existing.Sku.Name = utils.String(state.Sku)
 			}
 			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+				existing.Tags = tags.FromTypedObject(metadata.Client.Tags, state.Tags)
 			}
 
 			if metadata.ResourceData.HasChange("number_of_workers") {
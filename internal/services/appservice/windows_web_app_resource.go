@@ -268,7 +268,7 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
-				Tags:     tags.FromTypedObject(webApp.Tags),
+				Tags:     tags.FromTypedObject(metadata.Client.Tags, webApp.Tags),
 				Identity: helpers.ExpandIdentity(webApp.Identity),
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          utils.String(webApp.ServicePlanId),
@@ -434,7 +434,7 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				Name:          id.SiteName,
 				ResourceGroup: id.ResourceGroup,
 				Location:      location.NormalizeNilable(webApp.Location),
-				Tags:          tags.ToTypedObject(webApp.Tags),
+				Tags:          tags.ToTypedObject(metadata.Client.Tags, webApp.Tags, metadata.ResourceData.Get("tags").(map[string]interface{})),
 			}
 
 			var healthCheckCount *int
@@ -587,7 +587,7 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+				existing.Tags = tags.FromTypedObject(metadata.Client.Tags, state.Tags)
 			}
 
 			currentStack := ""
@@ -79,7 +79,7 @@ func dataSourceArmAttestationProviderRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("attestation_uri", props.AttestUri)
 			d.Set("trust_model", props.TrustModel)
 		}
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(resp.Model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(resp.Model.Tags))
 	}
 
 	return nil
@@ -99,7 +99,7 @@ func resourceAttestationProviderCreate(d *pluginsdk.ResourceData, meta interface
 		Properties: attestationproviders.AttestationServiceCreationSpecificParams{
 			// AttestationPolicy was deprecated in October of 2019
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	// NOTE: This maybe an slice in a future release or even a slice of slices
@@ -155,7 +155,7 @@ func resourceAttestationProviderRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("attestation_uri", props.AttestUri)
 			d.Set("trust_model", props.TrustModel)
 		}
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 
 	return nil
@@ -173,7 +173,7 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 
 	updateParams := attestationproviders.AttestationServicePatchParams{}
 	if d.HasChange("tags") {
-		updateParams.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+		updateParams.Tags = tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, *id, updateParams); err != nil {
@@ -109,7 +109,7 @@ func resourceAutomationAccountCreateUpdate(d *pluginsdk.ResourceData, meta inter
 			Sku: &sku,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
@@ -175,7 +175,7 @@ func resourceAutomationAccountRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if t := resp.Tags; t != nil {
-		return tags.FlattenAndSet(d, t)
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, t)
 	}
 
 	return nil
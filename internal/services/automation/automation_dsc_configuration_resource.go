@@ -126,7 +126,7 @@ func resourceAutomationDscConfigurationCreateUpdate(d *pluginsdk.ResourceData, m
 			},
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, parameters); err != nil {
@@ -185,7 +185,7 @@ func resourceAutomationDscConfigurationRead(d *pluginsdk.ResourceData, meta inte
 
 	d.Set("content_embedded", content)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAutomationDscConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
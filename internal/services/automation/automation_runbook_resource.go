@@ -184,7 +184,7 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	contentLink := expandContentLink(d.Get("publish_content_link").([]interface{}))
@@ -329,7 +329,7 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if t := resp.Tags; t != nil {
-		return tags.FlattenAndSet(d, t)
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, t)
 	}
 
 	return nil
@@ -98,7 +98,7 @@ func resourceArmStackHCIClusterCreate(d *pluginsdk.ResourceData, meta interface{
 		ClusterProperties: &azurestackhci.ClusterProperties{
 			AadClientID: utils.String(d.Get("client_id").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("tenant_id"); ok {
@@ -146,7 +146,7 @@ func resourceArmStackHCIClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("tenant_id", props.AadTenantID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -162,7 +162,7 @@ func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{
 	cluster := azurestackhci.ClusterUpdate{}
 
 	if d.HasChange("tags") {
-		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		cluster.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, cluster); err != nil {
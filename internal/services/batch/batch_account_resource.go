@@ -162,7 +162,7 @@ func resourceBatchAccountCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			PublicNetworkAccess: batch.PublicNetworkAccessTypeEnabled,
 		},
 		Identity: identity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
@@ -271,7 +271,7 @@ func resourceBatchAccountRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("secondary_access_key", keys.Secondary)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBatchAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -301,7 +301,7 @@ func resourceBatchAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			},
 		},
 		Identity: identity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err = client.Update(ctx, id.ResourceGroup, id.BatchAccountName, parameters); err != nil {
@@ -197,7 +197,7 @@ func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta inter
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
@@ -258,7 +258,7 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("isolated_network_enabled", props.IsIsolated)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -296,7 +296,7 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
@@ -152,7 +152,7 @@ func resourceArmBotConnectionCreate(d *pluginsdk.ResourceData, meta interface{})
 		},
 		Kind:     botservice.KindBot,
 		Location: utils.String(d.Get("location").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("parameters"); ok {
@@ -201,7 +201,7 @@ func resourceArmBotConnectionRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmBotConnectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -223,7 +223,7 @@ func resourceArmBotConnectionUpdate(d *pluginsdk.ResourceData, meta interface{})
 		},
 		Kind:     botservice.KindBot,
 		Location: utils.String(d.Get("location").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("parameters"); ok {
@@ -97,7 +97,7 @@ func resourceHealthbotServiceCreate(d *pluginsdk.ResourceData, meta interface{})
 		Sku: &healthbot.Sku{
 			Name: healthbot.SkuName(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
@@ -145,7 +145,7 @@ func resourceHealthbotServiceRead(d *pluginsdk.ResourceData, meta interface{}) e
 	if props := resp.Properties; props != nil {
 		d.Set("bot_management_portal_url", props.BotManagementPortalLink)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceHealthbotServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -166,7 +166,7 @@ func resourceHealthbotServiceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.HealthBotName, parameters); err != nil {
@@ -184,7 +184,7 @@ func resourceBotWebAppCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
@@ -235,7 +235,7 @@ func resourceBotWebAppRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("luis_app_ids", props.LuisAppIds)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBotWebAppUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -269,7 +269,7 @@ func resourceBotWebAppUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, bot); err != nil {
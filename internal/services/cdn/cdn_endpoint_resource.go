@@ -246,7 +246,7 @@ func resourceCdnEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			IsHTTPSAllowed:             &httpsAllowed,
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("origin_host_header"); ok {
@@ -342,7 +342,7 @@ func resourceCdnEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			IsHTTPSAllowed:             utils.Bool(httpsAllowed),
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("origin_host_header"); ok {
@@ -476,7 +476,7 @@ func resourceCdnEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCdnEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -74,5 +74,5 @@ func dataSourceCdnProfileRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("sku", string(sku.Name))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
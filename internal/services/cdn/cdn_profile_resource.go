@@ -100,7 +100,7 @@ func resourceCdnProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
@@ -137,7 +137,7 @@ func resourceCdnProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: tags.Expand(newTags),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, newTags),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, props)
@@ -178,7 +178,7 @@ func resourceCdnProfileRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("sku", string(sku.Name))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCdnProfileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
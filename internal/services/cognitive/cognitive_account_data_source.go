@@ -111,7 +111,7 @@ func dataSourceCognitiveAccountRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("endpoint", props.Endpoint)
 		}
 
-		return tags.FlattenAndSet(d, flattenTags(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags))
 	}
 	return nil
 }
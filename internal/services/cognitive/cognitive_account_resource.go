@@ -588,7 +588,7 @@ func resourceCognitiveAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 			d.Set("local_auth_enabled", localAuthEnabled)
 		}
 
-		return tags.FlattenAndSet(d, flattenTags(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags))
 	}
 	return nil
 }
@@ -116,7 +116,7 @@ func resourceArmCommunicationServiceCreateUpdate(d *pluginsdk.ResourceData, meta
 		ServiceProperties: &communication.ServiceProperties{
 			DataLocation: utils.String(d.Get("data_location").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, &parameter)
@@ -178,7 +178,7 @@ func resourceArmCommunicationServiceRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("primary_key", keysResp.PrimaryKey)
 	d.Set("secondary_key", keysResp.SecondaryKey)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmCommunicationServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
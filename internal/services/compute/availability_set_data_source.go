@@ -89,5 +89,5 @@ func dataSourceAvailabilitySetRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("platform_fault_domain_count", strconv.Itoa(int(*v)))
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
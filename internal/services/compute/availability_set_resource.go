@@ -129,7 +129,7 @@ func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 			PlatformFaultDomainCount:  utils.Int32(int32(faultDomainCount)),
 			PlatformUpdateDomainCount: utils.Int32(int32(updateDomainCount)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
@@ -192,7 +192,7 @@ func resourceAvailabilitySetRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAvailabilitySetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -68,5 +68,5 @@ func dataSourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 	d.Set("dedicated_host_group_name", hostGroupName)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
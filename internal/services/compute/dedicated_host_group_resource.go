@@ -103,7 +103,7 @@ func resourceDedicatedHostGroupCreate(d *pluginsdk.ResourceData, meta interface{
 		DedicatedHostGroupProperties: &compute.DedicatedHostGroupProperties{
 			PlatformFaultDomainCount: utils.Int32(int32(platformFaultDomainCount)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 	if zones, ok := d.GetOk("zones"); ok {
 		parameters.Zones = utils.ExpandStringSlice(zones.([]interface{}))
@@ -165,7 +165,7 @@ func resourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDedicatedHostGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -178,7 +178,7 @@ func resourceDedicatedHostGroupUpdate(d *pluginsdk.ResourceData, meta interface{
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := compute.DedicatedHostGroupUpdate{
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Update(ctx, resourceGroupName, name, parameters); err != nil {
@@ -54,5 +54,5 @@ func dataSourceDiskAccessRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
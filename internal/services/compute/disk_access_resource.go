@@ -79,7 +79,7 @@ func resourceDiskAccessCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	createDiskAccess := compute.DiskAccess{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, createDiskAccess)
@@ -131,7 +131,7 @@ func resourceDiskAccessRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDiskAccessDelete(d *pluginsdk.ResourceData, meta interface{}) error {
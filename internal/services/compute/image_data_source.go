@@ -208,5 +208,5 @@ func dataSourceImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("zone_resilient", profile.ZoneResilient)
 	}
 
-	return tags.FlattenAndSet(d, img.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, img.Tags)
 }
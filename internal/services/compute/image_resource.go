@@ -214,7 +214,7 @@ func resourceImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	expandedTags := tags.Expand(d.Get("tags").(map[string]interface{}))
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 
 	properties := compute.ImageProperties{
 		HyperVGeneration: compute.HyperVGenerationTypes(hyperVGeneration),
@@ -320,7 +320,7 @@ func resourceImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("hyper_v_generation", string(resp.HyperVGeneration))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceImageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -121,7 +121,7 @@ func dataSourceImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
-	filterTags := tags.Expand(d.Get("tags_filter").(map[string]interface{}))
+	filterTags := tags.ExpandFilter(d.Get("tags_filter").(map[string]interface{}))
 
 	resp, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
 	if err != nil {
@@ -131,7 +131,7 @@ func dataSourceImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving Images (Resource Group %q): %+v", resourceGroup, err)
 	}
 
-	images, err := flattenImagesResult(ctx, resp, filterTags)
+	images, err := flattenImagesResult(ctx, meta.(*clients.Client).Tags, resp, filterTags)
 	if err != nil {
 		return fmt.Errorf("parsing Images (Resource Group %q): %+v", resourceGroup, err)
 	}
@@ -150,7 +150,7 @@ func dataSourceImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func flattenImagesResult(ctx context.Context, iterator compute.ImageListResultIterator, filterTags map[string]*string) ([]interface{}, error) {
+func flattenImagesResult(ctx context.Context, tagsConfig tags.ProviderConfiguration, iterator compute.ImageListResultIterator, filterTags map[string]*string) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 
 	for iterator.NotDone() {
@@ -167,7 +167,7 @@ func flattenImagesResult(ctx context.Context, iterator compute.ImageListResultIt
 		}
 
 		if found {
-			results = append(results, flattenImage(image))
+			results = append(results, flattenImage(tagsConfig, image))
 		}
 		if err := iterator.NextWithContext(ctx); err != nil {
 			return nil, err
@@ -177,7 +177,7 @@ func flattenImagesResult(ctx context.Context, iterator compute.ImageListResultIt
 	return results, nil
 }
 
-func flattenImage(input compute.Image) map[string]interface{} {
+func flattenImage(tagsConfig tags.ProviderConfiguration, input compute.Image) map[string]interface{} {
 	output := make(map[string]interface{})
 
 	output["name"] = input.Name
@@ -193,7 +193,7 @@ func flattenImage(input compute.Image) map[string]interface{} {
 		}
 	}
 
-	output["tags"] = tags.Flatten(input.Tags)
+	output["tags"] = tags.Flatten(tagsConfig, input.Tags)
 
 	return output
 }
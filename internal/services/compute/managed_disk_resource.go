@@ -431,7 +431,7 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Sku: &compute.DiskSku{
 			Name: skuName,
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.Expand(meta.(*clients.Client).Tags, t),
 		Zones: zones,
 	}
 
@@ -509,7 +509,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
-		diskUpdate.Tags = tags.Expand(t)
+		diskUpdate.Tags = tags.Expand(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("storage_account_type") {
@@ -845,7 +845,7 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("on_demand_bursting_enabled", onDemandBurstingEnabled)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceManagedDiskDelete(d *pluginsdk.ResourceData, meta interface{}) error {
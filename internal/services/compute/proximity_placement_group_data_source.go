@@ -59,5 +59,5 @@ func dataSourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta inter
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -79,7 +79,7 @@ func resourceProximityPlacementGroupCreateUpdate(d *pluginsdk.ResourceData, meta
 	ppg := compute.ProximityPlacementGroup{
 		Name:     &name,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	resp, err := client.CreateOrUpdate(ctx, resourceGroup, name, ppg)
@@ -117,7 +117,7 @@ func resourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceProximityPlacementGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
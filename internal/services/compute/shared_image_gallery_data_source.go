@@ -79,5 +79,5 @@ func dataSourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
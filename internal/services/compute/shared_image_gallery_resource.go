@@ -95,7 +95,7 @@ func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		GalleryProperties: &compute.GalleryProperties{
 			Description: utils.String(description),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gallery)
@@ -157,7 +157,7 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSharedImageGalleryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
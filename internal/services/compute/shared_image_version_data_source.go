@@ -147,7 +147,7 @@ func dataSourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return tags.FlattenAndSet(d, image.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, image.Tags)
 }
 
 func obtainImage(client *compute.GalleryImageVersionsClient, ctx context.Context, resourceGroup string, galleryName string, galleryImageName string, galleryImageVersionName string) (*compute.GalleryImageVersion, error) {
@@ -161,7 +161,7 @@ func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			},
 			StorageProfile: &compute.GalleryImageVersionStorageProfile{},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_image_id"); ok {
@@ -248,7 +248,7 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -100,7 +100,7 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	imageName := d.Get("image_name").(string)
 	galleryName := d.Get("gallery_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
-	filterTags := tags.Expand(d.Get("tags_filter").(map[string]interface{}))
+	filterTags := tags.ExpandFilter(d.Get("tags_filter").(map[string]interface{}))
 
 	resp, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
 	if err != nil {
@@ -118,7 +118,7 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	flattenedImages := flattenSharedImageVersions(images, filterTags)
+	flattenedImages := flattenSharedImageVersions(meta.(*clients.Client).Tags, images, filterTags)
 	if len(flattenedImages) == 0 {
 		return fmt.Errorf("unable to find any images")
 	}
@@ -136,11 +136,11 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
-func flattenSharedImageVersions(input []compute.GalleryImageVersion, filterTags map[string]*string) []interface{} {
+func flattenSharedImageVersions(tagsConfig tags.ProviderConfiguration, input []compute.GalleryImageVersion, filterTags map[string]*string) []interface{} {
 	results := make([]interface{}, 0)
 
 	for _, imageVersion := range input {
-		flattenedIPAddress := flattenSharedImageVersion(imageVersion)
+		flattenedIPAddress := flattenSharedImageVersion(tagsConfig, imageVersion)
 		found := true
 		// Loop through our filter tags and see if they match
 		for k, v := range filterTags {
@@ -160,7 +160,7 @@ func flattenSharedImageVersions(input []compute.GalleryImageVersion, filterTags
 	return results
 }
 
-func flattenSharedImageVersion(input compute.GalleryImageVersion) map[string]interface{} {
+func flattenSharedImageVersion(tagsConfig tags.ProviderConfiguration, input compute.GalleryImageVersion) map[string]interface{} {
 	output := make(map[string]interface{})
 
 	output["name"] = input.Name
@@ -182,7 +182,7 @@ func flattenSharedImageVersion(input compute.GalleryImageVersion) map[string]int
 		}
 	}
 
-	output["tags"] = tags.Flatten(input.Tags)
+	output["tags"] = tags.Flatten(tagsConfig, input.Tags)
 
 	return output
 }
@@ -0,0 +1,48 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestSharedImageVersionsTagsFilterIgnoresDefaultTags(t *testing.T) {
+	config := tags.ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"environment": "production",
+		},
+	}
+	filter := tags.ExpandFilter(map[string]interface{}{
+		"team": "compute",
+	})
+
+	if len(filter) != 1 {
+		t.Fatalf("expected the filter to only contain the `tags_filter` but got %+v", filter)
+	}
+
+	versions := []compute.GalleryImageVersion{
+		{
+			Name: utils.String("1.0.0"),
+			Tags: map[string]*string{
+				"team": utils.String("compute"),
+			},
+		},
+		{
+			Name: utils.String("2.0.0"),
+			Tags: map[string]*string{
+				"team":        utils.String("network"),
+				"environment": utils.String("production"),
+			},
+		},
+	}
+
+	results := flattenSharedImageVersions(config, versions, filter)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 Image Version to match the filter but got %d", len(results))
+	}
+	if name := results[0].(map[string]interface{})["name"].(*string); *name != "1.0.0" {
+		t.Fatalf("expected the Image Version `1.0.0` to match the filter but got %q", *name)
+	}
+}
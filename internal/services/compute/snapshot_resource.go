@@ -122,7 +122,7 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -212,7 +212,7 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
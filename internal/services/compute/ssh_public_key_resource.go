@@ -95,7 +95,7 @@ func resourceSshPublicKeyCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	params := compute.SSHPublicKeyResource{
 		Name:     utils.String(name),
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		SSHPublicKeyResourceProperties: &compute.SSHPublicKeyResourceProperties{
 			PublicKey: utils.String(public_key),
 		},
@@ -150,7 +150,7 @@ func resourceSshPublicKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("public_key", props.PublicKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSshPublicKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -185,7 +185,7 @@ func resourceSshPublicKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	if d.HasChange("tags") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = tags.Expand(meta.(*clients.Client).Tags, tagsRaw)
 	}
 
 	log.Printf("[DEBUG] Updating SSH Public Key %q (Resource Group %q)..", id.Name, id.ResourceGroup)
@@ -149,7 +149,7 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *pluginsdk.ResourceData, met
 			AutoUpgradeMinorVersion: &autoUpgradeMinor,
 			EnableAutomaticUpgrade:  &enableAutomaticUpgrade,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if settingsString := d.Get("settings").(string); settingsString != "" {
@@ -241,7 +241,7 @@ func resourceVirtualMachineExtensionsRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualMachineExtensionsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -7,10 +7,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/fluxconfiguration"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	ExtensionsClient                *extensions.ExtensionsClient
+	FluxConfigurationClient         *fluxconfiguration.FluxConfigurationClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
@@ -53,11 +57,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	extensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&extensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		ExtensionsClient:                &extensionsClient,
+		FluxConfigurationClient:         &fluxConfigurationClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
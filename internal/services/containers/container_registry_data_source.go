@@ -118,5 +118,5 @@ func dataSourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("admin_password", "")
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -512,7 +512,7 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 			NetworkRuleBypassOptions: containerregistry.NetworkRuleBypassOptions(d.Get("network_rule_bypass_option").(string)),
 		},
 
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
@@ -529,7 +529,7 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 	if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 {
 		newGeoReplicationLocations = expandReplicationsFromLocations(geoReplicationLocations.List())
 	} else {
-		newGeoReplicationLocations = expandReplications(geoReplications)
+		newGeoReplicationLocations = expandReplications(meta.(*clients.Client).Tags, geoReplications)
 	}
 	// geo replications have been specified
 	if len(newGeoReplicationLocations) > 0 {
@@ -624,7 +624,7 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 			NetworkRuleBypassOptions: containerregistry.NetworkRuleBypassOptions(d.Get("network_rule_bypass_option").(string)),
 		},
 		Identity: identity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	// geo replication is only supported by Premium Sku
@@ -634,7 +634,7 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	if hasGeoReplicationsChanges {
-		err := applyGeoReplicationLocations(d, meta, resourceGroup, name, expandReplications(oldReplications), expandReplications(newReplications))
+		err := applyGeoReplicationLocations(d, meta, resourceGroup, name, expandReplications(meta.(*clients.Client).Tags, oldReplications), expandReplications(meta.(*clients.Client).Tags, newReplications))
 		if err != nil {
 			return fmt.Errorf("applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -836,7 +836,7 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 				geoReplicationLocations = append(geoReplicationLocations, *value.Location)
 				replication := make(map[string]interface{})
 				replication["location"] = valueLocation
-				replication["tags"] = tags.Flatten(value.Tags)
+				replication["tags"] = tags.Flatten(meta.(*clients.Client).Tags, value.Tags)
 				replication["zone_redundancy_enabled"] = value.ZoneRedundancy == containerregistry.ZoneRedundancyEnabled
 				replication["regional_endpoint_enabled"] = value.RegionEndpointEnabled != nil && *value.RegionEndpointEnabled
 				geoReplications = append(geoReplications, replication)
@@ -850,7 +850,7 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 	// Deprecated as it is not returned by the API now.
 	d.Set("storage_account_id", "")
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceContainerRegistryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -971,7 +971,7 @@ func expandReplicationsFromLocations(p []interface{}) []containerregistry.Replic
 	return replications
 }
 
-func expandReplications(p []interface{}) []containerregistry.Replication {
+func expandReplications(tagsConfig tags.ProviderConfiguration, p []interface{}) []containerregistry.Replication {
 	replications := make([]containerregistry.Replication, 0)
 	if p == nil {
 		return replications
@@ -979,7 +979,7 @@ func expandReplications(p []interface{}) []containerregistry.Replication {
 	for _, v := range p {
 		value := v.(map[string]interface{})
 		location := azure.NormalizeLocation(value["location"])
-		tags := tags.Expand(value["tags"].(map[string]interface{}))
+		tags := tags.Expand(tagsConfig, value["tags"].(map[string]interface{}))
 		zoneRedundancy := containerregistry.ZoneRedundancyDisabled
 		if value["zone_redundancy_enabled"].(bool) {
 			zoneRedundancy = containerregistry.ZoneRedundancyEnabled
@@ -136,7 +136,7 @@ func resourceContainerRegistryWebhookCreate(d *pluginsdk.ResourceData, meta inte
 	webhook := containerregistry.WebhookCreateParameters{
 		Location:                          &location,
 		WebhookPropertiesCreateParameters: expandWebhookPropertiesCreateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, registryName, name, webhook)
@@ -178,7 +178,7 @@ func resourceContainerRegistryWebhookUpdate(d *pluginsdk.ResourceData, meta inte
 
 	webhook := containerregistry.WebhookUpdateParameters{
 		WebhookPropertiesUpdateParameters: expandWebhookPropertiesUpdateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.Name, webhook)
@@ -253,7 +253,7 @@ func resourceContainerRegistryWebhookRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("actions", webhookActions)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceContainerRegistryWebhookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -666,7 +666,7 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterDataSourceAgentPoolProfiles(props.AgentPoolProfiles)
+		agentPoolProfiles := flattenKubernetesClusterDataSourceAgentPoolProfiles(meta.(*clients.Client).Tags, props.AgentPoolProfiles)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("setting `agent_pool_profile`: %+v", err)
 		}
@@ -737,7 +737,7 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenKubernetesClusterDataSourceRoleBasedAccessControl(input *containerservice.ManagedClusterProperties) []interface{} {
@@ -989,7 +989,7 @@ func flattenKubernetesClusterDataSourceAddOnIdentityProfile(profile *containerse
 	return identity, nil
 }
 
-func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile) []interface{} {
+func flattenKubernetesClusterDataSourceAgentPoolProfiles(tagsConfig tags.ProviderConfiguration, input *[]containerservice.ManagedClusterAgentPoolProfile) []interface{} {
 	agentPoolProfiles := make([]interface{}, 0)
 
 	if input == nil {
@@ -1088,7 +1088,7 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 			"orchestrator_version":     orchestratorVersion,
 			"os_disk_size_gb":          osDiskSizeGb,
 			"os_type":                  string(profile.OsType),
-			"tags":                     tags.Flatten(profile.Tags),
+			"tags":                     tags.Flatten(tagsConfig, profile.Tags),
 			"type":                     string(profile.Type),
 			"upgrade_settings":         flattenUpgradeSettings(profile.UpgradeSettings),
 			"vm_size":                  vmSize,
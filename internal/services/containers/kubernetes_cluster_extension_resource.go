@@ -0,0 +1,378 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	managedClusterResourceProvider = "Microsoft.ContainerService"
+	managedClusterResourceType     = "managedClusters"
+)
+
+func resourceKubernetesClusterExtension() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesClusterExtensionCreate,
+		Read:   resourceKubernetesClusterExtensionRead,
+		Update: resourceKubernetesClusterExtensionUpdate,
+		Delete: resourceKubernetesClusterExtensionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := extensions.ParseExtensionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterID,
+			},
+
+			"extension_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"configuration_protected_settings": {
+				Type:      pluginsdk.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"configuration_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"release_namespace": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"target_namespace"},
+				ValidateFunc:  validate.KubernetesNamespaceName,
+			},
+
+			"release_train": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_namespace": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"release_namespace"},
+				ValidateFunc:  validate.KubernetesNamespaceName,
+			},
+
+			"version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"aks_assigned_identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"current_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesClusterExtensionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := extensions.NewExtensionID(clusterId.SubscriptionId, clusterId.ResourceGroup, managedClusterResourceProvider, managedClusterResourceType, clusterId.ManagedClusterName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_kubernetes_cluster_extension", id.ID())
+	}
+
+	// the minor version is only upgraded automatically when a specific version isn't pinned
+	version := d.Get("version").(string)
+	properties := extensions.ExtensionProperties{
+		AutoUpgradeMinorVersion:        utils.Bool(version == ""),
+		ConfigurationProtectedSettings: expandKubernetesClusterExtensionSettings(d.Get("configuration_protected_settings").(map[string]interface{})),
+		ConfigurationSettings:          expandKubernetesClusterExtensionSettings(d.Get("configuration_settings").(map[string]interface{})),
+		ExtensionType:                  utils.String(d.Get("extension_type").(string)),
+	}
+
+	if version != "" {
+		properties.Version = utils.String(version)
+	}
+
+	if releaseTrain := d.Get("release_train").(string); releaseTrain != "" {
+		properties.ReleaseTrain = utils.String(releaseTrain)
+	}
+
+	if releaseNamespace := d.Get("release_namespace").(string); releaseNamespace != "" {
+		properties.Scope = &extensions.Scope{
+			Cluster: &extensions.ScopeCluster{
+				ReleaseNamespace: utils.String(releaseNamespace),
+			},
+		}
+	}
+
+	if targetNamespace := d.Get("target_namespace").(string); targetNamespace != "" {
+		properties.Scope = &extensions.Scope{
+			Namespace: &extensions.ScopeNamespace{
+				TargetNamespace: utils.String(targetNamespace),
+			},
+		}
+	}
+
+	parameters := extensions.Extension{
+		Properties: &properties,
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceKubernetesClusterExtensionRead(d, meta)
+}
+
+func resourceKubernetesClusterExtensionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ExtensionName)
+	d.Set("cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("extension_type", props.ExtensionType)
+			d.Set("release_train", props.ReleaseTrain)
+			d.Set("version", props.Version)
+			d.Set("current_version", props.CurrentVersion)
+			d.Set("configuration_settings", flattenKubernetesClusterExtensionSettings(props.ConfigurationSettings))
+
+			releaseNamespace := ""
+			targetNamespace := ""
+			if scope := props.Scope; scope != nil {
+				if scope.Cluster != nil && scope.Cluster.ReleaseNamespace != nil {
+					releaseNamespace = *scope.Cluster.ReleaseNamespace
+				}
+				if scope.Namespace != nil && scope.Namespace.TargetNamespace != nil {
+					targetNamespace = *scope.Namespace.TargetNamespace
+				}
+			}
+			d.Set("release_namespace", releaseNamespace)
+			d.Set("target_namespace", targetNamespace)
+
+			if err := d.Set("aks_assigned_identity", flattenKubernetesClusterExtensionAksAssignedIdentity(props.AksAssignedIdentity)); err != nil {
+				return fmt.Errorf("setting `aks_assigned_identity`: %+v", err)
+			}
+		}
+	}
+
+	// the API doesn't return the values of `configuration_protected_settings` so we leave these as-is
+
+	return nil
+}
+
+func resourceKubernetesClusterExtensionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	properties := extensions.PatchExtensionProperties{}
+
+	if d.HasChange("configuration_protected_settings") {
+		properties.ConfigurationProtectedSettings = expandKubernetesClusterExtensionSettings(d.Get("configuration_protected_settings").(map[string]interface{}))
+	}
+
+	if d.HasChange("configuration_settings") {
+		properties.ConfigurationSettings = expandKubernetesClusterExtensionSettings(d.Get("configuration_settings").(map[string]interface{}))
+	}
+
+	if d.HasChange("release_train") {
+		properties.ReleaseTrain = utils.String(d.Get("release_train").(string))
+	}
+
+	if d.HasChange("version") {
+		version := d.Get("version").(string)
+		properties.AutoUpgradeMinorVersion = utils.Bool(version == "")
+		if version != "" {
+			properties.Version = utils.String(version)
+		}
+	}
+
+	parameters := extensions.PatchExtension{
+		Properties: &properties,
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKubernetesClusterExtensionRead(d, meta)
+}
+
+func resourceKubernetesClusterExtensionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterExtensionSettings(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return &output
+}
+
+func flattenKubernetesClusterExtensionSettings(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = v
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterExtensionAksAssignedIdentity(input *extensions.ExtensionPropertiesAksAssignedIdentity) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	identityType := ""
+	if input.Type != nil {
+		identityType = string(*input.Type)
+	}
+
+	principalId := ""
+	if input.PrincipalId != nil {
+		principalId = *input.PrincipalId
+	}
+
+	tenantId := ""
+	if input.TenantId != nil {
+		tenantId = *input.TenantId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         identityType,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
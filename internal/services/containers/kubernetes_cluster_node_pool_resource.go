@@ -364,7 +364,7 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
 		Mode:                   mode,
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
-		Tags:                   tags.Expand(t),
+		Tags:                   tags.Expand(meta.(*clients.Client).Tags, t),
 		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
 		VMSize:                 utils.String(vmSize),
 		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
@@ -604,7 +604,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
-		props.Tags = tags.Expand(t)
+		props.Tags = tags.Expand(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("upgrade_settings") {
@@ -803,7 +803,7 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKubernetesClusterNodePoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
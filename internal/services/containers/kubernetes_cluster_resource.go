@@ -1037,7 +1037,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 
 	// NOTE: we /could/ validate the default node pool version here - but since the entire cluster deployment
 	// will fail here this should be fine to omit for the Create
-	agentProfiles, err := ExpandDefaultNodePool(d)
+	agentProfiles, err := ExpandDefaultNodePool(meta.(*clients.Client).Tags, d)
 	if err != nil {
 		return fmt.Errorf("expanding `default_node_pool`: %+v", err)
 	}
@@ -1117,7 +1117,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			NodeResourceGroup:      utils.String(nodeResourceGroup),
 			DisableLocalAccounts:   utils.Bool(d.Get("local_account_disabled").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
@@ -1464,7 +1464,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 	if d.HasChange("tags") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
-		existing.Tags = tags.Expand(t)
+		existing.Tags = tags.Expand(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("windows_profile") {
@@ -1542,7 +1542,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 	if d.HasChange("default_node_pool") {
 		log.Printf("[DEBUG] Updating of Default Node Pool..")
 
-		agentProfiles, err := ExpandDefaultNodePool(d)
+		agentProfiles, err := ExpandDefaultNodePool(meta.(*clients.Client).Tags, d)
 		if err != nil {
 			return fmt.Errorf("expanding `default_node_pool`: %+v", err)
 		}
@@ -1672,7 +1672,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `auto_scaler_profile`: %+v", err)
 		}
 
-		flattenedDefaultNodePool, err := FlattenDefaultNodePool(props.AgentPoolProfiles, d)
+		flattenedDefaultNodePool, err := FlattenDefaultNodePool(meta.(*clients.Client).Tags, props.AgentPoolProfiles, d)
 		if err != nil {
 			return fmt.Errorf("flattening `default_node_pool`: %+v", err)
 		}
@@ -1752,7 +1752,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("maintenance_window", flattenKubernetesClusterMaintenanceConfiguration(props))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
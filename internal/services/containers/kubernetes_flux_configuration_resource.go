@@ -0,0 +1,768 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxRepositoryReferenceTypeBranch = "branch"
+	fluxRepositoryReferenceTypeCommit = "commit"
+	fluxRepositoryReferenceTypeSemver = "semver"
+	fluxRepositoryReferenceTypeTag    = "tag"
+
+	// these are the keys the Flux extension expects within `configurationProtectedSettings`
+	fluxProtectedSettingBucketSecretKey = "bucketSecretKey"
+	fluxProtectedSettingHttpsKey        = "httpsKey"
+	fluxProtectedSettingSshPrivateKey   = "sshPrivateKey"
+)
+
+func resourceKubernetesFluxConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesFluxConfigurationCreateUpdate,
+		Read:   resourceKubernetesFluxConfigurationRead,
+		Update: resourceKubernetesFluxConfigurationCreateUpdate,
+		Delete: resourceKubernetesFluxConfigurationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := fluxconfiguration.ParseFluxConfigurationID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(kubernetesFluxConfigurationCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesFluxConfigurationName,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterID,
+			},
+
+			"namespace": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesNamespaceName,
+			},
+
+			"kustomizations": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.KubernetesFluxKustomizationName,
+						},
+
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"depends_on": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.KubernetesFluxKustomizationName,
+							},
+						},
+
+						"garbage_collection_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"recreating_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"retry_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"sync_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"timeout_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"bucket": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"bucket", "git_repository"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"bucket_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"access_key": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							RequiredWith:  []string{"bucket.0.secret_key_base64"},
+							ConflictsWith: []string{"bucket.0.local_auth_reference"},
+						},
+
+						"secret_key_base64": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsBase64,
+							RequiredWith:  []string{"bucket.0.access_key"},
+							ConflictsWith: []string{"bucket.0.local_auth_reference"},
+						},
+
+						"local_auth_reference": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validate.KubernetesNamespaceName,
+							ConflictsWith: []string{"bucket.0.access_key", "bucket.0.secret_key_base64"},
+						},
+
+						"tls_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"sync_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"timeout_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"git_repository": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"bucket", "git_repository"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.KubernetesGitRepositoryUrl,
+						},
+
+						"reference_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fluxRepositoryReferenceTypeBranch,
+								fluxRepositoryReferenceTypeCommit,
+								fluxRepositoryReferenceTypeSemver,
+								fluxRepositoryReferenceTypeTag,
+							}, false),
+						},
+
+						"reference_value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"https_ca_cert_base64": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
+						"https_user": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							RequiredWith:  []string{"git_repository.0.https_key_base64"},
+							ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						},
+
+						"https_key_base64": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsBase64,
+							RequiredWith:  []string{"git_repository.0.https_user"},
+							ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						},
+
+						"ssh_private_key_base64": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsBase64,
+							ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user", "git_repository.0.https_key_base64"},
+						},
+
+						"ssh_known_hosts_base64": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
+						"local_auth_reference": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validate.KubernetesNamespaceName,
+							ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.https_key_base64", "git_repository.0.ssh_private_key_base64"},
+						},
+
+						"sync_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"timeout_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(fluxconfiguration.ScopeTypeNamespace),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(fluxconfiguration.ScopeTypeCluster),
+					string(fluxconfiguration.ScopeTypeNamespace),
+				}, false),
+			},
+
+			"continuous_reconciliation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesFluxConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := fluxconfiguration.NewFluxConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroup, managedClusterResourceProvider, managedClusterResourceType, clusterId.ManagedClusterName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_kubernetes_flux_configuration", id.ID())
+		}
+	}
+
+	scope := fluxconfiguration.ScopeType(d.Get("scope").(string))
+	protectedSettings := make(map[string]string)
+	properties := fluxconfiguration.FluxConfigurationProperties{
+		ConfigurationProtectedSettings: &protectedSettings,
+		Kustomizations:                 expandKubernetesFluxConfigurationKustomizations(d.Get("kustomizations").(*pluginsdk.Set).List()),
+		Namespace:                      utils.String(d.Get("namespace").(string)),
+		Scope:                          &scope,
+		Suspend:                        utils.Bool(!d.Get("continuous_reconciliation_enabled").(bool)),
+	}
+
+	if v := d.Get("git_repository").([]interface{}); len(v) > 0 {
+		sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+		properties.SourceKind = &sourceKind
+		properties.GitRepository = expandKubernetesFluxConfigurationGitRepository(v, protectedSettings)
+	}
+
+	if v := d.Get("bucket").([]interface{}); len(v) > 0 {
+		sourceKind := fluxconfiguration.SourceKindTypeBucket
+		properties.SourceKind = &sourceKind
+		properties.Bucket = expandKubernetesFluxConfigurationBucket(v, protectedSettings)
+	}
+
+	parameters := fluxconfiguration.FluxConfiguration{
+		Properties: &properties,
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceKubernetesFluxConfigurationRead(d, meta)
+}
+
+func resourceKubernetesFluxConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fluxconfiguration.ParseFluxConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FluxConfigurationName)
+	d.Set("cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("namespace", props.Namespace)
+
+			scope := ""
+			if props.Scope != nil {
+				scope = string(*props.Scope)
+			}
+			d.Set("scope", scope)
+
+			continuousReconciliationEnabled := true
+			if props.Suspend != nil {
+				continuousReconciliationEnabled = !*props.Suspend
+			}
+			d.Set("continuous_reconciliation_enabled", continuousReconciliationEnabled)
+
+			// the API doesn't return the protected settings, so these are taken from the existing state
+			if err := d.Set("git_repository", flattenKubernetesFluxConfigurationGitRepository(d, props.GitRepository)); err != nil {
+				return fmt.Errorf("setting `git_repository`: %+v", err)
+			}
+
+			if err := d.Set("bucket", flattenKubernetesFluxConfigurationBucket(d, props.Bucket)); err != nil {
+				return fmt.Errorf("setting `bucket`: %+v", err)
+			}
+
+			if err := d.Set("kustomizations", flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)); err != nil {
+				return fmt.Errorf("setting `kustomizations`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceKubernetesFluxConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fluxconfiguration.ParseFluxConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// kubernetesFluxConfigurationCustomizeDiff ensures the kustomization names are unique and that
+// each kustomization only depends on other kustomizations defined within this configuration
+func kubernetesFluxConfigurationCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	kustomizations := diff.Get("kustomizations").(*pluginsdk.Set).List()
+
+	names := make(map[string]bool)
+	for _, item := range kustomizations {
+		name := item.(map[string]interface{})["name"].(string)
+		if name == "" {
+			// the name isn't known until apply time
+			return nil
+		}
+		if names[name] {
+			return fmt.Errorf("kustomization names must be unique but %q is defined more than once", name)
+		}
+		names[name] = true
+	}
+
+	for _, item := range kustomizations {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		for _, dependsOn := range v["depends_on"].([]interface{}) {
+			dependency, ok := dependsOn.(string)
+			if !ok || dependency == "" {
+				continue
+			}
+			if dependency == name {
+				return fmt.Errorf("kustomization %q cannot depend on itself", name)
+			}
+			if !names[dependency] {
+				return fmt.Errorf("kustomization %q depends on %q which is not defined in this Flux Configuration", name, dependency)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []interface{}) *map[string]fluxconfiguration.KustomizationDefinition {
+	results := make(map[string]fluxconfiguration.KustomizationDefinition)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		kustomization := fluxconfiguration.KustomizationDefinition{
+			DependsOn:              utils.ExpandStringSlice(v["depends_on"].([]interface{})),
+			Force:                  utils.Bool(v["recreating_enabled"].(bool)),
+			Prune:                  utils.Bool(v["garbage_collection_enabled"].(bool)),
+			RetryIntervalInSeconds: utils.Int64(int64(v["retry_interval_in_seconds"].(int))),
+			SyncIntervalInSeconds:  utils.Int64(int64(v["sync_interval_in_seconds"].(int))),
+			TimeoutInSeconds:       utils.Int64(int64(v["timeout_in_seconds"].(int))),
+		}
+
+		if path := v["path"].(string); path != "" {
+			kustomization.Path = utils.String(path)
+		}
+
+		results[name] = kustomization
+	}
+
+	return &results
+}
+
+func expandKubernetesFluxConfigurationGitRepository(input []interface{}, protectedSettings map[string]string) *fluxconfiguration.GitRepositoryDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	referenceValue := utils.String(v["reference_value"].(string))
+	repositoryRef := fluxconfiguration.RepositoryRefDefinition{}
+	switch v["reference_type"].(string) {
+	case fluxRepositoryReferenceTypeBranch:
+		repositoryRef.Branch = referenceValue
+	case fluxRepositoryReferenceTypeCommit:
+		repositoryRef.Commit = referenceValue
+	case fluxRepositoryReferenceTypeSemver:
+		repositoryRef.Semver = referenceValue
+	case fluxRepositoryReferenceTypeTag:
+		repositoryRef.Tag = referenceValue
+	}
+
+	result := fluxconfiguration.GitRepositoryDefinition{
+		RepositoryRef:         &repositoryRef,
+		SyncIntervalInSeconds: utils.Int64(int64(v["sync_interval_in_seconds"].(int))),
+		TimeoutInSeconds:      utils.Int64(int64(v["timeout_in_seconds"].(int))),
+		Url:                   utils.String(v["url"].(string)),
+	}
+
+	if httpsCACert := v["https_ca_cert_base64"].(string); httpsCACert != "" {
+		result.HttpsCACert = utils.String(httpsCACert)
+	}
+
+	if httpsUser := v["https_user"].(string); httpsUser != "" {
+		result.HttpsUser = utils.String(httpsUser)
+	}
+
+	if localAuthRef := v["local_auth_reference"].(string); localAuthRef != "" {
+		result.LocalAuthRef = utils.String(localAuthRef)
+	}
+
+	if sshKnownHosts := v["ssh_known_hosts_base64"].(string); sshKnownHosts != "" {
+		result.SshKnownHosts = utils.String(sshKnownHosts)
+	}
+
+	if httpsKey := v["https_key_base64"].(string); httpsKey != "" {
+		protectedSettings[fluxProtectedSettingHttpsKey] = httpsKey
+	}
+
+	if sshPrivateKey := v["ssh_private_key_base64"].(string); sshPrivateKey != "" {
+		protectedSettings[fluxProtectedSettingSshPrivateKey] = sshPrivateKey
+	}
+
+	return &result
+}
+
+func expandKubernetesFluxConfigurationBucket(input []interface{}, protectedSettings map[string]string) *fluxconfiguration.BucketDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	result := fluxconfiguration.BucketDefinition{
+		BucketName:            utils.String(v["bucket_name"].(string)),
+		Insecure:              utils.Bool(!v["tls_enabled"].(bool)),
+		SyncIntervalInSeconds: utils.Int64(int64(v["sync_interval_in_seconds"].(int))),
+		TimeoutInSeconds:      utils.Int64(int64(v["timeout_in_seconds"].(int))),
+		Url:                   utils.String(v["url"].(string)),
+	}
+
+	if accessKey := v["access_key"].(string); accessKey != "" {
+		result.AccessKey = utils.String(accessKey)
+	}
+
+	if localAuthRef := v["local_auth_reference"].(string); localAuthRef != "" {
+		result.LocalAuthRef = utils.String(localAuthRef)
+	}
+
+	if secretKey := v["secret_key_base64"].(string); secretKey != "" {
+		protectedSettings[fluxProtectedSettingBucketSecretKey] = secretKey
+	}
+
+	return &result
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for name, item := range *input {
+		path := ""
+		if item.Path != nil {
+			path = *item.Path
+		}
+
+		garbageCollectionEnabled := false
+		if item.Prune != nil {
+			garbageCollectionEnabled = *item.Prune
+		}
+
+		recreatingEnabled := false
+		if item.Force != nil {
+			recreatingEnabled = *item.Force
+		}
+
+		var retryInterval, syncInterval, timeout int64
+		if item.RetryIntervalInSeconds != nil {
+			retryInterval = *item.RetryIntervalInSeconds
+		}
+		if item.SyncIntervalInSeconds != nil {
+			syncInterval = *item.SyncIntervalInSeconds
+		}
+		if item.TimeoutInSeconds != nil {
+			timeout = *item.TimeoutInSeconds
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                       name,
+			"path":                       path,
+			"depends_on":                 utils.FlattenStringSlice(item.DependsOn),
+			"garbage_collection_enabled": garbageCollectionEnabled,
+			"recreating_enabled":         recreatingEnabled,
+			"retry_interval_in_seconds":  int(retryInterval),
+			"sync_interval_in_seconds":   int(syncInterval),
+			"timeout_in_seconds":         int(timeout),
+		})
+	}
+
+	return results
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(d *pluginsdk.ResourceData, input *fluxconfiguration.GitRepositoryDefinition) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	referenceType := ""
+	referenceValue := ""
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			referenceType = fluxRepositoryReferenceTypeBranch
+			referenceValue = *ref.Branch
+		case ref.Commit != nil:
+			referenceType = fluxRepositoryReferenceTypeCommit
+			referenceValue = *ref.Commit
+		case ref.Semver != nil:
+			referenceType = fluxRepositoryReferenceTypeSemver
+			referenceValue = *ref.Semver
+		case ref.Tag != nil:
+			referenceType = fluxRepositoryReferenceTypeTag
+			referenceValue = *ref.Tag
+		}
+	}
+
+	httpsCACert := ""
+	if input.HttpsCACert != nil {
+		httpsCACert = *input.HttpsCACert
+	}
+
+	httpsUser := ""
+	if input.HttpsUser != nil {
+		httpsUser = *input.HttpsUser
+	}
+
+	localAuthRef := ""
+	if input.LocalAuthRef != nil {
+		localAuthRef = *input.LocalAuthRef
+	}
+
+	sshKnownHosts := ""
+	if input.SshKnownHosts != nil {
+		sshKnownHosts = *input.SshKnownHosts
+	}
+
+	var syncInterval, timeout int64
+	if input.SyncIntervalInSeconds != nil {
+		syncInterval = *input.SyncIntervalInSeconds
+	}
+	if input.TimeoutInSeconds != nil {
+		timeout = *input.TimeoutInSeconds
+	}
+
+	url := ""
+	if input.Url != nil {
+		url = *input.Url
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"url":                      url,
+			"reference_type":           referenceType,
+			"reference_value":          referenceValue,
+			"https_ca_cert_base64":     httpsCACert,
+			"https_user":               httpsUser,
+			"https_key_base64":         d.Get("git_repository.0.https_key_base64").(string),
+			"ssh_private_key_base64":   d.Get("git_repository.0.ssh_private_key_base64").(string),
+			"ssh_known_hosts_base64":   sshKnownHosts,
+			"local_auth_reference":     localAuthRef,
+			"sync_interval_in_seconds": int(syncInterval),
+			"timeout_in_seconds":       int(timeout),
+		},
+	}
+}
+
+func flattenKubernetesFluxConfigurationBucket(d *pluginsdk.ResourceData, input *fluxconfiguration.BucketDefinition) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	accessKey := ""
+	if input.AccessKey != nil {
+		accessKey = *input.AccessKey
+	}
+
+	bucketName := ""
+	if input.BucketName != nil {
+		bucketName = *input.BucketName
+	}
+
+	tlsEnabled := true
+	if input.Insecure != nil {
+		tlsEnabled = !*input.Insecure
+	}
+
+	localAuthRef := ""
+	if input.LocalAuthRef != nil {
+		localAuthRef = *input.LocalAuthRef
+	}
+
+	var syncInterval, timeout int64
+	if input.SyncIntervalInSeconds != nil {
+		syncInterval = *input.SyncIntervalInSeconds
+	}
+	if input.TimeoutInSeconds != nil {
+		timeout = *input.TimeoutInSeconds
+	}
+
+	url := ""
+	if input.Url != nil {
+		url = *input.Url
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"access_key":               accessKey,
+			"bucket_name":              bucketName,
+			"secret_key_base64":        d.Get("bucket.0.secret_key_base64").(string),
+			"local_auth_reference":     localAuthRef,
+			"tls_enabled":              tlsEnabled,
+			"sync_interval_in_seconds": int(syncInterval),
+			"timeout_in_seconds":       int(timeout),
+			"url":                      url,
+		},
+	}
+}
@@ -610,7 +610,7 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 	}
 }
 
-func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.ManagedClusterAgentPoolProfile, error) {
+func ExpandDefaultNodePool(tagsConfig tags.ProviderConfiguration, d *pluginsdk.ResourceData) (*[]containerservice.ManagedClusterAgentPoolProfile, error) {
 	input := d.Get("default_node_pool").([]interface{})
 
 	raw := input[0].(map[string]interface{})
@@ -640,7 +640,7 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		Name:                   utils.String(raw["name"].(string)),
 		NodeLabels:             nodeLabels,
 		NodeTaints:             nodeTaints,
-		Tags:                   tags.Expand(t),
+		Tags:                   tags.Expand(tagsConfig, t),
 		Type:                   containerservice.AgentPoolType(raw["type"].(string)),
 		VMSize:                 utils.String(raw["vm_size"].(string)),
 
@@ -940,7 +940,7 @@ func expandAgentPoolSysctlConfig(input []interface{}) (*containerservice.SysctlC
 	return result, nil
 }
 
-func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolProfile, d *pluginsdk.ResourceData) (*[]interface{}, error) {
+func FlattenDefaultNodePool(tagsConfig tags.ProviderConfiguration, input *[]containerservice.ManagedClusterAgentPoolProfile, d *pluginsdk.ResourceData) (*[]interface{}, error) {
 	if input == nil {
 		return &[]interface{}{}, nil
 	}
@@ -1086,7 +1086,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"os_disk_size_gb":              osDiskSizeGB,
 			"os_disk_type":                 string(osDiskType),
 			"os_sku":                       string(agentPool.OsSKU),
-			"tags":                         tags.Flatten(agentPool.Tags),
+			"tags":                         tags.Flatten(tagsConfig, agentPool.Tags),
 			"type":                         string(agentPool.Type),
 			"ultra_ssd_enabled":            enableUltraSSD,
 			"vm_size":                      vmSize,
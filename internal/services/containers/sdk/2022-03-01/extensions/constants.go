@@ -0,0 +1,136 @@
+package extensions
+
+import "strings"
+
+type AKSIdentityType string
+
+const (
+	AKSIdentityTypeSystemAssigned AKSIdentityType = "SystemAssigned"
+	AKSIdentityTypeUserAssigned   AKSIdentityType = "UserAssigned"
+)
+
+func PossibleValuesForAKSIdentityType() []string {
+	return []string{
+		string(AKSIdentityTypeSystemAssigned),
+		string(AKSIdentityTypeUserAssigned),
+	}
+}
+
+func parseAKSIdentityType(input string) (*AKSIdentityType, error) {
+	vals := map[string]AKSIdentityType{
+		"systemassigned": AKSIdentityTypeSystemAssigned,
+		"userassigned":   AKSIdentityTypeUserAssigned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AKSIdentityType(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type LevelType string
+
+const (
+	LevelTypeError       LevelType = "Error"
+	LevelTypeInformation LevelType = "Information"
+	LevelTypeWarning     LevelType = "Warning"
+)
+
+func PossibleValuesForLevelType() []string {
+	return []string{
+		string(LevelTypeError),
+		string(LevelTypeInformation),
+		string(LevelTypeWarning),
+	}
+}
+
+func parseLevelType(input string) (*LevelType, error) {
+	vals := map[string]LevelType{
+		"error":       LevelTypeError,
+		"information": LevelTypeInformation,
+		"warning":     LevelTypeWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LevelType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package extensions
+
+type ErrorAdditionalInfo struct {
+	Info *interface{} `json:"info,omitempty"`
+	Type *string      `json:"type,omitempty"`
+}
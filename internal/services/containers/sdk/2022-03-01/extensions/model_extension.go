@@ -0,0 +1,14 @@
+package extensions
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type Extension struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *identity.SystemAssigned `json:"identity,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *ExtensionProperties     `json:"properties,omitempty"`
+	SystemData *SystemData              `json:"systemData,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
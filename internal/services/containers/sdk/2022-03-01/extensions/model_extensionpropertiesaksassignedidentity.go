@@ -0,0 +1,7 @@
+package extensions
+
+type ExtensionPropertiesAksAssignedIdentity struct {
+	PrincipalId *string          `json:"principalId,omitempty"`
+	TenantId    *string          `json:"tenantId,omitempty"`
+	Type        *AKSIdentityType `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package extensions
+
+type ExtensionStatus struct {
+	Code          *string    `json:"code,omitempty"`
+	DisplayStatus *string    `json:"displayStatus,omitempty"`
+	Level         *LevelType `json:"level,omitempty"`
+	Message       *string    `json:"message,omitempty"`
+	Time          *string    `json:"time,omitempty"`
+}
@@ -0,0 +1,9 @@
+package extensions
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/extensions/%s", defaultApiVersion)
+}
@@ -0,0 +1,161 @@
+package fluxconfiguration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = FluxConfigurationId{}
+
+// FluxConfigurationId is a struct representing the Resource ID for a Flux Configuration
+type FluxConfigurationId struct {
+	SubscriptionId        string
+	ResourceGroupName     string
+	ClusterRp             string
+	ClusterResourceName   string
+	ClusterName           string
+	FluxConfigurationName string
+}
+
+// NewFluxConfigurationID returns a new FluxConfigurationId struct
+func NewFluxConfigurationID(subscriptionId string, resourceGroupName string, clusterRp string, clusterResourceName string, clusterName string, fluxConfigurationName string) FluxConfigurationId {
+	return FluxConfigurationId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroupName:     resourceGroupName,
+		ClusterRp:             clusterRp,
+		ClusterResourceName:   clusterResourceName,
+		ClusterName:           clusterName,
+		FluxConfigurationName: fluxConfigurationName,
+	}
+}
+
+// ParseFluxConfigurationID parses 'input' into a FluxConfigurationId
+func ParseFluxConfigurationID(input string) (*FluxConfigurationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(FluxConfigurationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := FluxConfigurationId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterRp, ok = parsed.Parsed["clusterRp"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterRp' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterResourceName, ok = parsed.Parsed["clusterResourceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterResourceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterName, ok = parsed.Parsed["clusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterName' was not found in the resource id %q", input)
+	}
+
+	if id.FluxConfigurationName, ok = parsed.Parsed["fluxConfigurationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'fluxConfigurationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseFluxConfigurationIDInsensitively parses 'input' case-insensitively into a FluxConfigurationId
+// note: this method should only be used for API response data and not user input
+func ParseFluxConfigurationIDInsensitively(input string) (*FluxConfigurationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(FluxConfigurationId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := FluxConfigurationId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterRp, ok = parsed.Parsed["clusterRp"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterRp' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterResourceName, ok = parsed.Parsed["clusterResourceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterResourceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterName, ok = parsed.Parsed["clusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterName' was not found in the resource id %q", input)
+	}
+
+	if id.FluxConfigurationName, ok = parsed.Parsed["fluxConfigurationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'fluxConfigurationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateFluxConfigurationID checks that 'input' can be parsed as a Flux Configuration ID
+func ValidateFluxConfigurationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseFluxConfigurationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Flux Configuration ID
+func (id FluxConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/%s/%s/%s/providers/Microsoft.KubernetesConfiguration/fluxConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ClusterRp, id.ClusterResourceName, id.ClusterName, id.FluxConfigurationName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Flux Configuration ID
+func (id FluxConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("subscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("resourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("providers", "providers", "providers"),
+		resourceids.UserSpecifiedSegment("clusterRp", "clusterRpValue"),
+		resourceids.UserSpecifiedSegment("clusterResourceName", "clusterResourceValue"),
+		resourceids.UserSpecifiedSegment("clusterName", "clusterValue"),
+		resourceids.StaticSegment("providers2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("microsoftKubernetesConfiguration", "Microsoft.KubernetesConfiguration", "Microsoft.KubernetesConfiguration"),
+		resourceids.StaticSegment("fluxConfigurations", "fluxConfigurations", "fluxConfigurations"),
+		resourceids.UserSpecifiedSegment("fluxConfigurationName", "fluxConfigurationValue"),
+	}
+}
+
+// String returns a human-readable description of this Flux Configuration ID
+func (id FluxConfigurationId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Cluster Rp: %q", id.ClusterRp),
+		fmt.Sprintf("Cluster Resource Name: %q", id.ClusterResourceName),
+		fmt.Sprintf("Cluster Name: %q", id.ClusterName),
+		fmt.Sprintf("Flux Configuration Name: %q", id.FluxConfigurationName),
+	}
+	return fmt.Sprintf("Flux Configuration (%s)", strings.Join(components, "\n"))
+}
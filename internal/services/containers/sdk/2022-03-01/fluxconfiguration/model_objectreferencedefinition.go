@@ -0,0 +1,6 @@
+package fluxconfiguration
+
+type ObjectReferenceDefinition struct {
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fluxconfiguration
+
+type ObjectStatusConditionDefinition struct {
+	LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+	Message            *string `json:"message,omitempty"`
+	Reason             *string `json:"reason,omitempty"`
+	Status             *string `json:"status,omitempty"`
+	Type               *string `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package fluxconfiguration
+
+type ObjectStatusDefinition struct {
+	AppliedBy             *ObjectReferenceDefinition         `json:"appliedBy,omitempty"`
+	ComplianceState       *FluxComplianceState               `json:"complianceState,omitempty"`
+	HelmReleaseProperties *HelmReleasePropertiesDefinition   `json:"helmReleaseProperties,omitempty"`
+	Kind                  *string                            `json:"kind,omitempty"`
+	Name                  *string                            `json:"name,omitempty"`
+	Namespace             *string                            `json:"namespace,omitempty"`
+	StatusConditions      *[]ObjectStatusConditionDefinition `json:"statusConditions,omitempty"`
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func KubernetesFluxConfigurationName(i interface{}, k string) (warnings []string, errors []error) {
+	return kubernetesFluxName(i, k)
+}
+
+func KubernetesFluxKustomizationName(i interface{}, k string) (warnings []string, errors []error) {
+	return kubernetesFluxName(i, k)
+}
+
+// kubernetesFluxName validates the name of a Flux object, which is used as a Kubernetes object name and is limited to 30 characters
+func kubernetesFluxName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	re := regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]{0,28}[a-z0-9])?$`)
+	if !re.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 30 characters, contain only lowercase letters, numbers, hyphens and periods, and must start and end with a lowercase letter or number. Got %q.", k, v))
+	}
+
+	return warnings, errors
+}
+
+func KubernetesNamespaceName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	re := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	if !re.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 63 characters, contain only lowercase letters, numbers and hyphens, and must start and end with a lowercase letter or number. Got %q.", k, v))
+	}
+
+	return warnings, errors
+}
+
+func KubernetesGitRepositoryUrl(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(v, "http://") && !strings.HasPrefix(v, "https://") && !strings.HasPrefix(v, "ssh://") {
+		errors = append(errors, fmt.Errorf("%s must start with `http://`, `https://` or `ssh://`. Got %q.", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,139 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestKubernetesFluxConfigurationName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "flux",
+			Errors: 0,
+		},
+		{
+			Name:   "flux-config.1",
+			Errors: 0,
+		},
+		{
+			Name:   "Flux",
+			Errors: 1,
+		},
+		{
+			Name:   "-flux",
+			Errors: 1,
+		},
+		{
+			Name:   "flux-",
+			Errors: 1,
+		},
+		{
+			Name:   "flux_config",
+			Errors: 1,
+		},
+		{
+			Name:   "abcdefghijklmnopqrstuvwxyz0123",
+			Errors: 0,
+		},
+		{
+			Name:   "abcdefghijklmnopqrstuvwxyz01234",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := KubernetesFluxConfigurationName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesFluxConfigurationName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestKubernetesNamespaceName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "flux-system",
+			Errors: 0,
+		},
+		{
+			Name:   "flux.system",
+			Errors: 1,
+		},
+		{
+			Name:   "Flux",
+			Errors: 1,
+		},
+		{
+			Name:   "abcdefghijklmnopqrstuvwxyz0123456789abcdefghijklmnopqrstuvwxyz0",
+			Errors: 0,
+		},
+		{
+			Name:   "abcdefghijklmnopqrstuvwxyz0123456789abcdefghijklmnopqrstuvwxyz01",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := KubernetesNamespaceName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesNamespaceName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestKubernetesGitRepositoryUrl(t *testing.T) {
+	cases := []struct {
+		Url    string
+		Errors int
+	}{
+		{
+			Url:    "",
+			Errors: 1,
+		},
+		{
+			Url:    "https://github.com/Azure/arc-k8s-demo",
+			Errors: 0,
+		},
+		{
+			Url:    "http://github.com/Azure/arc-k8s-demo",
+			Errors: 0,
+		},
+		{
+			Url:    "ssh://git@github.com/Azure/arc-k8s-demo.git",
+			Errors: 0,
+		},
+		{
+			Url:    "git@github.com:Azure/arc-k8s-demo.git",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Url, func(t *testing.T) {
+			_, errors := KubernetesGitRepositoryUrl(tc.Url, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesGitRepositoryUrl to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
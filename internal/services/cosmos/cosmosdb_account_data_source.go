@@ -354,7 +354,7 @@ func dataSourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("secondary_readonly_master_key", readonlyKeys.SecondaryReadonlyMasterKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func anyUnexpectedFailoverPriority(failoverPolicies []documentdb.FailoverPolicy) bool {
@@ -636,7 +636,7 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 			DisableLocalAuth:                   utils.Bool(disableLocalAuthentication),
 			DefaultIdentity:                    utils.String(d.Get("default_identity_type").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("analytical_storage"); ok {
@@ -779,7 +779,7 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			DisableLocalAuth:                   utils.Bool(disableLocalAuthentication),
 			DefaultIdentity:                    utils.String(d.Get("default_identity_type").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("analytical_storage"); ok {
@@ -1052,7 +1052,7 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 	d.Set("connection_strings", connStrings)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -64,5 +64,5 @@ func dataSourceCosmosDbMongoDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("account_name", id.DatabaseAccountName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
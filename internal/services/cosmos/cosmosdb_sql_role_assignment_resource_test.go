@@ -0,0 +1,135 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSQLRoleAssignmentResource struct{}
+
+func TestAccCosmosDbSQLRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_role_assignment", "test")
+	r := CosmosDbSQLRoleAssignmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbSQLRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_role_assignment", "test")
+	r := CosmosDbSQLRoleAssignmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCosmosDbSQLRoleAssignment_containerScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_role_assignment", "test")
+	r := CosmosDbSQLRoleAssignmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CosmosDbSQLRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SqlRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Cosmos.SqlResourceClient.GetSQLRoleAssignment(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving CosmosDb SQL Role Assignment %q: %+v", id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r CosmosDbSQLRoleAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_cosmosdb_sql_role_definition" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_cosmosdb_account.test.name
+  name                = "acctestsqlrole%d"
+  assignable_scopes   = [azurerm_cosmosdb_account.test.id]
+
+  permissions {
+    data_actions = ["Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/items/read"]
+  }
+}
+`, CosmosDbSQLRoleDefinitionResource{}.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbSQLRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_role_assignment" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_cosmosdb_account.test.name
+  role_definition_id  = azurerm_cosmosdb_sql_role_definition.test.id
+  principal_id        = data.azurerm_client_config.current.object_id
+  scope               = azurerm_cosmosdb_account.test.id
+}
+`, r.template(data))
+}
+
+func (r CosmosDbSQLRoleAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_role_assignment" "import" {
+  name                = azurerm_cosmosdb_sql_role_assignment.test.name
+  resource_group_name = azurerm_cosmosdb_sql_role_assignment.test.resource_group_name
+  account_name        = azurerm_cosmosdb_sql_role_assignment.test.account_name
+  role_definition_id  = azurerm_cosmosdb_sql_role_assignment.test.role_definition_id
+  principal_id        = azurerm_cosmosdb_sql_role_assignment.test.principal_id
+  scope               = azurerm_cosmosdb_sql_role_assignment.test.scope
+}
+`, r.basic(data))
+}
+
+func (r CosmosDbSQLRoleAssignmentResource) containerScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_role_assignment" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_cosmosdb_account.test.name
+  role_definition_id  = azurerm_cosmosdb_sql_role_definition.test.id
+  principal_id        = data.azurerm_client_config.current.object_id
+  scope               = "${azurerm_cosmosdb_account.test.id}/dbs/${azurerm_cosmosdb_sql_database.test.name}/colls/${azurerm_cosmosdb_sql_container.test.name}"
+}
+`, r.template(data))
+}
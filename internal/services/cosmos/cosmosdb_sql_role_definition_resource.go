@@ -0,0 +1,277 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCosmosDbSQLRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbSQLRoleDefinitionCreateUpdate,
+		Read:   resourceCosmosDbSQLRoleDefinitionRead,
+		Update: resourceCosmosDbSQLRoleDefinitionCreateUpdate,
+		Delete: resourceCosmosDbSQLRoleDefinitionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SqlRoleDefinitionID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceCosmosDbSQLRoleDefinitionCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.SqlRoleScope,
+				},
+			},
+
+			"permissions": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			// only custom roles can be created - the built-in roles are managed by Cosmos DB
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(documentdb.RoleDefinitionTypeCustomRole),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(documentdb.RoleDefinitionTypeCustomRole),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCosmosDbSQLRoleDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	if roleDefinitionId == "" {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for CosmosDb SQL Role Definition: %+v", err)
+		}
+
+		roleDefinitionId = uuid
+	}
+
+	id := parse.NewSqlRoleDefinitionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), roleDefinitionId)
+
+	if d.IsNewResource() {
+		existing, err := client.GetSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing CosmosDb SQL Role Definition %q: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_cosmosdb_sql_role_definition", id.ID())
+		}
+	}
+
+	parameters := documentdb.SQLRoleDefinitionCreateUpdateParameters{
+		SQLRoleDefinitionResource: &documentdb.SQLRoleDefinitionResource{
+			RoleName:         utils.String(d.Get("name").(string)),
+			Type:             documentdb.RoleDefinitionType(d.Get("type").(string)),
+			AssignableScopes: utils.ExpandStringSlice(d.Get("assignable_scopes").(*pluginsdk.Set).List()),
+			Permissions:      expandCosmosDbSQLRoleDefinitionPermissions(d.Get("permissions").(*pluginsdk.Set).List()),
+		},
+	}
+
+	future, err := client.CreateUpdateSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating CosmosDb SQL Role Definition %q: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for create/update of the CosmosDb SQL Role Definition %q: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCosmosDbSQLRoleDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbSQLRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] CosmosDb SQL Role Definition %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving CosmosDb SQL Role Definition %q: %+v", id, err)
+	}
+
+	d.Set("role_definition_id", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.DatabaseAccountName)
+
+	if props := resp.SQLRoleDefinitionResource; props != nil {
+		d.Set("name", props.RoleName)
+		d.Set("type", string(props.Type))
+		d.Set("assignable_scopes", utils.FlattenStringSlice(props.AssignableScopes))
+
+		if err := d.Set("permissions", flattenCosmosDbSQLRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permissions`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbSQLRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		return fmt.Errorf("deleting CosmosDb SQL Role Definition %q: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of the CosmosDb SQL Role Definition %q: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandCosmosDbSQLRoleDefinitionPermissions(input []interface{}) *[]documentdb.Permission {
+	permissions := make([]documentdb.Permission, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		permissions = append(permissions, documentdb.Permission{
+			DataActions: utils.ExpandStringSlice(v["data_actions"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &permissions
+}
+
+func flattenCosmosDbSQLRoleDefinitionPermissions(input *[]documentdb.Permission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"data_actions": utils.FlattenStringSlice(item.DataActions),
+		})
+	}
+
+	return results
+}
+
+// resourceCosmosDbSQLRoleDefinitionCustomizeDiff ensures that each of the assignable scopes is within the Database Account
+// the Role Definition belongs to, rather than this only being rejected by the API during the apply
+func resourceCosmosDbSQLRoleDefinitionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("assignable_scopes") || !d.NewValueKnown("account_name") || !d.NewValueKnown("resource_group_name") {
+		return nil
+	}
+
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	accountId := parse.NewDatabaseAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string))
+
+	for _, v := range d.Get("assignable_scopes").(*pluginsdk.Set).List() {
+		scope := v.(string)
+
+		// scopes which aren't known yet or aren't valid are skipped, since the latter are caught by the ValidateFunc
+		segments := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+		if len(segments) < 8 {
+			continue
+		}
+		scopeAccountId, err := parse.DatabaseAccountID("/" + strings.Join(segments[:8], "/"))
+		if err != nil {
+			continue
+		}
+
+		if !strings.EqualFold(scopeAccountId.SubscriptionId, accountId.SubscriptionId) || !strings.EqualFold(scopeAccountId.ResourceGroup, accountId.ResourceGroup) || !strings.EqualFold(scopeAccountId.Name, accountId.Name) {
+			return fmt.Errorf("the assignable scope %q must be within the %s", scope, accountId)
+		}
+	}
+
+	return nil
+}
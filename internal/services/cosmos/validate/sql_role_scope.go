@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+)
+
+// SqlRoleScope validates a Cosmos DB SQL data-plane scope, which is the Resource ID of a Database Account optionally
+// followed by a SQL Database (`/dbs/{database}`) or a SQL Container (`/dbs/{database}/colls/{container}`) path.
+func SqlRoleScope(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	// the Database Account ID is made up of 8 segments
+	segments := strings.Split(strings.TrimPrefix(v, "/"), "/")
+	if len(segments) < 8 {
+		errors = append(errors, fmt.Errorf("expected %q to begin with a Cosmos DB Account ID but got %q", key, v))
+		return
+	}
+
+	accountId := "/" + strings.Join(segments[:8], "/")
+	if _, err := parse.DatabaseAccountID(accountId); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to begin with a Cosmos DB Account ID: %+v", key, err))
+		return
+	}
+
+	path := segments[8:]
+	switch len(path) {
+	case 0:
+		return
+	case 2, 4:
+		if path[0] != "dbs" || path[1] == "" {
+			errors = append(errors, fmt.Errorf("expected %q to be in the format `{accountId}/dbs/{database}` but got %q", key, v))
+			return
+		}
+		if len(path) == 4 && (path[2] != "colls" || path[3] == "") {
+			errors = append(errors, fmt.Errorf("expected %q to be in the format `{accountId}/dbs/{database}/colls/{container}` but got %q", key, v))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("expected %q to be a Cosmos DB Account ID, optionally followed by `/dbs/{database}` or `/dbs/{database}/colls/{container}` but got %q", key, v))
+	}
+
+	return
+}
@@ -0,0 +1,87 @@
+package validate
+
+import "testing"
+
+func TestSqlRoleScope(t *testing.T) {
+	accountId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1"
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// resource group only
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Valid: false,
+		},
+		{
+			// missing account name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/",
+			Valid: false,
+		},
+		{
+			// account
+			Input: accountId,
+			Valid: true,
+		},
+		{
+			// database
+			Input: accountId + "/dbs/db1",
+			Valid: true,
+		},
+		{
+			// container
+			Input: accountId + "/dbs/db1/colls/coll1",
+			Valid: true,
+		},
+		{
+			// missing database name
+			Input: accountId + "/dbs/",
+			Valid: false,
+		},
+		{
+			// missing container name
+			Input: accountId + "/dbs/db1/colls/",
+			Valid: false,
+		},
+		{
+			// management plane database path
+			Input: accountId + "/sqlDatabases/db1",
+			Valid: false,
+		},
+		{
+			// management plane container path
+			Input: accountId + "/dbs/db1/containers/coll1",
+			Valid: false,
+		},
+		{
+			// wrong casing
+			Input: accountId + "/DBS/db1/COLLS/coll1",
+			Valid: false,
+		},
+		{
+			// too many segments
+			Input: accountId + "/dbs/db1/colls/coll1/docs/doc1",
+			Valid: false,
+		},
+		{
+			// wrong provider
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/acc1/dbs/db1",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := SqlRoleScope(tc.Input, "scope")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -144,7 +144,7 @@ func resourceCustomProviderCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			Validations:   expandCustomProviderValidation(d.Get("validation").(*pluginsdk.Set).List()),
 		},
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, provider)
@@ -207,7 +207,7 @@ func resourceCustomProviderRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `validation`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCustomProviderDelete(d *pluginsdk.ResourceData, meta interface{}) error {
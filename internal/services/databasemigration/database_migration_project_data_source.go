@@ -78,5 +78,5 @@ func dataSourceDatabaseMigrationProjectRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("target_platform", string(prop.TargetPlatform))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
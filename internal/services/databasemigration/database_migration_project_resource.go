@@ -112,7 +112,7 @@ func resourceDatabaseMigrationProjectCreateUpdate(d *pluginsdk.ResourceData, met
 			SourcePlatform: datamigration.ProjectSourcePlatform(sourcePlatform),
 			TargetPlatform: datamigration.ProjectTargetPlatform(targetPlatform),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, parameters, id.ResourceGroup, id.ServiceName, id.Name); err != nil {
@@ -153,7 +153,7 @@ func resourceDatabaseMigrationProjectRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("target_platform", string(prop.TargetPlatform))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDatabaseMigrationProjectDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -74,5 +74,5 @@ func dataSourceDatabaseMigrationServiceRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("sku_name", resp.Sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
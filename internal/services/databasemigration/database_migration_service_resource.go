@@ -111,7 +111,7 @@ func resourceDatabaseMigrationServiceCreate(d *pluginsdk.ResourceData, meta inte
 		Kind: utils.String("Cloud"), // currently only "Cloud" is supported, hence hardcode here
 	}
 	if t, ok := d.GetOk("tags"); ok {
-		parameters.Tags = tags.Expand(t.(map[string]interface{}))
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, t.(map[string]interface{}))
 	}
 
 	future, err := client.CreateOrUpdate(ctx, parameters, id.ResourceGroup, id.Name)
@@ -156,7 +156,7 @@ func resourceDatabaseMigrationServiceRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("sku_name", resp.Sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDatabaseMigrationServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -170,7 +170,7 @@ func resourceDatabaseMigrationServiceUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	parameters := datamigration.Service{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, parameters, id.ResourceGroup, id.Name)
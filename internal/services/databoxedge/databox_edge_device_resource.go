@@ -149,7 +149,7 @@ func resourceDataboxEdgeDeviceCreate(d *pluginsdk.ResourceData, meta interface{}
 	dataBoxEdgeDevice := databoxedge.Device{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Sku:      expandDeviceSku(d.Get("sku_name").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	future, err := client.CreateOrUpdate(ctx, name, dataBoxEdgeDevice, resourceGroup)
 	if err != nil {
@@ -213,7 +213,7 @@ func resourceDataboxEdgeDeviceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `sku_name`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDataboxEdgeDeviceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -228,7 +228,7 @@ func resourceDataboxEdgeDeviceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	parameters := databoxedge.DevicePatch{}
 	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.Name, parameters, id.ResourceGroup); err != nil {
@@ -336,7 +336,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	managedResourceGroupName := d.Get("managed_resource_group_name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	backendPool := d.Get("load_balancer_backend_address_pool_id").(string)
-	expandedTags := tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	expandedTags := tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 
 	if backendPool != "" {
 		backendPoolId, err := loadBalancerParse.LoadBalancerBackendAddressPoolID(backendPool)
@@ -445,7 +445,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 			ManagedResourceGroupId: managedResourceGroupID,
 			Parameters:             customParams,
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if requireNsgRules != "" {
@@ -599,7 +599,7 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 				d.Set("managed_services_cmk_key_vault_key_id", key.ID())
 			}
 		}
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 
 	return nil
@@ -262,7 +262,7 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	dataFactory := datafactory.Factory{
 		Location:          &location,
 		FactoryProperties: &datafactory.FactoryProperties{},
-		Tags:              tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:              tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	dataFactory.PublicNetworkAccess = datafactory.PublicNetworkAccessEnabled
@@ -435,7 +435,7 @@ func resourceDataFactoryRead(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 	d.Set("managed_virtual_network_enabled", managedVirtualNetworkEnabled)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDataFactoryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -109,7 +109,7 @@ func resourceArmDateLakeAnalyticsAccountCreate(d *pluginsdk.ResourceData, meta i
 
 	dateLakeAnalyticsAccount := accounts.CreateDataLakeAnalyticsAccountParameters{
 		Location: location,
-		Tags:     tagsHelper.Expand(t),
+		Tags:     tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 		Properties: accounts.CreateDataLakeAnalyticsAccountProperties{
 			NewTier:                     &tier,
 			DefaultDataLakeStoreAccount: storeAccountName,
@@ -145,7 +145,7 @@ func resourceArmDateLakeAnalyticsAccountUpdate(d *pluginsdk.ResourceData, meta i
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := accounts.UpdateDataLakeAnalyticsAccountParameters{
-		Tags: tagsHelper.Expand(newTags),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, newTags),
 		Properties: &accounts.UpdateDataLakeAnalyticsAccountProperties{
 			NewTier: &newTier,
 			DataLakeStoreAccounts: &[]accounts.UpdateDataLakeStoreWithAccountParameters{
@@ -200,7 +200,7 @@ func resourceArmDateLakeAnalyticsAccountRead(d *pluginsdk.ResourceData, meta int
 			d.Set("default_store_account_name", properties.DefaultDataLakeStoreAccount)
 		}
 
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 	return nil
 }
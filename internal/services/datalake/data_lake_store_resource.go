@@ -159,7 +159,7 @@ func resourceArmDateLakeStoreCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	dateLakeStore := accounts.CreateDataLakeStoreAccountParameters{
 		Location: location,
-		Tags:     tagsHelper.Expand(t),
+		Tags:     tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 		Identity: expandDataLakeStoreIdentity(d.Get("identity").([]interface{})),
 		Properties: &accounts.CreateDataLakeStoreAccountProperties{
 			NewTier:               &tier,
@@ -202,7 +202,7 @@ func resourceArmDateLakeStoreUpdate(d *pluginsdk.ResourceData, meta interface{})
 			FirewallState:         &firewallState,
 			FirewallAllowAzureIps: &firewallAllowAzureIPs,
 		},
-		Tags: tagsHelper.Expand(t),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 	}
 
 	if err := client.UpdateThenPoll(ctx, *id, props); err != nil {
@@ -277,7 +277,7 @@ func resourceArmDateLakeStoreRead(d *pluginsdk.ResourceData, meta interface{}) e
 			d.Set("endpoint", properties.Endpoint)
 		}
 
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 	return nil
 }
@@ -114,7 +114,7 @@ func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, me
 				}},
 		},
 		Identity: expandBackupVaultDppIdentityDetails(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	future, err := client.CreateOrUpdate(ctx, id.Name, id.ResourceGroup, parameters)
 	if err != nil {
@@ -160,7 +160,7 @@ func resourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta inter
 	if err := d.Set("identity", flattenBackupVaultDppIdentityDetails(resp.Identity)); err != nil {
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDataProtectionBackupVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
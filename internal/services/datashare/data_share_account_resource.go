@@ -104,7 +104,7 @@ func resourceDataShareAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		Name:     utils.String(name),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Identity: expandAzureRmDataShareAccountIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, account)
@@ -156,7 +156,7 @@ func resourceDataShareAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDataShareAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -172,7 +172,7 @@ func resourceDataShareAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 	props := datashare.AccountUpdateParameters{}
 
 	if d.HasChange("tags") {
-		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		props.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err = client.Update(ctx, id.ResourceGroup, id.Name, props); err != nil {
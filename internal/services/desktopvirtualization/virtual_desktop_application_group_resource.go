@@ -138,7 +138,7 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *pluginsdk.ResourceDat
 
 	context := desktopvirtualization.ApplicationGroup{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		ApplicationGroupProperties: &desktopvirtualization.ApplicationGroupProperties{
 			ApplicationGroupType: desktopvirtualization.ApplicationGroupType(d.Get("type").(string)),
 			FriendlyName:         utils.String(d.Get("friendly_name").(string)),
@@ -237,7 +237,7 @@ func resourceVirtualDesktopApplicationGroupRead(d *pluginsdk.ResourceData, meta
 
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualDesktopApplicationGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -196,7 +196,7 @@ func resourceVirtualDesktopHostPoolCreateUpdate(d *pluginsdk.ResourceData, meta
 
 	context := desktopvirtualization.HostPool{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		HostPoolProperties: &desktopvirtualization.HostPoolProperties{
 			HostPoolType:                  desktopvirtualization.HostPoolType(d.Get("type").(string)),
 			FriendlyName:                  utils.String(d.Get("friendly_name").(string)),
@@ -270,7 +270,7 @@ func resourceVirtualDesktopHostPoolRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualDesktopHostPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -104,7 +104,7 @@ func resourceArmDesktopVirtualizationWorkspaceCreateUpdate(d *pluginsdk.Resource
 
 	context := desktopvirtualization.Workspace{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		WorkspaceProperties: &desktopvirtualization.WorkspaceProperties{
 			Description:  utils.String(d.Get("description").(string)),
 			FriendlyName: utils.String(d.Get("friendly_name").(string)),
@@ -153,7 +153,7 @@ func resourceArmDesktopVirtualizationWorkspaceRead(d *pluginsdk.ResourceData, me
 		d.Set("friendly_name", props.FriendlyName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmDesktopVirtualizationWorkspaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
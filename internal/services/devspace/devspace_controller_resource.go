@@ -130,7 +130,7 @@ func resourceDevSpaceControllerCreate(d *pluginsdk.ResourceData, meta interface{
 			TargetContainerHostResourceID:        utils.String(d.Get("target_container_host_resource_id").(string)),
 			TargetContainerHostCredentialsBase64: utils.String(d.Get("target_container_host_credentials_base64").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, controller)
@@ -167,7 +167,7 @@ func resourceDevSpaceControllerUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 	params := devspaces.ControllerUpdateParameters{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	result, err := client.Update(ctx, id.ResourceGroup, id.Name, params)
@@ -220,7 +220,7 @@ func resourceDevSpaceControllerRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("target_container_host_resource_id", props.TargetContainerHostResourceID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDevSpaceControllerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
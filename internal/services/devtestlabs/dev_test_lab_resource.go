@@ -130,7 +130,7 @@ func resourceDevTestLabCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	parameters := dtl.Lab{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		LabProperties: &dtl.LabProperties{
 			LabStorageType: dtl.StorageType(storageType),
 		},
@@ -189,7 +189,7 @@ func resourceDevTestLabRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("unique_identifier", props.UniqueIdentifier)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, read.Tags)
 }
 
 func resourceDevTestLabDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -213,7 +213,7 @@ func resourceDevTestLabSchedulesCreateUpdate(d *pluginsdk.ResourceData, meta int
 	schedule := dtl.Schedule{
 		Location:           &location,
 		ScheduleProperties: &dtl.ScheduleProperties{},
-		Tags:               tags.Expand(t),
+		Tags:               tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	switch status := d.Get("status"); status {
@@ -312,7 +312,7 @@ func resourceDevTestLabSchedulesRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDevTestLabSchedulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
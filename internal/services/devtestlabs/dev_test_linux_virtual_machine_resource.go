@@ -223,7 +223,7 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *pluginsdk.ResourceData
 			StorageType:                utils.String(storageType),
 			UserName:                   utils.String(username),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LabName, id.VirtualMachineName, parameters)
@@ -286,7 +286,7 @@ func resourceArmDevTestLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta i
 		d.Set("unique_identifier", props.UniqueIdentifier)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, read.Tags)
 }
 
 func resourceArmDevTestLinuxVirtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
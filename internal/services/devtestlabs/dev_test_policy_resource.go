@@ -138,7 +138,7 @@ func resourceArmDevTestPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interf
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := dtl.Policy{
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 		PolicyProperties: &dtl.PolicyProperties{
 			FactName:      dtl.PolicyFactName(id.PolicyName),
 			FactData:      utils.String(factData),
@@ -190,7 +190,7 @@ func resourceArmDevTestPolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("threshold", props.Threshold)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, read.Tags)
 }
 
 func resourceArmDevTestPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
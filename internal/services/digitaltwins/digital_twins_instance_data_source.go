@@ -72,5 +72,5 @@ func dataSourceDigitalTwinsInstanceRead(d *pluginsdk.ResourceData, meta interfac
 
 	d.SetId(id)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -113,7 +113,7 @@ func resourceDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			ARecords:       expandAzureRmDnsARecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -176,7 +176,7 @@ func resourceDnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsARecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -117,7 +117,7 @@ func resourceDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			AaaaRecords:    expandAzureRmDnsAaaaRecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -180,7 +180,7 @@ func resourceDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsAaaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
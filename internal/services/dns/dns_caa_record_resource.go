@@ -128,7 +128,7 @@ func resourceDnsCaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			CaaRecords: expandAzureRmDnsCaaRecords(d),
 		},
@@ -174,7 +174,7 @@ func resourceDnsCaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsCaaRecords(resp.CaaRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsCaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -110,7 +110,7 @@ func resourceDnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			CnameRecord:    &dns.CnameRecord{},
 			TargetResource: &dns.SubResource{},
@@ -181,7 +181,7 @@ func resourceDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("target_resource_id", targetResourceId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsCNameRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
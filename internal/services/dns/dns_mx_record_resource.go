@@ -118,7 +118,7 @@ func resourceDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:       &ttl,
 			MxRecords: expandAzureRmDnsMxRecords(d),
 		},
@@ -162,7 +162,7 @@ func resourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsMxRecords(resp.MxRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -104,7 +104,7 @@ func resourceDnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:       &ttl,
 			NsRecords: records,
 		},
@@ -148,7 +148,7 @@ func resourceDnsNsRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
-		existing.RecordSetProperties.Metadata = tags.Expand(t)
+		existing.RecordSetProperties.Metadata = tags.Expand(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("ttl") {
@@ -196,7 +196,7 @@ func resourceDnsNsRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsNsRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
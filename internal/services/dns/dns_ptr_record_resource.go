@@ -100,7 +100,7 @@ func resourceDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	parameters := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			PtrRecords: expandAzureRmDnsPtrRecords(d),
 		},
@@ -147,7 +147,7 @@ func resourceDnsPtrRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("records", flattenAzureRmDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsPtrRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -123,7 +123,7 @@ func resourceDnsSrvRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			SrvRecords: expandAzureRmDnsSrvRecords(d),
 		},
@@ -168,7 +168,7 @@ func resourceDnsSrvRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsSrvRecords(resp.SrvRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsSrvRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -109,7 +109,7 @@ func resourceDnsTxtRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			TxtRecords: expandAzureRmDnsTxtRecords(d),
 		},
@@ -154,7 +154,7 @@ func resourceDnsTxtRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsTxtRecords(resp.TxtRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsTxtRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -175,7 +175,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 
 	parameters := dns.Zone{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	etag := ""
@@ -188,8 +188,9 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		soaRecord := v.([]interface{})[0].(map[string]interface{})
 		rsParameters := dns.RecordSet{
 			RecordSetProperties: &dns.RecordSetProperties{
-				TTL:       utils.Int64(int64(soaRecord["ttl"].(int))),
-				Metadata:  tags.Expand(soaRecord["tags"].(map[string]interface{})),
+				TTL: utils.Int64(int64(soaRecord["ttl"].(int))),
+				// the metadata of the SOA Record isn't a Resource's Tags, so the Provider's Default Tags don't apply
+				Metadata:  tags.Expand(tags.ProviderConfiguration{}, soaRecord["tags"].(map[string]interface{})),
 				SoaRecord: expandArmDNSZoneSOARecord(soaRecord),
 			},
 		}
@@ -251,7 +252,7 @@ func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -301,7 +302,8 @@ func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
 
 	metaData := make(map[string]interface{})
 	if input.Metadata != nil {
-		metaData = tags.Flatten(input.Metadata)
+		// the metadata of the SOA Record isn't a Resource's Tags, so the Provider's Default/Ignored Tags don't apply
+		metaData = tags.Flatten(tags.ProviderConfiguration{}, input.Metadata)
 	}
 
 	fqdn := ""
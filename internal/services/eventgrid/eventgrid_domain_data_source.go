@@ -183,5 +183,5 @@ func dataSourceEventGridDomainRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -198,7 +198,7 @@ func resourceEventGridDomainCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	domain := eventgrid.Domain{
 		Location:         &location,
 		DomainProperties: domainProperties,
-		Tags:             tags.Expand(t),
+		Tags:             tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("identity"); ok {
@@ -305,7 +305,7 @@ func resourceEventGridDomainRead(d *pluginsdk.ResourceData, meta interface{}) er
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
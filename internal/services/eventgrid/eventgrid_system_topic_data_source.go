@@ -99,5 +99,5 @@ func dataSourceEventGridSystemTopicRead(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
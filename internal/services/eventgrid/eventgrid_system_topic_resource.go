@@ -115,7 +115,7 @@ func resourceEventGridSystemTopicCreateUpdate(d *pluginsdk.ResourceData, meta in
 	systemTopic := eventgrid.SystemTopic{
 		Location:              &location,
 		SystemTopicProperties: systemTopicProperties,
-		Tags:                  tags.Expand(t),
+		Tags:                  tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("identity"); ok {
@@ -188,7 +188,7 @@ func resourceEventGridSystemTopicRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridSystemTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -91,5 +91,5 @@ func dataSourceEventGridTopicRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -198,7 +198,7 @@ func resourceEventGridTopicCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	topic := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: topicProperties,
-		Tags:            tags.Expand(t),
+		Tags:            tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("identity"); ok {
@@ -308,7 +308,7 @@ func resourceEventGridTopicRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -295,7 +295,7 @@ func resourceEventHubNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta inter
 			IsAutoInflateEnabled: utils.Bool(autoInflateEnabled),
 			ZoneRedundant:        utils.Bool(zoneRedundant),
 		},
-		Tags: tagsHelper.Expand(t),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 	}
 
 	if v := d.Get("dedicated_cluster_id").(string); v != "" {
@@ -391,7 +391,7 @@ func resourceEventHubNamespaceRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("dedicated_cluster_id", props.ClusterArmId)
 		}
 
-		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
@@ -210,5 +210,5 @@ func firewallDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, read.Tags)
 }
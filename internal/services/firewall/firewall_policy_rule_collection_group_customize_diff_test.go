@@ -0,0 +1,547 @@
+package firewall
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestParseFirewallPolicyPortRange(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected [2]int
+		Error    bool
+	}{
+		{
+			Input:    "80",
+			Expected: [2]int{80, 80},
+		},
+		{
+			Input:    "8000-8080",
+			Expected: [2]int{8000, 8080},
+		},
+		{
+			Input:    "*",
+			Expected: [2]int{1, 65535},
+		},
+		{
+			Input: "http",
+			Error: true,
+		},
+		{
+			Input: "8000-http",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseFirewallPolicyPortRange(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateFirewallPolicyApplicationRule(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input map[string]interface{}
+		Error bool
+	}{
+		{
+			Name: "fqdns",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_addresses":  {"10.0.0.0/24"},
+				"destination_fqdns": {"example.com"},
+			}, false, "Https:443"),
+		},
+		{
+			Name: "no sources",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"destination_fqdns": {"example.com"},
+			}, false, "Https:443"),
+			Error: true,
+		},
+		{
+			Name: "no destinations",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_ip_groups": {"ipgroup1"},
+			}, false, "Https:443"),
+			Error: true,
+		},
+		{
+			Name: "fqdn tags and fqdns",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_addresses":      {"10.0.0.0/24"},
+				"destination_fqdns":     {"example.com"},
+				"destination_fqdn_tags": {"WindowsUpdate"},
+			}, false, "Https:443"),
+			Error: true,
+		},
+		{
+			Name: "urls and fqdns",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_addresses":  {"10.0.0.0/24"},
+				"destination_fqdns": {"example.com"},
+				"destination_urls":  {"example.com/path"},
+			}, true, "Https:443"),
+			Error: true,
+		},
+		{
+			Name: "urls without terminating tls",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_addresses": {"10.0.0.0/24"},
+				"destination_urls": {"example.com/path"},
+			}, false, "Https:443"),
+			Error: true,
+		},
+		{
+			Name: "urls terminating tls",
+			Input: testFirewallPolicyApplicationRule("rule1", map[string][]string{
+				"source_addresses": {"10.0.0.0/24"},
+				"destination_urls": {"example.com/path"},
+			}, true, "Https:443"),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, err := validateFirewallPolicyApplicationRule("collection1", v.Input)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestValidateFirewallPolicyNetworkRule(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input map[string]interface{}
+		Error bool
+	}{
+		{
+			Name: "addresses",
+			Input: testFirewallPolicyRule("rule1", map[string][]string{
+				"protocols":             {"TCP"},
+				"source_addresses":      {"10.0.0.0/24"},
+				"destination_addresses": {"192.168.0.1"},
+				"destination_ports":     {"443", "8000-8080"},
+			}),
+		},
+		{
+			Name: "any port",
+			Input: testFirewallPolicyRule("rule1", map[string][]string{
+				"protocols":             {"ICMP"},
+				"source_ip_groups":      {"ipgroup1"},
+				"destination_ip_groups": {"ipgroup2"},
+				"destination_ports":     {"*"},
+			}),
+		},
+		{
+			Name: "no sources",
+			Input: testFirewallPolicyRule("rule1", map[string][]string{
+				"protocols":         {"TCP"},
+				"destination_fqdns": {"example.com"},
+				"destination_ports": {"443"},
+			}),
+			Error: true,
+		},
+		{
+			Name: "no destinations",
+			Input: testFirewallPolicyRule("rule1", map[string][]string{
+				"protocols":         {"TCP"},
+				"source_addresses":  {"10.0.0.0/24"},
+				"destination_ports": {"443"},
+			}),
+			Error: true,
+		},
+		{
+			Name: "invalid port",
+			Input: testFirewallPolicyRule("rule1", map[string][]string{
+				"protocols":             {"TCP"},
+				"source_addresses":      {"10.0.0.0/24"},
+				"destination_addresses": {"192.168.0.1"},
+				"destination_ports":     {"https"},
+			}),
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, err := validateFirewallPolicyNetworkRule("collection1", v.Input)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestValidateFirewallPolicyNatRule(t *testing.T) {
+	testData := []struct {
+		Name              string
+		Input             map[string][]string
+		TranslatedAddress string
+		TranslatedFqdn    string
+		Error             bool
+	}{
+		{
+			Name: "translated address",
+			Input: map[string][]string{
+				"protocols":         {"TCP"},
+				"source_addresses":  {"*"},
+				"destination_ports": {"443"},
+			},
+			TranslatedAddress: "10.0.0.4",
+		},
+		{
+			Name: "translated fqdn",
+			Input: map[string][]string{
+				"protocols":         {"TCP"},
+				"source_ip_groups":  {"ipgroup1"},
+				"destination_ports": {"443"},
+			},
+			TranslatedFqdn: "internal.example.com",
+		},
+		{
+			Name: "translated address and fqdn",
+			Input: map[string][]string{
+				"protocols":         {"TCP"},
+				"source_addresses":  {"*"},
+				"destination_ports": {"443"},
+			},
+			TranslatedAddress: "10.0.0.4",
+			TranslatedFqdn:    "internal.example.com",
+			Error:             true,
+		},
+		{
+			Name: "no translation",
+			Input: map[string][]string{
+				"protocols":         {"TCP"},
+				"source_addresses":  {"*"},
+				"destination_ports": {"443"},
+			},
+			Error: true,
+		},
+		{
+			Name: "no sources",
+			Input: map[string][]string{
+				"protocols":         {"TCP"},
+				"destination_ports": {"443"},
+			},
+			TranslatedAddress: "10.0.0.4",
+			Error:             true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, err := validateFirewallPolicyNatRule("collection1", testFirewallPolicyNatRule("rule1", "203.0.113.1", v.TranslatedAddress, v.TranslatedFqdn, v.Input))
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestValidateFirewallPolicyRuleOverlaps(t *testing.T) {
+	networkRule := func(collectionName, name, protocol, port string, destinations ...string) firewallPolicyRuleTarget {
+		target, err := validateFirewallPolicyNetworkRule(collectionName, testFirewallPolicyRule(name, map[string][]string{
+			"protocols":             {protocol},
+			"source_addresses":      {"10.0.0.0/24"},
+			"destination_addresses": destinations,
+			"destination_ports":     {port},
+		}))
+		if err != nil {
+			t.Fatalf("building network rule %q: %+v", name, err)
+		}
+		return *target
+	}
+	applicationRule := func(collectionName, name, protocol string, fqdns ...string) firewallPolicyRuleTarget {
+		target, err := validateFirewallPolicyApplicationRule(collectionName, testFirewallPolicyApplicationRule(name, map[string][]string{
+			"source_addresses":  {"10.0.0.0/24"},
+			"destination_fqdns": fqdns,
+		}, false, protocol))
+		if err != nil {
+			t.Fatalf("building application rule %q: %+v", name, err)
+		}
+		return *target
+	}
+	natRule := func(collectionName, name, destinationAddress, port string) firewallPolicyRuleTarget {
+		target, err := validateFirewallPolicyNatRule(collectionName, testFirewallPolicyNatRule(name, destinationAddress, "10.0.0.4", "", map[string][]string{
+			"protocols":         {"TCP"},
+			"source_addresses":  {"*"},
+			"destination_ports": {port},
+		}))
+		if err != nil {
+			t.Fatalf("building nat rule %q: %+v", name, err)
+		}
+		return *target
+	}
+
+	testData := []struct {
+		Name  string
+		Input []firewallPolicyRuleTarget
+		Error bool
+	}{
+		{
+			Name: "network rules with overlapping ports",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "TCP", "8000-8080", "192.168.0.1", "192.168.0.2"),
+				networkRule("collection1", "rule2", "TCP", "8080", "192.168.0.2"),
+			},
+			Error: true,
+		},
+		{
+			Name: "network rules with any protocol",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "Any", "*", "192.168.0.1"),
+				networkRule("collection1", "rule2", "UDP", "53", "192.168.0.1"),
+			},
+			Error: true,
+		},
+		{
+			Name: "network rules with distinct ports",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "TCP", "8000-8080", "192.168.0.1"),
+				networkRule("collection1", "rule2", "TCP", "8081", "192.168.0.1"),
+			},
+		},
+		{
+			Name: "network rules with distinct protocols",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "TCP", "53", "192.168.0.1"),
+				networkRule("collection1", "rule2", "UDP", "53", "192.168.0.1"),
+			},
+		},
+		{
+			Name: "network rules with distinct destinations",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "TCP", "443", "192.168.0.1"),
+				networkRule("collection1", "rule2", "TCP", "443", "192.168.0.2"),
+			},
+		},
+		{
+			Name: "network rules in different collections",
+			Input: []firewallPolicyRuleTarget{
+				networkRule("collection1", "rule1", "TCP", "443", "192.168.0.1"),
+				networkRule("collection2", "rule1", "TCP", "443", "192.168.0.1"),
+			},
+		},
+		{
+			Name: "application rules with a common fqdn",
+			Input: []firewallPolicyRuleTarget{
+				applicationRule("collection1", "rule1", "Https:443", "example.com", "example.org"),
+				applicationRule("collection1", "rule2", "Https:443", "EXAMPLE.COM"),
+			},
+			Error: true,
+		},
+		{
+			Name: "application rules with distinct protocols",
+			Input: []firewallPolicyRuleTarget{
+				applicationRule("collection1", "rule1", "Https:443", "example.com"),
+				applicationRule("collection1", "rule2", "Http:80", "example.com"),
+			},
+		},
+		{
+			Name: "nat rules in different collections",
+			Input: []firewallPolicyRuleTarget{
+				natRule("collection1", "rule1", "203.0.113.1", "443"),
+				natRule("collection2", "rule1", "203.0.113.1", "400-500"),
+			},
+			Error: true,
+		},
+		{
+			Name: "nat rules with distinct destination addresses",
+			Input: []firewallPolicyRuleTarget{
+				natRule("collection1", "rule1", "203.0.113.1", "443"),
+				natRule("collection1", "rule2", "203.0.113.2", "443"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateFirewallPolicyRuleOverlaps(v.Input)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestFirewallPolicyRuleCollectionGroupConfigRules(t *testing.T) {
+	rule := func(name string, ports cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":              cty.StringVal(name),
+			"destination_ports": ports,
+		})
+	}
+	collection := func(name string, rules ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"rule": cty.TupleVal(rules),
+		})
+	}
+	config := func(application, network, nat []cty.Value) cty.Value {
+		collections := func(input []cty.Value) cty.Value {
+			if input == nil {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			return cty.TupleVal(input)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"application_rule_collection": collections(application),
+			"network_rule_collection":     collections(network),
+			"nat_rule_collection":         collections(nat),
+		})
+	}
+	knownPorts := cty.TupleVal([]cty.Value{cty.StringVal("443")})
+	unknownPorts := cty.UnknownVal(cty.List(cty.String))
+
+	testData := []struct {
+		Name     string
+		Input    cty.Value
+		Expected map[string]bool
+		Error    bool
+	}{
+		{
+			Name:     "null configuration",
+			Input:    cty.NullVal(cty.DynamicPseudoType),
+			Expected: nil,
+		},
+		{
+			Name: "known and unknown rules",
+			Input: config(nil, []cty.Value{
+				collection("collection1", rule("rule1", knownPorts), rule("rule2", unknownPorts)),
+			}, []cty.Value{
+				collection("collection2", rule("rule1", knownPorts)),
+			}),
+			Expected: map[string]bool{
+				"network_rule_collection/collection1/rule1": true,
+				"network_rule_collection/collection1/rule2": false,
+				"nat_rule_collection/collection2/rule1":     true,
+			},
+		},
+		{
+			Name: "duplicate collection names",
+			Input: config(nil, []cty.Value{
+				collection("collection1", rule("rule1", knownPorts)),
+				collection("collection1", rule("rule2", knownPorts)),
+			}, nil),
+			Error: true,
+		},
+		{
+			Name: "duplicate collection names across collection types",
+			Input: config([]cty.Value{
+				collection("collection1", rule("rule1", knownPorts)),
+			}, []cty.Value{
+				collection("collection1", rule("rule2", knownPorts)),
+			}, nil),
+			Error: true,
+		},
+		{
+			Name: "duplicate rule names",
+			Input: config(nil, nil, []cty.Value{
+				collection("collection1", rule("rule1", knownPorts), rule("rule1", unknownPorts)),
+			}),
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := firewallPolicyRuleCollectionGroupConfigRules(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if (actual == nil) != (v.Expected == nil) || len(actual) != len(v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+		for key, known := range v.Expected {
+			if actual[key] != known {
+				t.Fatalf("expected %q to be %t but got %t", key, known, actual[key])
+			}
+		}
+	}
+}
+
+func testFirewallPolicyRule(name string, values map[string][]string) map[string]interface{} {
+	rule := map[string]interface{}{
+		"name": name,
+	}
+	for _, key := range []string{"protocols", "source_addresses", "source_ip_groups", "destination_addresses", "destination_ip_groups", "destination_fqdns", "destination_fqdn_tags", "destination_urls", "destination_ports", "web_categories"} {
+		items := make([]interface{}, 0)
+		for _, v := range values[key] {
+			items = append(items, v)
+		}
+		rule[key] = pluginsdk.NewSet(pluginsdk.HashString, items)
+	}
+	return rule
+}
+
+func testFirewallPolicyApplicationRule(name string, values map[string][]string, terminateTls bool, protocols ...string) map[string]interface{} {
+	rule := testFirewallPolicyRule(name, values)
+	rule["terminate_tls"] = terminateTls
+
+	items := make([]interface{}, 0)
+	for _, v := range protocols {
+		protocol := strings.SplitN(v, ":", 2)
+		port, err := strconv.Atoi(protocol[1])
+		if err != nil {
+			panic(fmt.Sprintf("parsing protocol %q: %+v", v, err))
+		}
+		items = append(items, map[string]interface{}{
+			"type": protocol[0],
+			"port": port,
+		})
+	}
+	rule["protocols"] = pluginsdk.NewSet(func(v interface{}) int {
+		protocol := v.(map[string]interface{})
+		return pluginsdk.HashString(fmt.Sprintf("%s:%d", protocol["type"], protocol["port"]))
+	}, items)
+	return rule
+}
+
+func testFirewallPolicyNatRule(name, destinationAddress, translatedAddress, translatedFqdn string, values map[string][]string) map[string]interface{} {
+	rule := testFirewallPolicyRule(name, values)
+	rule["destination_address"] = destinationAddress
+	rule["translated_address"] = translatedAddress
+	rule["translated_fqdn"] = translatedFqdn
+	return rule
+}
@@ -1,13 +1,18 @@
 package firewall
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -20,6 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	// the maximum number of rules (across all rule collections) which a Rule Collection Group can contain
+	firewallPolicyRuleCollectionGroupMaxRules = 10000
+
+	// the maximum number of DNAT rules which an Azure Firewall (Standard or Premium) supports across its Public IPs
+	firewallPolicyMaxNatRules = 250
+)
+
 func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
@@ -39,6 +52,8 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(firewallPolicyRuleCollectionGroupCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -64,6 +79,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -88,6 +104,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Required: true,
 							MinItems: 1,
+							Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"name": {
@@ -200,6 +217,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -224,6 +242,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Required: true,
 							MinItems: 1,
+							Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"name": {
@@ -311,6 +330,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -338,6 +358,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Required: true,
 							MinItems: 1,
+							Set:      resourceFirewallPolicyRuleCollectionGroupNameHash,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"name": {
@@ -555,6 +576,384 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+// firewallPolicyRuleCollectionGroupCustomizeDiff catches invalid or conflicting rules - duplicate names, missing or
+// conflicting sources and destinations and breaches of the service limits - at plan time, since otherwise these only
+// surface once the (long running) update of the Firewall Policy fails
+func firewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	knownRules, err := firewallPolicyRuleCollectionGroupConfigRules(diff.GetRawConfig())
+	if err != nil {
+		return err
+	}
+
+	targets := make([]firewallPolicyRuleTarget, 0)
+	totalRules := 0
+	natRules := 0
+
+	for _, collectionType := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		for _, c := range diff.Get(collectionType).(*pluginsdk.Set).List() {
+			collection := c.(map[string]interface{})
+			collectionName := collection["name"].(string)
+
+			rules := collection["rule"].(*pluginsdk.Set).List()
+			totalRules += len(rules)
+			if collectionType == "nat_rule_collection" {
+				natRules += len(rules)
+			}
+
+			for _, r := range rules {
+				rule := r.(map[string]interface{})
+
+				ruleName := rule["name"].(string)
+				if ruleName == "" {
+					// the name isn't known until apply time
+					continue
+				}
+				if knownRules != nil && !knownRules[firewallPolicyRuleKey(collectionType, collectionName, ruleName)] {
+					// the rule can't be validated until the values it references are known
+					continue
+				}
+
+				var target *firewallPolicyRuleTarget
+				var err error
+				switch collectionType {
+				case "application_rule_collection":
+					target, err = validateFirewallPolicyApplicationRule(collectionName, rule)
+				case "network_rule_collection":
+					target, err = validateFirewallPolicyNetworkRule(collectionName, rule)
+				case "nat_rule_collection":
+					target, err = validateFirewallPolicyNatRule(collectionName, rule)
+				}
+				if err != nil {
+					return fmt.Errorf("`%s` %q rule %q: %+v", collectionType, collectionName, ruleName, err)
+				}
+				targets = append(targets, *target)
+			}
+		}
+	}
+
+	if totalRules > firewallPolicyRuleCollectionGroupMaxRules {
+		return fmt.Errorf("a Rule Collection Group can contain at most %d rules but %d are defined", firewallPolicyRuleCollectionGroupMaxRules, totalRules)
+	}
+	if natRules > firewallPolicyMaxNatRules {
+		return fmt.Errorf("a Firewall Policy can contain at most %d NAT rules but %d are defined in this Rule Collection Group", firewallPolicyMaxNatRules, natRules)
+	}
+
+	return validateFirewallPolicyRuleOverlaps(targets)
+}
+
+// validateFirewallPolicyRuleOverlaps checks that no two rules match overlapping traffic. Application and Network rules
+// are only compared with the other rules in the same rule collection, since these share an action and priority (so the
+// rules should be combined) - whereas overlapping rules in different rule collections are expected, for example a higher
+// priority rule collection denying some of the traffic allowed by another. NAT rules are compared across the Rule
+// Collection Group, since only the rule with the highest priority translates the traffic
+func validateFirewallPolicyRuleOverlaps(rules []firewallPolicyRuleTarget) error {
+	for i, first := range rules {
+		for _, second := range rules[i+1:] {
+			if first.collectionType != second.collectionType {
+				continue
+			}
+			if first.collectionType != "nat_rule_collection" && first.collectionName != second.collectionName {
+				continue
+			}
+			if first.overlaps(second) {
+				return fmt.Errorf("`%s` %q rule %q and `%s` %q rule %q match overlapping traffic (the same sources with a common destination, protocol and port) - these rules should either be combined or match distinct traffic", first.collectionType, first.collectionName, first.name, second.collectionType, second.collectionName, second.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// firewallPolicyRuleTarget is the traffic a rule matches: the sources, destinations, protocols and ports
+type firewallPolicyRuleTarget struct {
+	collectionType string
+	collectionName string
+	name           string
+	sources        string
+	destinations   map[string]bool
+	protocols      map[string]bool
+
+	// ports is nil when the ports are a part of the protocols, as for Application rules
+	ports [][2]int
+}
+
+// overlaps returns whether both rules match some of the same traffic - destinations are compared on their values,
+// meaning (for example) that an address prefix containing another address isn't considered an overlap
+func (t firewallPolicyRuleTarget) overlaps(other firewallPolicyRuleTarget) bool {
+	if t.sources != other.sources {
+		return false
+	}
+	if !firewallPolicyRuleValuesIntersect(t.destinations, other.destinations) {
+		return false
+	}
+	anyProtocol := string(network.FirewallPolicyRuleNetworkProtocolAny)
+	if !t.protocols[anyProtocol] && !other.protocols[anyProtocol] && !firewallPolicyRuleValuesIntersect(t.protocols, other.protocols) {
+		return false
+	}
+	if t.ports == nil || other.ports == nil {
+		return true
+	}
+	for _, a := range t.ports {
+		for _, b := range other.ports {
+			if a[0] <= b[1] && b[0] <= a[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateFirewallPolicyApplicationRule(collectionName string, rule map[string]interface{}) (*firewallPolicyRuleTarget, error) {
+	if !firewallPolicyRuleHasAnyValue(rule, "source_addresses", "source_ip_groups") {
+		return nil, fmt.Errorf("one of `source_addresses` or `source_ip_groups` must be specified")
+	}
+
+	hasFqdns := firewallPolicyRuleHasAnyValue(rule, "destination_fqdns")
+	hasFqdnTags := firewallPolicyRuleHasAnyValue(rule, "destination_fqdn_tags")
+	hasUrls := firewallPolicyRuleHasAnyValue(rule, "destination_urls")
+	if !hasFqdns && !hasFqdnTags && !hasUrls && !firewallPolicyRuleHasAnyValue(rule, "destination_addresses", "web_categories") {
+		return nil, fmt.Errorf("one of `destination_addresses`, `destination_fqdns`, `destination_fqdn_tags`, `destination_urls` or `web_categories` must be specified")
+	}
+	if hasFqdnTags && (hasFqdns || hasUrls) {
+		return nil, fmt.Errorf("`destination_fqdn_tags` cannot be combined with `destination_fqdns` or `destination_urls`")
+	}
+	if hasUrls && hasFqdns {
+		return nil, fmt.Errorf("`destination_urls` cannot be combined with `destination_fqdns`")
+	}
+	if hasUrls && !rule["terminate_tls"].(bool) {
+		return nil, fmt.Errorf("`terminate_tls` must be enabled when `destination_urls` is specified")
+	}
+
+	target := firewallPolicyRuleTarget{
+		collectionType: "application_rule_collection",
+		collectionName: collectionName,
+		name:           rule["name"].(string),
+		sources:        firewallPolicyRuleSourcesKey(rule),
+		destinations:   firewallPolicyRuleValues(rule, "destination_addresses", "destination_fqdns", "destination_fqdn_tags", "destination_urls", "web_categories"),
+		protocols:      make(map[string]bool),
+	}
+	for _, v := range rule["protocols"].(*pluginsdk.Set).List() {
+		protocol := v.(map[string]interface{})
+		target.protocols[fmt.Sprintf("%s:%d", protocol["type"].(string), protocol["port"].(int))] = true
+	}
+
+	return &target, nil
+}
+
+func validateFirewallPolicyNetworkRule(collectionName string, rule map[string]interface{}) (*firewallPolicyRuleTarget, error) {
+	if !firewallPolicyRuleHasAnyValue(rule, "source_addresses", "source_ip_groups") {
+		return nil, fmt.Errorf("one of `source_addresses` or `source_ip_groups` must be specified")
+	}
+	if !firewallPolicyRuleHasAnyValue(rule, "destination_addresses", "destination_ip_groups", "destination_fqdns") {
+		return nil, fmt.Errorf("one of `destination_addresses`, `destination_ip_groups` or `destination_fqdns` must be specified")
+	}
+
+	target := firewallPolicyRuleTarget{
+		collectionType: "network_rule_collection",
+		collectionName: collectionName,
+		name:           rule["name"].(string),
+		sources:        firewallPolicyRuleSourcesKey(rule),
+		destinations:   firewallPolicyRuleValues(rule, "destination_addresses", "destination_ip_groups", "destination_fqdns"),
+		protocols:      make(map[string]bool),
+		ports:          make([][2]int, 0),
+	}
+	for _, v := range rule["protocols"].(*pluginsdk.Set).List() {
+		target.protocols[v.(string)] = true
+	}
+	for _, v := range rule["destination_ports"].(*pluginsdk.Set).List() {
+		portRange, err := parseFirewallPolicyPortRange(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		target.ports = append(target.ports, portRange)
+	}
+
+	return &target, nil
+}
+
+func validateFirewallPolicyNatRule(collectionName string, rule map[string]interface{}) (*firewallPolicyRuleTarget, error) {
+	if !firewallPolicyRuleHasAnyValue(rule, "source_addresses", "source_ip_groups") {
+		return nil, fmt.Errorf("one of `source_addresses` or `source_ip_groups` must be specified")
+	}
+
+	translatedAddress := rule["translated_address"].(string)
+	translatedFqdn := rule["translated_fqdn"].(string)
+	if translatedAddress != "" && translatedFqdn != "" {
+		return nil, fmt.Errorf("only one of `translated_address` or `translated_fqdn` can be specified")
+	}
+	if translatedAddress == "" && translatedFqdn == "" {
+		return nil, fmt.Errorf("one of `translated_address` or `translated_fqdn` must be specified")
+	}
+
+	target := firewallPolicyRuleTarget{
+		collectionType: "nat_rule_collection",
+		collectionName: collectionName,
+		name:           rule["name"].(string),
+		sources:        firewallPolicyRuleSourcesKey(rule),
+		destinations:   make(map[string]bool),
+		protocols:      make(map[string]bool),
+		ports:          make([][2]int, 0),
+	}
+	if destinationAddress := rule["destination_address"].(string); destinationAddress != "" {
+		target.destinations[destinationAddress] = true
+	}
+	for _, v := range rule["protocols"].(*pluginsdk.Set).List() {
+		target.protocols[v.(string)] = true
+	}
+	for _, v := range rule["destination_ports"].(*pluginsdk.Set).List() {
+		portRange, err := parseFirewallPolicyPortRange(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		target.ports = append(target.ports, portRange)
+	}
+
+	return &target, nil
+}
+
+// firewallPolicyRuleCollectionGroupConfigRules checks that the rule collection and rule names within the configuration
+// are unique and returns whether each rule's configuration is wholly known at plan time, keyed by firewallPolicyRuleKey.
+// The raw configuration is used since the rule collections and rules are keyed on their name, meaning any duplicates
+// are collapsed (and unknown elements within the nested sets aren't surfaced) by the diff. A nil map is returned when
+// the configuration isn't available, in which case every rule can be validated
+func firewallPolicyRuleCollectionGroupConfigRules(config cty.Value) (map[string]bool, error) {
+	if config.IsNull() || !config.IsKnown() {
+		return nil, nil
+	}
+
+	output := make(map[string]bool)
+	collectionNames := make(map[string]string)
+	for _, collectionType := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		collections := config.GetAttr(collectionType)
+		if collections.IsNull() || !collections.IsKnown() {
+			continue
+		}
+
+		for _, collection := range collections.AsValueSlice() {
+			if !collection.IsKnown() || collection.IsNull() {
+				continue
+			}
+			name := collection.GetAttr("name")
+			if !name.IsKnown() || name.IsNull() {
+				continue
+			}
+			collectionName := name.AsString()
+			if existing, ok := collectionNames[collectionName]; ok {
+				if existing == collectionType {
+					return nil, fmt.Errorf("rule collection names must be unique within a Rule Collection Group but %q is defined more than once as a `%s` block", collectionName, collectionType)
+				}
+				return nil, fmt.Errorf("rule collection names must be unique within a Rule Collection Group but %q is used by both a `%s` and a `%s` block", collectionName, existing, collectionType)
+			}
+			collectionNames[collectionName] = collectionType
+
+			rules := collection.GetAttr("rule")
+			if rules.IsNull() || !rules.IsKnown() {
+				continue
+			}
+			for _, rule := range rules.AsValueSlice() {
+				if !rule.IsKnown() || rule.IsNull() {
+					continue
+				}
+				name := rule.GetAttr("name")
+				if !name.IsKnown() || name.IsNull() {
+					continue
+				}
+				ruleName := name.AsString()
+				key := firewallPolicyRuleKey(collectionType, collectionName, ruleName)
+				if _, ok := output[key]; ok {
+					return nil, fmt.Errorf("rule names must be unique within a rule collection but %q is defined more than once in the `%s` %q", ruleName, collectionType, collectionName)
+				}
+				output[key] = rule.IsWhollyKnown()
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func firewallPolicyRuleKey(collectionType, collectionName, ruleName string) string {
+	return fmt.Sprintf("%s/%s/%s", collectionType, collectionName, ruleName)
+}
+
+func firewallPolicyRuleHasAnyValue(rule map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if v, ok := rule[key].(*pluginsdk.Set); ok && v.Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// firewallPolicyRuleValues returns the (case-insensitive) values of the specified attributes, prefixed with the attribute
+// name so that (for example) an FQDN and an FQDN Tag with the same value aren't considered equal
+func firewallPolicyRuleValues(rule map[string]interface{}, keys ...string) map[string]bool {
+	output := make(map[string]bool)
+	for _, key := range keys {
+		if v, ok := rule[key].(*pluginsdk.Set); ok {
+			for _, value := range v.List() {
+				output[fmt.Sprintf("%s:%s", key, strings.ToLower(value.(string)))] = true
+			}
+		}
+	}
+	return output
+}
+
+func firewallPolicyRuleValuesIntersect(first, second map[string]bool) bool {
+	for v := range first {
+		if second[v] {
+			return true
+		}
+	}
+	return false
+}
+
+// firewallPolicyRuleSourcesKey returns a key which is equal for rules matching exactly the same sources
+func firewallPolicyRuleSourcesKey(rule map[string]interface{}) string {
+	addresses := make([]string, 0)
+	for _, v := range rule["source_addresses"].(*pluginsdk.Set).List() {
+		addresses = append(addresses, v.(string))
+	}
+	ipGroups := make([]string, 0)
+	for _, v := range rule["source_ip_groups"].(*pluginsdk.Set).List() {
+		ipGroups = append(ipGroups, strings.ToLower(v.(string)))
+	}
+	sort.Strings(addresses)
+	sort.Strings(ipGroups)
+	return strings.Join(addresses, ",") + "|" + strings.Join(ipGroups, ",")
+}
+
+// parseFirewallPolicyPortRange parses a port (e.g. `80`), port range (e.g. `8000-8080`) or any port (`*`) into its bounds
+func parseFirewallPolicyPortRange(input string) ([2]int, error) {
+	if strings.TrimSpace(input) == "*" {
+		return [2]int{1, 65535}, nil
+	}
+
+	bounds := strings.SplitN(input, "-", 2)
+	start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	if err != nil {
+		return [2]int{}, fmt.Errorf("parsing port %q: %+v", input, err)
+	}
+	end := start
+	if len(bounds) == 2 {
+		if end, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+			return [2]int{}, fmt.Errorf("parsing port range %q: %+v", input, err)
+		}
+	}
+	return [2]int{start, end}, nil
+}
+
+// resourceFirewallPolicyRuleCollectionGroupNameHash keys the rule collections and rules on their name, so that changes
+// to a single rule collection or rule are shown against that rule collection or rule in the plan
+func resourceFirewallPolicyRuleCollectionGroupNameHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
 	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
 }
@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -134,6 +135,18 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_duplicateRuleName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateRuleName(data),
+			ExpectError: regexp.MustCompile("rule names must be unique within a rule collection"),
+		},
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	var id, err = parse.FirewallPolicyRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -308,7 +321,7 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
       protocols           = ["TCP", "UDP"]
       source_addresses    = ["10.0.0.1", "10.0.0.2"]
       destination_address = "192.168.1.1"
-      destination_ports   = ["80"]
+      destination_ports   = ["81"]
       translated_fqdn     = "time.microsoft.com"
       translated_port     = "8080"
     }
@@ -526,7 +539,7 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
         type = "Https"
         port = 443
       }
-      source_addresses      = ["10.0.0.1"]
+      source_addresses      = ["10.0.0.2"]
       destination_addresses = ["10.0.0.1"]
       destination_urls      = ["www.google.com/en"]
       terminate_tls         = true
@@ -730,6 +743,47 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) duplicateRuleName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80"]
+    }
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["UDP"]
+      source_addresses      = ["10.0.0.2"]
+      destination_addresses = ["192.168.1.2"]
+      destination_ports     = ["53"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
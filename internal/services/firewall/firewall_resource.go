@@ -254,7 +254,7 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	parameters := network.AzureFirewall{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
 			IPConfigurations:     ipConfigs,
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intel_mode").(string)),
@@ -432,7 +432,7 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, read.Tags)
 }
 
 func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
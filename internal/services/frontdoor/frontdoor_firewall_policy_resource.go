@@ -481,7 +481,7 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 			CustomRules:  expandFrontDoorFirewallCustomRules(customRules),
 			ManagedRules: expandFrontDoorFirewallManagedRules(managedRules),
 		},
-		Tags: tagsHelper.Expand(t),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 	}
 
 	if redirectUrl != "" {
@@ -555,7 +555,7 @@ func resourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interfa
 			}
 		}
 
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 	return nil
 }
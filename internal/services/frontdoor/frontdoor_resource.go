@@ -618,7 +618,7 @@ func resourceFrontDoorCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			LoadBalancingSettings: expandFrontDoorLoadBalancingSettingsModel(loadBalancingSettings, id),
 			EnabledState:          &enabledState,
 		},
-		Tags: tagsHelper.Expand(t),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, t),
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, frontDoorParameters); err != nil {
@@ -711,7 +711,7 @@ func resourceFrontDoorUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 
 	if d.HasChanges("tags") {
-		existingModel.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+		existingModel.Tags = tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	// If the explicitResourceOrder is empty and it's not a new resource set the mapping table to the state file and return an error.
@@ -905,7 +905,7 @@ func resourceFrontDoorRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 		}
 
-		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
 	}
 
 	return nil
@@ -33,7 +33,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 		if d.HasChange("tags") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
-				Tags: tags.Expand(t),
+				Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 			}
 			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
 				return fmt.Errorf("updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
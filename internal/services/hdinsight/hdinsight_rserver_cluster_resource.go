@@ -208,7 +208,7 @@ func resourceHDInsightRServerClusterCreate(d *pluginsdk.ResourceData, meta inter
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -318,5 +318,5 @@ func resourceHDInsightRServerClusterRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("ssh_endpoint", sshEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -202,7 +202,7 @@ func resourceHDInsightStormClusterCreate(d *pluginsdk.ResourceData, meta interfa
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -320,7 +320,7 @@ func resourceHDInsightStormClusterRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("monitor", flattenHDInsightMonitoring(monitor))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func expandHDInsightStormComponentVersion(input []interface{}) map[string]*string {
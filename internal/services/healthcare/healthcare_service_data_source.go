@@ -170,5 +170,5 @@ func dataSourceHealthcareServiceRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -229,7 +229,7 @@ func resourceHealthcareServiceCreateUpdate(d *pluginsdk.ResourceData, meta inter
 
 	healthcareServiceDescription := healthcareapis.ServicesDescription{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Kind:     healthcareapis.Kind(kind),
 		Properties: &healthcareapis.ServicesProperties{
 			AccessPolicies:              expandAccessPolicyEntries(d),
@@ -321,7 +321,7 @@ func resourceHealthcareServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceHealthcareServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
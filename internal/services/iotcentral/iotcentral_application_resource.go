@@ -141,7 +141,7 @@ func resourceIotCentralAppCreate(d *pluginsdk.ResourceData, meta interface{}) er
 			Name: iotcentral.AppSku(d.Get("sku").(string)),
 		},
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IoTAppName, app)
@@ -175,7 +175,7 @@ func resourceIotCentralAppUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 	subdomain := d.Get("sub_domain").(string)
 	template := d.Get("template").(string)
 	appPatch := iotcentral.AppPatch{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 		AppProperties: &iotcentral.AppProperties{
 			DisplayName: &displayName,
 			Subdomain:   &subdomain,
@@ -227,7 +227,7 @@ func resourceIotCentralAppRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("template", props.Template)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceIotCentralAppDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -64,5 +64,5 @@ func dataSourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("hostname", properties.HostName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
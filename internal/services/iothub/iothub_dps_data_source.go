@@ -90,5 +90,5 @@ func dataSourceIotHubDPSRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("allocation_policy", props.AllocationPolicy)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
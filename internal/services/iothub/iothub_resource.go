@@ -559,7 +559,7 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			MessagingEndpoints:            messagingEndpoints,
 			EnableFileUploadNotifications: &enableFileUploadNotifications,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	// nolint staticcheck
@@ -711,7 +711,7 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `sku`: %+v", err)
 	}
 	d.Set("type", hub.Type)
-	return tags.FlattenAndSet(d, hub.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, hub.Tags)
 }
 
 func resourceIotHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -137,7 +137,7 @@ func resourceIoTTimeSeriesInsightsEventSourceEventhubCreateUpdate(d *pluginsdk.R
 
 	eventSource := timeseriesinsights.EventHubEventSourceCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 		EventHubEventSourceCreationProperties: &timeseriesinsights.EventHubEventSourceCreationProperties{
 			EventHubName:          utils.String(d.Get("eventhub_name").(string)),
 			ServiceBusNamespace:   utils.String(d.Get("namespace_name").(string)),
@@ -207,7 +207,7 @@ func resourceIoTTimeSeriesInsightsEventSourceEventhubRead(d *pluginsdk.ResourceD
 		d.Set("timestamp_property_name", props.TimestampPropertyName)
 	}
 
-	return tags.FlattenAndSet(d, eventSource.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, eventSource.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsEventSourceEventhubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -132,7 +132,7 @@ func resourceIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate(d *pluginsdk.Res
 
 	eventSource := timeseriesinsights.IoTHubEventSourceCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 		IoTHubEventSourceCreationProperties: &timeseriesinsights.IoTHubEventSourceCreationProperties{
 			IotHubName:            utils.String(d.Get("iothub_name").(string)),
 			SharedAccessKey:       utils.String(d.Get("shared_access_key").(string)),
@@ -202,7 +202,7 @@ func resourceIoTTimeSeriesInsightsEventSourceIoTHubRead(d *pluginsdk.ResourceDat
 		d.Set("timestamp_property_name", props.TimestampPropertyName)
 	}
 
-	return tags.FlattenAndSet(d, eventSource.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, eventSource.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsEventSourceIoTHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
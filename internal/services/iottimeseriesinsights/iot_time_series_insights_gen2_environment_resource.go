@@ -142,7 +142,7 @@ func resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate(d *pluginsdk.Resou
 
 	environment := timeseriesinsights.Gen2EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Sku:      sku,
 		Gen2EnvironmentCreationProperties: &timeseriesinsights.Gen2EnvironmentCreationProperties{
 			TimeSeriesIDProperties: expandIdProperties(d.Get("id_properties").(*pluginsdk.Set).List()),
@@ -224,7 +224,7 @@ func resourceIoTTimeSeriesInsightsGen2EnvironmentRead(d *pluginsdk.ResourceData,
 		return fmt.Errorf("setting `storage`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, environment.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, environment.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsGen2EnvironmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
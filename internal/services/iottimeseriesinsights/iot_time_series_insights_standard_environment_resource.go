@@ -146,7 +146,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *pluginsdk.R
 
 	environment := timeseriesinsights.Gen1EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Sku:      sku,
 		Gen1EnvironmentCreationProperties: &timeseriesinsights.Gen1EnvironmentCreationProperties{
 			StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limit_exceeded_behavior").(string)),
@@ -234,7 +234,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentRead(d *pluginsdk.ResourceD
 		}
 	}
 
-	return tags.FlattenAndSet(d, environment.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, environment.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
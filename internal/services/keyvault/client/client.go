@@ -4,13 +4,21 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
 )
 
 type Client struct {
 	ManagedHsmClient *keyvault.ManagedHsmsClient
 	ManagementClient *keyvaultmgmt.BaseClient
 	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+
+	// the 7.3 data plane API is required for Key Rotation Policies and for items within a Managed HSM
+	DataPlaneClient                          *dataplane.BaseClient
+	ManagedHsmDataPlaneRoleAssignmentsClient *dataplane.RoleAssignmentsClient
+	ManagedHsmDataPlaneRoleDefinitionsClient *dataplane.RoleDefinitionsClient
+	ManagedHsmDataPlaneSecurityDomainsClient *dataplane.HSMSecurityDomainClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -23,11 +31,29 @@ func NewClient(o *common.ClientOptions) *Client {
 	vaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
+	dataPlaneClient := dataplane.New()
+	o.ConfigureClient(&dataPlaneClient.Client, o.KeyVaultAuthorizer)
+
+	managedHsmRoleAssignmentsClient := dataplane.NewRoleAssignmentsClient()
+	o.ConfigureClient(&managedHsmRoleAssignmentsClient.Client, o.KeyVaultAuthorizer)
+
+	managedHsmRoleDefinitionsClient := dataplane.NewRoleDefinitionsClient()
+	o.ConfigureClient(&managedHsmRoleDefinitionsClient.Client, o.KeyVaultAuthorizer)
+
+	managedHsmSecurityDomainsClient := dataplane.NewHSMSecurityDomainClient()
+	o.ConfigureClient(&managedHsmSecurityDomainsClient.Client, o.KeyVaultAuthorizer)
+
 	return &Client{
 		ManagedHsmClient: &managedHsmClient,
 		ManagementClient: &managementClient,
 		VaultsClient:     &vaultsClient,
-		options:          o,
+
+		DataPlaneClient:                          &dataPlaneClient,
+		ManagedHsmDataPlaneRoleAssignmentsClient: &managedHsmRoleAssignmentsClient,
+		ManagedHsmDataPlaneRoleDefinitionsClient: &managedHsmRoleDefinitionsClient,
+		ManagedHsmDataPlaneSecurityDomainsClient: &managedHsmSecurityDomainsClient,
+
+		options: o,
 	}
 }
 
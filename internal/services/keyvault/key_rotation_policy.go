@@ -0,0 +1,194 @@
+package keyvault
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// keyRotationPolicySchema is shared between Keys within a Key Vault and Keys within a Managed HSM,
+// both of which expose the `rotation_policy` block at the top level
+func keyRotationPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"expire_after": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601DurationBetween("P28D", "P100Y"),
+					AtLeastOneOf: []string{
+						"rotation_policy.0.expire_after",
+						"rotation_policy.0.automatic",
+					},
+				},
+
+				// the service adds a notification 30 days before expiry when one isn't specified
+				"notify_before_expiry": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validate.ISO8601Duration,
+					RequiredWith: []string{"rotation_policy.0.expire_after"},
+				},
+
+				"automatic": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					AtLeastOneOf: []string{
+						"rotation_policy.0.expire_after",
+						"rotation_policy.0.automatic",
+					},
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"time_after_creation": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.ISO8601Duration,
+								ExactlyOneOf: []string{
+									"rotation_policy.0.automatic.0.time_after_creation",
+									"rotation_policy.0.automatic.0.time_before_expiry",
+								},
+							},
+
+							"time_before_expiry": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.ISO8601Duration,
+								RequiredWith: []string{"rotation_policy.0.expire_after"},
+								ExactlyOneOf: []string{
+									"rotation_policy.0.automatic.0.time_after_creation",
+									"rotation_policy.0.automatic.0.time_before_expiry",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandKeyRotationPolicy returns an empty policy when the block is omitted, which clears any existing policy
+func expandKeyRotationPolicy(input []interface{}) dataplane.KeyRotationPolicy {
+	lifetimeActions := make([]dataplane.LifetimeActions, 0)
+	policy := dataplane.KeyRotationPolicy{
+		LifetimeActions: &lifetimeActions,
+		Attributes:      &dataplane.KeyRotationPolicyAttributes{},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+	v := input[0].(map[string]interface{})
+
+	if expireAfter := v["expire_after"].(string); expireAfter != "" {
+		policy.Attributes.ExpiryTime = utils.String(expireAfter)
+	}
+
+	if notifyBeforeExpiry := v["notify_before_expiry"].(string); notifyBeforeExpiry != "" {
+		lifetimeActions = append(lifetimeActions, dataplane.LifetimeActions{
+			Trigger: &dataplane.LifetimeActionsTrigger{
+				TimeBeforeExpiry: utils.String(notifyBeforeExpiry),
+			},
+			Action: &dataplane.LifetimeActionsType{
+				Type: dataplane.Notify,
+			},
+		})
+	}
+
+	if automatic := v["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		raw := automatic[0].(map[string]interface{})
+		trigger := &dataplane.LifetimeActionsTrigger{}
+		if timeAfterCreation := raw["time_after_creation"].(string); timeAfterCreation != "" {
+			trigger.TimeAfterCreate = utils.String(timeAfterCreation)
+		}
+		if timeBeforeExpiry := raw["time_before_expiry"].(string); timeBeforeExpiry != "" {
+			trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+		}
+
+		lifetimeActions = append(lifetimeActions, dataplane.LifetimeActions{
+			Trigger: trigger,
+			Action: &dataplane.LifetimeActionsType{
+				Type: dataplane.Rotate,
+			},
+		})
+	}
+
+	policy.LifetimeActions = &lifetimeActions
+	return policy
+}
+
+// readKeyRotationPolicy retrieves the flattened rotation policy for the specified Key. Retrieving the policy requires the
+// `GetRotationPolicy` permission, which existing configurations may not have been granted, and isn't supported in every
+// cloud - as such this is only retrieved when a `rotation_policy` block is configured (or present in the state), meaning
+// that a rotation policy added outside of Terraform (or the policy of an imported Key) isn't detected until one is
+func readKeyRotationPolicy(ctx context.Context, client *dataplane.BaseClient, d *pluginsdk.ResourceData, baseUri, name string) ([]interface{}, error) {
+	if len(d.Get("rotation_policy").([]interface{})) == 0 {
+		return []interface{}{}, nil
+	}
+
+	resp, err := client.GetKeyRotationPolicy(ctx, baseUri, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenKeyRotationPolicy(resp), nil
+}
+
+func flattenKeyRotationPolicy(input dataplane.KeyRotationPolicy) []interface{} {
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreation := ""
+			if action.Trigger.TimeAfterCreate != nil {
+				timeAfterCreation = *action.Trigger.TimeAfterCreate
+			}
+			timeBeforeExpiry := ""
+			if action.Trigger.TimeBeforeExpiry != nil {
+				timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+			}
+
+			switch {
+			case strings.EqualFold(string(action.Action.Type), string(dataplane.Notify)):
+				notifyBeforeExpiry = timeBeforeExpiry
+			case strings.EqualFold(string(action.Action.Type), string(dataplane.Rotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreation,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// every key has a default policy which only notifies 30 days before an expiry that's never set,
+	// so this is only considered to be a rotation policy once either an expiry or a rotation is configured
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+			"automatic":            automatic,
+		},
+	}
+}
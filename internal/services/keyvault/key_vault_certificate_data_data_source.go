@@ -279,5 +279,5 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	d.Set("key", keyPEM.String())
 	d.Set("certificates_count", len(pemCerts))
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, cert.Tags)
 }
@@ -337,7 +337,7 @@ func dataSourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface
 
 	d.Set("not_before", n.Format(time.RFC3339))
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, cert.Tags)
 }
 
 func flattenKeyVaultCertificatePolicyForDataSource(input *keyvault.CertificatePolicy) []interface{} {
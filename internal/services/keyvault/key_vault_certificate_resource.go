@@ -446,7 +446,7 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        policy,
-			Tags:                     tags.Expand(t),
+			Tags:                     tags.Expand(meta.(*clients.Client).Tags, t),
 		}
 		if _, err := client.ImportCertificate(ctx, *keyVaultBaseUrl, name, importParameters); err != nil {
 			return err
@@ -455,7 +455,7 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: policy,
-			Tags:              tags.Expand(t),
+			Tags:              tags.Expand(meta.(*clients.Client).Tags, t),
 		}
 		if resp, err := client.CreateCertificate(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
 			if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedCerts && utils.ResponseWasConflict(resp.Response) {
@@ -527,7 +527,7 @@ func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{})
 	}
 	patch := keyvault.CertificateUpdateParameters{}
 	if t, ok := d.GetOk("tags"); ok {
-		patch.Tags = tags.Expand(t.(map[string]interface{}))
+		patch.Tags = tags.Expand(meta.(*clients.Client).Tags, t.(map[string]interface{}))
 	}
 
 	if _, err = client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, patch); err != nil {
@@ -644,7 +644,7 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("thumbprint", thumbprint)
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, cert.Tags)
 }
 
 func resourceKeyVaultCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
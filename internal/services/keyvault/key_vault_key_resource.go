@@ -132,6 +132,15 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": keyRotationPolicySchema(),
+
+			// changing this value rotates the Key on demand - creating a new version, which becomes the current version
+			"rotation_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -181,6 +190,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	dataPlaneClient := meta.(*clients.Client).KeyVault.DataPlaneClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -220,7 +230,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			Enabled: utils.Bool(true),
 		},
 
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
@@ -276,6 +286,12 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		if _, err := dataPlaneClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, expandKeyRotationPolicy(v.([]interface{}))); err != nil {
+			return fmt.Errorf("setting rotation policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -290,6 +306,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	dataPlaneClient := meta.(*clients.Client).KeyVault.DataPlaneClient
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -322,6 +339,26 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	if d.HasChange("rotation_policy") {
+		if _, err := dataPlaneClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, expandKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))); err != nil {
+			return fmt.Errorf("updating rotation policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	// the new version is rotated before the attributes below are updated, since these are applied to the current version
+	if d.HasChange("rotation_trigger") {
+		log.Printf("[DEBUG] Rotating Key %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
+		rotated, err := dataPlaneClient.RotateKey(ctx, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			return fmt.Errorf("rotating Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		if rotated.Key == nil || rotated.Key.Kid == nil {
+			return fmt.Errorf("rotating Key %q (Key Vault %q): `kid` was nil", id.Name, id.KeyVaultBaseUrl)
+		}
+
+		d.SetId(*rotated.Key.Kid)
+	}
+
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -330,7 +367,7 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		KeyAttributes: &keyvault.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("not_before_date"); ok {
@@ -355,6 +392,7 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	dataPlaneClient := meta.(*clients.Client).KeyVault.DataPlaneClient
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -434,6 +472,14 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	rotationPolicy, err := readKeyRotationPolicy(ctx, dataPlaneClient, d, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving rotation policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if err := d.Set("rotation_policy", rotationPolicy); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -486,7 +532,7 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
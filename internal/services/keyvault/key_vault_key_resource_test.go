@@ -203,6 +203,68 @@ func TestAccKeyVaultKey_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.rotationPolicyUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+	})
+}
+
+func TestAccKeyVaultKey_rotationTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTrigger(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id", "rotation_trigger"),
+		{
+			// changing the trigger rotates the key, which updates the version within the ID
+			Config: r.rotationTrigger(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_trigger").HasValue("second"),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id", "rotation_trigger"),
+	})
+}
+
 func TestAccKeyVaultKey_updatedExternally(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -567,6 +629,101 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "P90D"
+    notify_before_expiry = "P29D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) rotationPolicyUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    automatic {
+      time_after_creation = "P60D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) rotationTrigger(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_trigger = %q
+}
+`, r.templateStandard(data), data.RandomString, trigger)
+}
+
 func (r KeyVaultKeyResource) curveEC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -832,8 +989,11 @@ resource "azurerm_key_vault" "test" {
       "Create",
       "Delete",
       "Get",
+      "GetRotationPolicy",
       "Purge",
       "Recover",
+      "Rotate",
+      "SetRotationPolicy",
       "Update",
     ]
 
@@ -0,0 +1,453 @@
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleKeyCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleKeyRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleKeyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parseManagedHardwareSecurityModuleKeyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"key_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				// a Managed HSM only supports HSM-protected keys
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dataplane.ECHSM),
+					string(dataplane.OctHSM),
+					string(dataplane.RSAHSM),
+				}, false),
+			},
+
+			"key_size": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntInSlice([]int{128, 192, 256, 2048, 3072, 4096}),
+				ConflictsWith: []string{"curve"},
+			},
+
+			"curve": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dataplane.P256),
+					string(dataplane.P256K),
+					string(dataplane.P384),
+					string(dataplane.P521),
+				}, false),
+				ConflictsWith: []string{"key_size"},
+			},
+
+			"key_opts": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(dataplane.Decrypt),
+						string(dataplane.Encrypt),
+						string(dataplane.Sign),
+						string(dataplane.UnwrapKey),
+						string(dataplane.Verify),
+						string(dataplane.WrapKey),
+					}, false),
+				},
+			},
+
+			"not_before_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"rotation_policy": keyRotationPolicySchema(),
+
+			// Computed
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versioned_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"n": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"x": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"y": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.DataPlaneClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	baseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Data Plane URI for Key %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	id, err := parse.NewNestedItemID(*baseUri, "keys", name, "")
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetKey(ctx, *baseUri, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Key %q (%s): %+v", name, *managedHsmId, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_key", id.ID())
+	}
+
+	parameters := dataplane.KeyCreateParameters{
+		Kty:    dataplane.JSONWebKeyType(d.Get("key_type").(string)),
+		KeyOps: expandManagedHardwareSecurityModuleKeyOptions(d.Get("key_opts").([]interface{})),
+		KeyAttributes: &dataplane.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	switch parameters.Kty {
+	case dataplane.ECHSM:
+		curve, ok := d.GetOk("curve")
+		if !ok {
+			return fmt.Errorf("`curve` is required when creating an `EC-HSM` key")
+		}
+		parameters.Curve = dataplane.JSONWebKeyCurveName(curve.(string))
+	case dataplane.OctHSM, dataplane.RSAHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return fmt.Errorf("`key_size` is required when creating an `%s` key", string(parameters.Kty))
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		parameters.KeyAttributes.Expires = &expirationUnixTime
+	}
+
+	if _, err := client.CreateKey(ctx, *baseUri, name, parameters); err != nil {
+		return fmt.Errorf("creating Key %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		if _, err := client.UpdateKeyRotationPolicy(ctx, *baseUri, name, expandKeyRotationPolicy(v.([]interface{}))); err != nil {
+			return fmt.Errorf("setting rotation policy for Key %q (%s): %+v", name, *managedHsmId, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.DataPlaneClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagedHardwareSecurityModuleKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := dataplane.KeyUpdateParameters{
+		KeyOps: expandManagedHardwareSecurityModuleKeyOptions(d.Get("key_opts").([]interface{})),
+		KeyAttributes: &dataplane.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		parameters.KeyAttributes.Expires = &expirationUnixTime
+	}
+
+	if _, err := client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+		return fmt.Errorf("updating Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	if d.HasChange("rotation_policy") {
+		if _, err := client.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, expandKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))); err != nil {
+			return fmt.Errorf("updating rotation policy for Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.DataPlaneClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagedHardwareSecurityModuleKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Managed HSM at URL %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Key %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+
+	if key := resp.Key; key != nil {
+		d.Set("key_type", string(key.Kty))
+
+		if err := d.Set("key_opts", flattenKeyVaultKeyOptions(key.KeyOps)); err != nil {
+			return fmt.Errorf("setting `key_opts`: %+v", err)
+		}
+
+		d.Set("n", key.N)
+		d.Set("e", key.E)
+		d.Set("x", key.X)
+		d.Set("y", key.Y)
+		d.Set("curve", string(key.Crv))
+
+		// the size of symmetric keys isn't returned, so it's only possible to determine this for RSA keys
+		if key.N != nil {
+			nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
+			if err != nil {
+				return fmt.Errorf("decoding N: %+v", err)
+			}
+			d.Set("key_size", len(nBytes)*8)
+		}
+
+		version := ""
+		if key.Kid != nil {
+			versionedId, err := parse.ParseNestedItemID(*key.Kid)
+			if err != nil {
+				return err
+			}
+			version = versionedId.Version
+			d.Set("versioned_id", versionedId.ID())
+		}
+		d.Set("version", version)
+	}
+
+	if attributes := resp.Attributes; attributes != nil {
+		if v := attributes.NotBefore; v != nil {
+			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
+		}
+
+		if v := attributes.Expires; v != nil {
+			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
+		}
+	}
+
+	rotationPolicy, err := readKeyRotationPolicy(ctx, client, d, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving rotation policy for Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if err := d.Set("rotation_policy", rotationPolicy); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.DataPlaneClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagedHardwareSecurityModuleKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedKeysOnDestroy
+	description := fmt.Sprintf("Key %q (Managed HSM %q)", id.Name, id.KeyVaultBaseUrl)
+	deleter := deleteAndPurgeManagedHardwareSecurityModuleKey{
+		client:        client,
+		managedHsmUri: id.KeyVaultBaseUrl,
+		name:          id.Name,
+	}
+	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ deleteAndPurgeNestedItem = deleteAndPurgeManagedHardwareSecurityModuleKey{}
+
+type deleteAndPurgeManagedHardwareSecurityModuleKey struct {
+	client        *dataplane.BaseClient
+	managedHsmUri string
+	name          string
+}
+
+func (d deleteAndPurgeManagedHardwareSecurityModuleKey) DeleteNestedItem(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.DeleteKey(ctx, d.managedHsmUri, d.name)
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeManagedHardwareSecurityModuleKey) NestedItemHasBeenDeleted(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetKey(ctx, d.managedHsmUri, d.name, "")
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeManagedHardwareSecurityModuleKey) PurgeNestedItem(ctx context.Context) (autorest.Response, error) {
+	return d.client.PurgeDeletedKey(ctx, d.managedHsmUri, d.name)
+}
+
+func (d deleteAndPurgeManagedHardwareSecurityModuleKey) NestedItemHasBeenPurged(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetDeletedKey(ctx, d.managedHsmUri, d.name)
+	return resp.Response, err
+}
+
+// parseManagedHardwareSecurityModuleKeyID parses the versionless Data Plane ID used for Keys within a Managed HSM,
+// since the latest version of the Key changes each time it's rotated
+func parseManagedHardwareSecurityModuleKeyID(input string) (*parse.NestedItemId, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.NestedItemType != "keys" {
+		return nil, fmt.Errorf("expected a Managed HSM Key ID but got a nested item of type %q in %q", id.NestedItemType, input)
+	}
+
+	if id.Version != "" {
+		return nil, fmt.Errorf("expected a versionless Managed HSM Key ID but got version %q in %q", id.Version, input)
+	}
+
+	return id, nil
+}
+
+func expandManagedHardwareSecurityModuleKeyOptions(input []interface{}) *[]dataplane.JSONWebKeyOperation {
+	results := make([]dataplane.JSONWebKeyOperation, 0, len(input))
+
+	for _, option := range input {
+		results = append(results, dataplane.JSONWebKeyOperation(option.(string)))
+	}
+
+	return &results
+}
@@ -0,0 +1,184 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleKeyResource struct {
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size"),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size"),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.DataPlaneClient.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Key != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestkey-%s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type       = "RSA-HSM"
+  key_size       = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "import" {
+  name           = azurerm_key_vault_managed_hardware_security_module_key.test.name
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module_key.test.managed_hsm_id
+  key_type       = azurerm_key_vault_managed_hardware_security_module_key.test.key_type
+  key_size       = azurerm_key_vault_managed_hardware_security_module_key.test.key_size
+  key_opts       = azurerm_key_vault_managed_hardware_security_module_key.test.key_opts
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name            = "acctestkey-%s"
+  managed_hsm_id  = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type        = "RSA-HSM"
+  key_size        = 2048
+  not_before_date = "2021-01-01T01:02:03Z"
+  expiration_date = "2031-01-01T01:02:03Z"
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "P90D"
+    notify_before_expiry = "P29D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+
+  tags = {
+    Env = "Test"
+  }
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (KeyVaultManagedHardwareSecurityModuleKeyResource) template(data acceptance.TestData) string {
+	// the role assignment name has to be stable across the steps of a test, so derive it from the test data
+	roleAssignmentName := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("acctest-hsm-key-%d", data.RandomInteger))).String()
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "crypto_user" {
+  name               = "%s"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/keys"
+  role_definition_id = "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.activated(data), roleAssignmentName)
+}
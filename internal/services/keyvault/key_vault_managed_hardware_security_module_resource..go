@@ -1,8 +1,15 @@
 package keyvault
 
 import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha1" // #nosec G505 - the SHA1 thumbprint of the certificate is required by the API
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"math/big"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
@@ -12,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -24,6 +32,7 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmKeyVaultManagedHardwareSecurityModuleCreate,
 		Read:   resourceArmKeyVaultManagedHardwareSecurityModuleRead,
+		Update: resourceArmKeyVaultManagedHardwareSecurityModuleUpdate,
 		Delete: resourceArmKeyVaultManagedHardwareSecurityModuleDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -34,6 +43,7 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -89,14 +99,60 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 90),
 			},
 
+			"security_domain_key_vault_certificate_ids": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     3,
+				MaxItems:     10,
+				RequiredWith: []string{"security_domain_quorum"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NestedItemIdWithOptionalVersion,
+				},
+			},
+
+			"security_domain_quorum": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"security_domain_key_vault_certificate_ids"},
+				ValidateFunc: validation.IntBetween(2, 10),
+			},
+
 			"hsm_uri": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"security_domain_encrypted_data": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			// https://github.com/Azure/azure-rest-api-specs/issues/13365
 			"tags": tags.ForceNewSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			// the Security Domain can only be downloaded once, when the Managed HSM is activated
+			pluginsdk.ForceNewIfChange("security_domain_key_vault_certificate_ids", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0
+			}),
+			pluginsdk.ForceNewIfChange("security_domain_quorum", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(int) > 0
+			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				if !d.NewValueKnown("security_domain_key_vault_certificate_ids") {
+					return nil
+				}
+
+				certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{})
+				if quorum := d.Get("security_domain_quorum").(int); quorum > len(certificateIds) {
+					return fmt.Errorf("`security_domain_quorum` (%d) cannot be greater than the number of `security_domain_key_vault_certificate_ids` (%d)", quorum, len(certificateIds))
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -135,7 +191,7 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 			Family: utils.String("B"),
 			Name:   keyvault.ManagedHsmSkuName(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, hsm)
@@ -148,6 +204,37 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+
+	if certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{}); len(certificateIds) > 0 {
+		encryptedData, err := activateManagedHardwareSecurityModule(ctx, meta.(*clients.Client), id, certificateIds, d.Get("security_domain_quorum").(int))
+		if err != nil {
+			return err
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
+	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedHardwareSecurityModuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// changing the Security Domain once it's been downloaded forces a new resource - so this is only possible
+	// when activating an existing Managed HSM
+	if d.HasChanges("security_domain_key_vault_certificate_ids", "security_domain_quorum") {
+		encryptedData, err := activateManagedHardwareSecurityModule(ctx, meta.(*clients.Client), *id, d.Get("security_domain_key_vault_certificate_ids").([]interface{}), d.Get("security_domain_quorum").(int))
+		if err != nil {
+			return err
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
 	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
 }
 
@@ -194,7 +281,7 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleRead(d *pluginsdk.ResourceD
 		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -219,3 +306,102 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 
 	return nil
 }
+
+// activateManagedHardwareSecurityModule activates the Managed HSM by downloading the Security Domain, encrypted using
+// the public keys of the specified Key Vault Certificates, returning the encrypted Security Domain
+func activateManagedHardwareSecurityModule(ctx context.Context, client *clients.Client, id parse.ManagedHSMId, certificateIds []interface{}, quorum int) (*string, error) {
+	baseUri, err := client.KeyVault.BaseUriForManagedHSM(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("looking up the Data Plane URI for %s: %+v", id, err)
+	}
+
+	certificates := make([]dataplane.SecurityDomainJSONWebKey, 0)
+	for _, v := range certificateIds {
+		certificateId, err := parse.ParseOptionallyVersionedNestedItemID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		certificate, err := client.KeyVault.ManagementClient.GetCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, certificateId.Version)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Key Vault Certificate %q: %+v", certificateId.ID(), err)
+		}
+		if certificate.Cer == nil {
+			return nil, fmt.Errorf("retrieving Key Vault Certificate %q: `cer` was nil", certificateId.ID())
+		}
+
+		key, err := securityDomainJSONWebKeyFromCertificate(*certificate.Cer)
+		if err != nil {
+			return nil, fmt.Errorf("building the Security Domain key from Key Vault Certificate %q: %+v", certificateId.ID(), err)
+		}
+		certificates = append(certificates, *key)
+	}
+
+	securityDomainClient := client.KeyVault.ManagedHsmDataPlaneSecurityDomainsClient
+	log.Printf("[DEBUG] Downloading the Security Domain to activate %s..", id)
+	resp, err := securityDomainClient.Download(ctx, *baseUri, dataplane.CertificateInfoObject{
+		Certificates: &certificates,
+		Required:     utils.Int32(int32(quorum)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("downloading the Security Domain for %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(dataplane.InProgress)},
+		Target:  []string{string(dataplane.Success)},
+		Refresh: func() (interface{}, string, error) {
+			status, err := securityDomainClient.DownloadPending(ctx, *baseUri)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving the status of the Security Domain download: %+v", err)
+			}
+			if status.Status == dataplane.Failed {
+				details := ""
+				if status.StatusDetails != nil {
+					details = *status.StatusDetails
+				}
+				return nil, "", fmt.Errorf("downloading the Security Domain failed: %s", details)
+			}
+			return status, string(status.Status), nil
+		},
+		PollInterval: 10 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, fmt.Errorf("waiting for %s to be activated: %+v", id, err)
+	}
+	log.Printf("[DEBUG] Activated %s.", id)
+
+	return resp.Value, nil
+}
+
+// securityDomainJSONWebKeyFromCertificate returns the public key of the specified (DER encoded) certificate in the
+// JWK format required to download the Security Domain
+func securityDomainJSONWebKeyFromCertificate(input []byte) (*dataplane.SecurityDomainJSONWebKey, error) {
+	certificate, err := x509.ParseCertificate(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %+v", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected the certificate to contain an RSA public key but got %T", certificate.PublicKey)
+	}
+
+	sha1Thumbprint := sha1.Sum(input) // #nosec G401
+	sha256Thumbprint := sha256.Sum256(input)
+	return &dataplane.SecurityDomainJSONWebKey{
+		Kty:     utils.String("RSA"),
+		Alg:     utils.String("RSA-OAEP-256"),
+		Use:     utils.String("enc"),
+		N:       utils.String(base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())),
+		E:       utils.String(base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())),
+		X5c:     &[]string{base64.StdEncoding.EncodeToString(input)},
+		X5t:     utils.String(base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:])),
+		X5tS256: utils.String(base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:])),
+	}, nil
+}
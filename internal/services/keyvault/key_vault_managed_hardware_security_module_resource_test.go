@@ -27,6 +27,20 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"basic":    testAccKeyVaultManagedHardwareSecurityModule_basic,
 			"update":   testAccKeyVaultManagedHardwareSecurityModule_requiresImport,
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
+			"activate": testAccKeyVaultManagedHardwareSecurityModule_activate,
+		},
+		"key": {
+			"basic":          testAccKeyVaultManagedHardwareSecurityModuleKey_basic,
+			"requiresImport": testAccKeyVaultManagedHardwareSecurityModuleKey_requiresImport,
+			"complete":       testAccKeyVaultManagedHardwareSecurityModuleKey_complete,
+		},
+		"role_definition": {
+			"basic":  testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic,
+			"update": testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update,
+		},
+		"role_assignment": {
+			"basic":    testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic,
+			"keyScope": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_keyScope,
 		},
 	})
 }
@@ -76,6 +90,30 @@ func testAccKeyVaultManagedHardwareSecurityModule_complete(t *testing.T) {
 	})
 }
 
+func testAccKeyVaultManagedHardwareSecurityModule_activate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module", "test")
+	r := KeyVaultManagedHardwareSecurityModuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// activating an existing Managed HSM is done in-place
+			Config: r.activated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_domain_encrypted_data").Exists(),
+			),
+		},
+		data.ImportStep("security_domain_encrypted_data", "security_domain_key_vault_certificate_ids", "security_domain_quorum"),
+	})
+}
+
 func (KeyVaultManagedHardwareSecurityModuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagedHSMID(state.ID)
 	if err != nil {
@@ -110,6 +148,31 @@ resource "azurerm_key_vault_managed_hardware_security_module" "test" {
 `, template, data.RandomInteger)
 }
 
+// activated returns a Managed HSM which has been activated by downloading the Security Domain, which is required
+// to be able to manage Keys and Role Based Access Control within the Managed HSM
+func (r KeyVaultManagedHardwareSecurityModuleResource) activated(data acceptance.TestData) string {
+	template := r.securityDomainTemplate(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                = "kvHsm%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Standard_B1"
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  admin_object_ids    = [data.azurerm_client_config.current.object_id]
+
+  security_domain_key_vault_certificate_ids = azurerm_key_vault_certificate.test[*].id
+  security_domain_quorum                    = 2
+}
+`, template, data.RandomInteger)
+}
+
 func (r KeyVaultManagedHardwareSecurityModuleResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -152,6 +215,91 @@ resource "azurerm_key_vault_managed_hardware_security_module" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r KeyVaultManagedHardwareSecurityModuleResource) securityDomainTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv%s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    certificate_permissions = [
+      "Create",
+      "Delete",
+      "Get",
+      "Purge",
+      "Recover",
+      "Update",
+    ]
+
+    key_permissions = [
+      "Create",
+    ]
+
+    secret_permissions = [
+      "Get",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  count        = 3
+  name         = "acctestcert${count.index}"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
 func (KeyVaultManagedHardwareSecurityModuleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {
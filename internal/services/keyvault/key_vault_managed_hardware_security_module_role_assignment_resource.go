@@ -0,0 +1,193 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMRoleAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			// assignments can be made at the global scope (`/`), across all keys (`/keys`) or to a single key (`/keys/{name}`)
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^/(keys(/[^/]+)?)?$`),
+					"`scope` must be `/`, `/keys` or `/keys/{key-name}`",
+				),
+			},
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	baseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Data Plane URI for Role Assignment %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	id := parse.NewManagedHSMRoleAssignmentID(*baseUri, d.Get("scope").(string), name)
+
+	existing, err := client.Get(ctx, *baseUri, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Role Assignment %q (%s): %+v", name, *managedHsmId, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_assignment", id.ID())
+	}
+
+	parameters := dataplane.RoleAssignmentCreateParameters{
+		Properties: &dataplane.RoleAssignmentProperties{
+			RoleDefinitionID: utils.String(d.Get("role_definition_id").(string)),
+			PrincipalID:      utils.String(d.Get("principal_id").(string)),
+		},
+	}
+
+	if _, err := client.Create(ctx, *baseUri, id.Scope, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating Role Assignment %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleAssignmentsClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.ManagedHSMBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Managed HSM at URL %q: %+v", id.ManagedHSMBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.ManagedHSMBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Role Assignment %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.ManagedHSMBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+	d.Set("scope", id.Scope)
+	d.Set("resource_manager_id", resp.ID)
+
+	if props := resp.Properties; props != nil {
+		d.Set("role_definition_id", props.RoleDefinitionID)
+		d.Set("principal_id", props.PrincipalID)
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+
+	return nil
+}
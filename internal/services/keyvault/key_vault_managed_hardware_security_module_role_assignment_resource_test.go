@@ -0,0 +1,110 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct {
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+	id := uuid.New().String()
+	roleDefinitionId := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, roleDefinitionId, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_keyScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+	id := uuid.New().String()
+	roleDefinitionId := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyScope(id, roleDefinitionId, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmDataPlaneRoleAssignmentsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic(id, roleDefinitionId string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "%s"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/keys"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}.basic(roleDefinitionId, data), id)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) keyScope(id, roleDefinitionId string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "%s"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/keys/${azurerm_key_vault_managed_hardware_security_module_key.test.name}"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "%s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  role_name      = "acctest-role-%d"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleKeyResource{}.basic(data), id, roleDefinitionId, data.RandomInteger)
+}
@@ -0,0 +1,271 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	dataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMRoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"role_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"permission": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	baseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Data Plane URI for Role Definition %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	// custom role definitions can only be created at the global scope
+	id := parse.NewManagedHSMRoleDefinitionID(*baseUri, string(dataplane.Global), name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, *baseUri, id.Scope, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Role Definition %q (%s): %+v", name, *managedHsmId, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_definition", id.ID())
+		}
+	}
+
+	parameters := dataplane.RoleDefinitionCreateParameters{
+		Properties: &dataplane.RoleDefinitionProperties{
+			RoleName:         utils.String(d.Get("role_name").(string)),
+			Description:      utils.String(d.Get("description").(string)),
+			RoleType:         dataplane.CustomRole,
+			Permissions:      expandManagedHardwareSecurityModuleRoleDefinitionPermissions(d.Get("permission").([]interface{})),
+			AssignableScopes: &[]dataplane.RoleScope{dataplane.Global},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *baseUri, id.Scope, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating Role Definition %q (%s): %+v", name, *managedHsmId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.ManagedHSMBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Managed HSM at URL %q: %+v", id.ManagedHSMBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.ManagedHSMBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Role Definition %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.ManagedHSMBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+	d.Set("resource_manager_id", resp.ID)
+
+	if props := resp.Properties; props != nil {
+		d.Set("role_name", props.RoleName)
+		d.Set("description", props.Description)
+
+		if err := d.Set("permission", flattenManagedHardwareSecurityModuleRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permission`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagedHardwareSecurityModuleRoleDefinitionPermissions(input []interface{}) *[]dataplane.Permission {
+	permissions := make([]dataplane.Permission, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		permissions = append(permissions, dataplane.Permission{
+			Actions:        utils.ExpandStringSlice(v["actions"].(*pluginsdk.Set).List()),
+			NotActions:     utils.ExpandStringSlice(v["not_actions"].(*pluginsdk.Set).List()),
+			DataActions:    utils.ExpandStringSlice(v["data_actions"].(*pluginsdk.Set).List()),
+			NotDataActions: utils.ExpandStringSlice(v["not_data_actions"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &permissions
+}
+
+func flattenManagedHardwareSecurityModuleRoleDefinitionPermissions(input *[]dataplane.Permission) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(v.Actions),
+			"not_actions":      utils.FlattenStringSlice(v.NotActions),
+			"data_actions":     utils.FlattenStringSlice(v.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(v.NotDataActions),
+		})
+	}
+
+	return output
+}
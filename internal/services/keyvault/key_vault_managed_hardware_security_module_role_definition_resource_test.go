@@ -0,0 +1,126 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource struct {
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+	id := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+	id := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) basic(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "%s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  role_name      = "acctest-role-%d"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.activated(data), id, data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) complete(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "%s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  role_name      = "acctest-role-%d"
+  description    = "Acceptance Test Role Definition"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+      "Microsoft.KeyVault/managedHsm/keys/write/action",
+      "Microsoft.KeyVault/managedHsm/keys/encrypt/action",
+      "Microsoft.KeyVault/managedHsm/keys/decrypt/action",
+    ]
+
+    not_data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/delete",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.activated(data), id, data.RandomInteger)
+}
@@ -125,7 +125,7 @@ func resourceKeyVaultManagedStorageAccountCreateUpdate(d *pluginsdk.ResourceData
 		ActiveKeyName:      utils.String(d.Get("storage_account_key").(string)),
 		AutoRegenerateKey:  utils.Bool(d.Get("regenerate_key_automatically").(bool)),
 		RegenerationPeriod: utils.String(d.Get("regeneration_period").(string)),
-		Tags:               tags.Expand(t),
+		Tags:               tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if resp, err := client.SetStorageAccount(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
@@ -230,7 +230,7 @@ func resourceKeyVaultManagedStorageAccountRead(d *pluginsdk.ResourceData, meta i
 	d.Set("regenerate_key_automatically", resp.AutoRegenerateKey)
 	d.Set("regeneration_period", resp.RegenerationPeriod)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultManagedStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
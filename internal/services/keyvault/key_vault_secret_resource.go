@@ -128,7 +128,7 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	parameters := keyvault.SecretSetParameters{
 		Value:            utils.String(value),
 		ContentType:      utils.String(contentType),
-		Tags:             tags.Expand(t),
+		Tags:             tags.Expand(meta.(*clients.Client).Tags, t),
 		SecretAttributes: &keyvault.SecretAttributes{},
 	}
 
@@ -254,7 +254,7 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
 			ContentType:      utils.String(contentType),
-			Tags:             tags.Expand(t),
+			Tags:             tags.Expand(meta.(*clients.Client).Tags, t),
 			SecretAttributes: secretAttributes,
 		}
 
@@ -264,7 +264,7 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	} else {
 		parameters := keyvault.SecretUpdateParameters{
 			ContentType:      utils.String(contentType),
-			Tags:             tags.Expand(t),
+			Tags:             tags.Expand(meta.(*clients.Client).Tags, t),
 			SecretAttributes: secretAttributes,
 		}
 
@@ -358,7 +358,7 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
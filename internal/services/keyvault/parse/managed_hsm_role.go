@@ -0,0 +1,112 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedHSMRoleDefinitionId{}
+var _ resourceid.Formatter = ManagedHSMRoleAssignmentId{}
+
+type ManagedHSMRoleDefinitionId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Name              string
+}
+
+func NewManagedHSMRoleDefinitionID(managedHsmBaseUrl, scope, name string) ManagedHSMRoleDefinitionId {
+	return ManagedHSMRoleDefinitionId{
+		ManagedHSMBaseUrl: managedHsmBaseUrl,
+		Scope:             scope,
+		Name:              name,
+	}
+}
+
+func (id ManagedHSMRoleDefinitionId) ID() string {
+	// example: https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000
+	return formatManagedHSMRoleID(id.ManagedHSMBaseUrl, id.Scope, "roleDefinitions", id.Name)
+}
+
+// ManagedHSMRoleDefinitionID parses a Managed HSM Role Definition ID, which is a Data Plane URI
+func ManagedHSMRoleDefinitionID(input string) (*ManagedHSMRoleDefinitionId, error) {
+	baseUrl, scope, name, err := parseManagedHSMRoleID(input, "roleDefinitions")
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewManagedHSMRoleDefinitionID(baseUrl, scope, name)
+	return &id, nil
+}
+
+type ManagedHSMRoleAssignmentId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Name              string
+}
+
+func NewManagedHSMRoleAssignmentID(managedHsmBaseUrl, scope, name string) ManagedHSMRoleAssignmentId {
+	return ManagedHSMRoleAssignmentId{
+		ManagedHSMBaseUrl: managedHsmBaseUrl,
+		Scope:             scope,
+		Name:              name,
+	}
+}
+
+func (id ManagedHSMRoleAssignmentId) ID() string {
+	// example: https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000
+	return formatManagedHSMRoleID(id.ManagedHSMBaseUrl, id.Scope, "roleAssignments", id.Name)
+}
+
+// ManagedHSMRoleAssignmentID parses a Managed HSM Role Assignment ID, which is a Data Plane URI
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleAssignmentId, error) {
+	baseUrl, scope, name, err := parseManagedHSMRoleID(input, "roleAssignments")
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewManagedHSMRoleAssignmentID(baseUrl, scope, name)
+	return &id, nil
+}
+
+func formatManagedHSMRoleID(baseUrl, scope, itemType, name string) string {
+	// the global scope `/` is omitted from the ID, whereas other scopes (e.g. `/keys`) are included
+	segments := []string{
+		strings.TrimSuffix(baseUrl, "/"),
+		strings.Trim(scope, "/"),
+		"providers/Microsoft.Authorization",
+		itemType,
+		name,
+	}
+	if segments[1] == "" {
+		segments = append(segments[:1], segments[2:]...)
+	}
+	return strings.Join(segments, "/")
+}
+
+func parseManagedHSMRoleID(input, itemType string) (baseUrl string, scope string, name string, err error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return "", "", "", fmt.Errorf("parsing Managed HSM Role ID %q: %+v", input, err)
+	}
+
+	separator := fmt.Sprintf("/providers/Microsoft.Authorization/%s/", itemType)
+	index := strings.Index(idURL.Path, separator)
+	if index == -1 {
+		return "", "", "", fmt.Errorf("expected %q to contain %q", input, separator)
+	}
+
+	name = idURL.Path[index+len(separator):]
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", "", fmt.Errorf("expected a name after %q in %q", separator, input)
+	}
+
+	scope = idURL.Path[:index]
+	if scope == "" {
+		scope = "/"
+	}
+
+	return fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host), scope, name, nil
+}
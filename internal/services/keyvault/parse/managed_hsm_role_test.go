@@ -0,0 +1,129 @@
+package parse
+
+import "testing"
+
+func TestManagedHSMRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    *ManagedHSMRoleDefinitionId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleDefinitionId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := ManagedHSMRoleDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for %q: %+v", tc.Input, err)
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+
+		if actual.ID() != tc.Input {
+			t.Fatalf("Expected ID() to return %q but got %q", tc.Input, actual.ID())
+		}
+	}
+}
+
+func TestManagedHSMRoleAssignmentID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    *ManagedHSMRoleAssignmentId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/abc/def",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/keys/my-key/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys/my-key",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := ManagedHSMRoleAssignmentID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for %q: %+v", tc.Input, err)
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+
+		if actual.ID() != tc.Input {
+			t.Fatalf("Expected ID() to return %q but got %q", tc.Input, actual.ID())
+		}
+	}
+}
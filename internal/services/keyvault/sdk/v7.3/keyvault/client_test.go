@@ -0,0 +1,339 @@
+package keyvault
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// roundTrip describes the request the client is expected to send and the response returned by the test server
+type roundTrip struct {
+	method       string
+	path         string
+	requestBody  string
+	statusCode   int
+	responseBody string
+
+	// withoutAPIVersion is set for the operations which (as in the Azure SDK for Go) don't send an api-version
+	withoutAPIVersion bool
+}
+
+func newRoundTripServer(t *testing.T, expected roundTrip) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != expected.method {
+			t.Errorf("expected the method to be %q but got %q", expected.method, r.Method)
+		}
+		if r.URL.Path != expected.path {
+			t.Errorf("expected the path to be %q but got %q", expected.path, r.URL.Path)
+		}
+		expectedAPIVersion := "7.3"
+		if expected.withoutAPIVersion {
+			expectedAPIVersion = ""
+		}
+		if v := r.URL.Query().Get("api-version"); v != expectedAPIVersion {
+			t.Errorf("expected the api-version to be %q but got %q", expectedAPIVersion, v)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading the request body: %+v", err)
+		}
+		if expected.requestBody == "" {
+			if len(body) != 0 {
+				t.Errorf("expected no request body but got %s", body)
+			}
+		} else {
+			assertJSONEqual(t, expected.requestBody, string(body))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(expected.statusCode)
+		w.Write([]byte(expected.responseBody))
+	}))
+}
+
+func assertJSONEqual(t *testing.T, expected, actual string) {
+	var e, a interface{}
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		t.Fatalf("unmarshaling the expected JSON %s: %+v", expected, err)
+	}
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		t.Fatalf("unmarshaling the actual JSON %s: %+v", actual, err)
+	}
+	if !reflect.DeepEqual(e, a) {
+		t.Errorf("expected the request body to be %s but got %s", expected, actual)
+	}
+}
+
+func stringPtr(input string) *string {
+	return &input
+}
+
+func TestCreateKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPost,
+		path:         "/keys/example/create",
+		requestBody:  `{"kty":"RSA","key_size":2048,"key_ops":["encrypt","decrypt"],"tags":{"env":"test"}}`,
+		statusCode:   http.StatusOK,
+		responseBody: `{"key":{"kid":"https://example.vault.azure.net/keys/example/abc123","kty":"RSA","n":"AQAB","e":"AQAB"},"attributes":{"enabled":true,"exp":1700000000},"tags":{"env":"test"}}`,
+	})
+	defer server.Close()
+
+	keySize := int32(2048)
+	result, err := NewWithoutDefaults().CreateKey(context.TODO(), server.URL, "example", KeyCreateParameters{
+		Kty:     RSA,
+		KeySize: &keySize,
+		KeyOps:  &[]JSONWebKeyOperation{Encrypt, Decrypt},
+		Tags: map[string]*string{
+			"env": stringPtr("test"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating the key: %+v", err)
+	}
+
+	if result.Key == nil || result.Key.Kid == nil || *result.Key.Kid != "https://example.vault.azure.net/keys/example/abc123" {
+		t.Fatalf("expected the key ID to be returned but got %+v", result.Key)
+	}
+	if result.Key.Kty != RSA {
+		t.Fatalf("expected the key type to be %q but got %q", RSA, result.Key.Kty)
+	}
+	if result.Attributes == nil || result.Attributes.Expires == nil || time.Time(*result.Attributes.Expires).Unix() != 1700000000 {
+		t.Fatalf("expected the expiry to be decoded but got %+v", result.Attributes)
+	}
+	if v := result.Tags["env"]; v == nil || *v != "test" {
+		t.Fatalf("expected the tags to be decoded but got %+v", result.Tags)
+	}
+}
+
+func TestGetKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "/keys/example/abc123",
+		statusCode:   http.StatusOK,
+		responseBody: `{"key":{"kid":"https://example.vault.azure.net/keys/example/abc123","kty":"EC","crv":"P-256","x":"eA","y":"eQ"},"attributes":{"enabled":false}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().GetKey(context.TODO(), server.URL, "example", "abc123")
+	if err != nil {
+		t.Fatalf("retrieving the key: %+v", err)
+	}
+
+	if result.Key == nil || result.Key.Crv != P256 || result.Key.X == nil || *result.Key.X != "eA" {
+		t.Fatalf("expected the curve and coordinates to be decoded but got %+v", result.Key)
+	}
+	if result.Attributes == nil || result.Attributes.Enabled == nil || *result.Attributes.Enabled {
+		t.Fatalf("expected the key to be disabled but got %+v", result.Attributes)
+	}
+}
+
+func TestGetKeyNotFound(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "/keys/example/",
+		statusCode:   http.StatusNotFound,
+		responseBody: `{"error":{"code":"KeyNotFound","message":"A key with (name/id) example was not found in this key vault."}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().GetKey(context.TODO(), server.URL, "example", "")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if result.Response.Response == nil || result.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the status code to be %d but got %+v", http.StatusNotFound, result.Response.Response)
+	}
+}
+
+func TestUpdateKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPatch,
+		path:         "/keys/example/abc123",
+		requestBody:  `{"key_ops":["sign"],"attributes":{"enabled":true},"tags":{}}`,
+		statusCode:   http.StatusOK,
+		responseBody: `{"key":{"kid":"https://example.vault.azure.net/keys/example/abc123","key_ops":["sign"]},"attributes":{"enabled":true}}`,
+	})
+	defer server.Close()
+
+	enabled := true
+	result, err := NewWithoutDefaults().UpdateKey(context.TODO(), server.URL, "example", "abc123", KeyUpdateParameters{
+		KeyOps: &[]JSONWebKeyOperation{Sign},
+		KeyAttributes: &KeyAttributes{
+			Enabled: &enabled,
+		},
+		Tags: map[string]*string{},
+	})
+	if err != nil {
+		t.Fatalf("updating the key: %+v", err)
+	}
+
+	if result.Key == nil || result.Key.KeyOps == nil || !reflect.DeepEqual(*result.Key.KeyOps, []string{"sign"}) {
+		t.Fatalf("expected the key operations to be decoded but got %+v", result.Key)
+	}
+}
+
+func TestDeleteKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodDelete,
+		path:         "/keys/example",
+		statusCode:   http.StatusOK,
+		responseBody: `{"recoveryId":"https://example.vault.azure.net/deletedkeys/example","deletedDate":1600000000,"scheduledPurgeDate":1607776000,"key":{"kid":"https://example.vault.azure.net/keys/example/abc123"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().DeleteKey(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("deleting the key: %+v", err)
+	}
+
+	if result.RecoveryID == nil || *result.RecoveryID != "https://example.vault.azure.net/deletedkeys/example" {
+		t.Fatalf("expected the recovery ID to be decoded but got %+v", result.RecoveryID)
+	}
+	if result.ScheduledPurgeDate == nil || time.Time(*result.ScheduledPurgeDate).Unix() != 1607776000 {
+		t.Fatalf("expected the scheduled purge date to be decoded but got %+v", result.ScheduledPurgeDate)
+	}
+}
+
+func TestGetDeletedKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "/deletedkeys/example",
+		statusCode:   http.StatusOK,
+		responseBody: `{"recoveryId":"https://example.vault.azure.net/deletedkeys/example","attributes":{"recoveryLevel":"Recoverable+Purgeable","recoverableDays":90}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().GetDeletedKey(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("retrieving the deleted key: %+v", err)
+	}
+
+	if result.Attributes == nil || result.Attributes.RecoveryLevel != RecoverablePurgeable {
+		t.Fatalf("expected the recovery level to be %q but got %+v", RecoverablePurgeable, result.Attributes)
+	}
+	if result.Attributes.RecoverableDays == nil || *result.Attributes.RecoverableDays != 90 {
+		t.Fatalf("expected the recoverable days to be decoded but got %+v", result.Attributes.RecoverableDays)
+	}
+}
+
+func TestPurgeDeletedKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:     http.MethodDelete,
+		path:       "/deletedkeys/example",
+		statusCode: http.StatusNoContent,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().PurgeDeletedKey(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("purging the deleted key: %+v", err)
+	}
+	if result.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the status code to be %d but got %d", http.StatusNoContent, result.StatusCode)
+	}
+}
+
+func TestRecoverDeletedKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPost,
+		path:         "/deletedkeys/example/recover",
+		statusCode:   http.StatusOK,
+		responseBody: `{"key":{"kid":"https://example.vault.azure.net/keys/example/abc123"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().RecoverDeletedKey(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("recovering the deleted key: %+v", err)
+	}
+	if result.Key == nil || result.Key.Kid == nil {
+		t.Fatalf("expected the key ID to be decoded but got %+v", result.Key)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPost,
+		path:         "/keys/example/rotate",
+		statusCode:   http.StatusOK,
+		responseBody: `{"key":{"kid":"https://example.vault.azure.net/keys/example/def456"},"managed":false}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().RotateKey(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("rotating the key: %+v", err)
+	}
+	if result.Key == nil || result.Key.Kid == nil || *result.Key.Kid != "https://example.vault.azure.net/keys/example/def456" {
+		t.Fatalf("expected the new key version to be returned but got %+v", result.Key)
+	}
+}
+
+func TestGetKeyRotationPolicy(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "/keys/example/rotationpolicy",
+		statusCode:   http.StatusOK,
+		responseBody: `{"id":"https://example.vault.azure.net/keys/example/rotationpolicy","lifetimeActions":[{"trigger":{"timeAfterCreate":"P30D"},"action":{"type":"Rotate"}},{"trigger":{"timeBeforeExpiry":"P7D"},"action":{"type":"Notify"}}],"attributes":{"expiryTime":"P90D","created":1600000000}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().GetKeyRotationPolicy(context.TODO(), server.URL, "example")
+	if err != nil {
+		t.Fatalf("retrieving the rotation policy: %+v", err)
+	}
+
+	if result.LifetimeActions == nil || len(*result.LifetimeActions) != 2 {
+		t.Fatalf("expected 2 lifetime actions but got %+v", result.LifetimeActions)
+	}
+	rotate := (*result.LifetimeActions)[0]
+	if rotate.Action == nil || rotate.Action.Type != Rotate || rotate.Trigger == nil || rotate.Trigger.TimeAfterCreate == nil || *rotate.Trigger.TimeAfterCreate != "P30D" {
+		t.Fatalf("expected the first action to rotate after 30 days but got %+v", rotate)
+	}
+	notify := (*result.LifetimeActions)[1]
+	if notify.Action == nil || notify.Action.Type != Notify || notify.Trigger == nil || notify.Trigger.TimeBeforeExpiry == nil || *notify.Trigger.TimeBeforeExpiry != "P7D" {
+		t.Fatalf("expected the second action to notify 7 days before expiry but got %+v", notify)
+	}
+	if result.Attributes == nil || result.Attributes.ExpiryTime == nil || *result.Attributes.ExpiryTime != "P90D" {
+		t.Fatalf("expected the expiry time to be decoded but got %+v", result.Attributes)
+	}
+}
+
+func TestUpdateKeyRotationPolicy(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPut,
+		path:         "/keys/example/rotationpolicy",
+		requestBody:  `{"lifetimeActions":[{"trigger":{"timeBeforeExpiry":"P30D"},"action":{"type":"Rotate"}}],"attributes":{"expiryTime":"P1Y"}}`,
+		statusCode:   http.StatusOK,
+		responseBody: `{"id":"https://example.vault.azure.net/keys/example/rotationpolicy","lifetimeActions":[{"trigger":{"timeBeforeExpiry":"P30D"},"action":{"type":"Rotate"}}],"attributes":{"expiryTime":"P1Y"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewWithoutDefaults().UpdateKeyRotationPolicy(context.TODO(), server.URL, "example", KeyRotationPolicy{
+		LifetimeActions: &[]LifetimeActions{
+			{
+				Trigger: &LifetimeActionsTrigger{
+					TimeBeforeExpiry: stringPtr("P30D"),
+				},
+				Action: &LifetimeActionsType{
+					Type: Rotate,
+				},
+			},
+		},
+		Attributes: &KeyRotationPolicyAttributes{
+			ExpiryTime: stringPtr("P1Y"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("updating the rotation policy: %+v", err)
+	}
+	if result.ID == nil || *result.ID != "https://example.vault.azure.net/keys/example/rotationpolicy" {
+		t.Fatalf("expected the policy ID to be decoded but got %+v", result.ID)
+	}
+}
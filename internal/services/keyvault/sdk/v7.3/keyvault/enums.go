@@ -0,0 +1,170 @@
+package keyvault
+
+// ActionType enumerates the values for action type.
+type ActionType string
+
+const (
+	// Notify ...
+	Notify ActionType = "Notify"
+	// Rotate ...
+	Rotate ActionType = "Rotate"
+)
+
+// PossibleActionTypeValues returns an array of possible values for the ActionType const type.
+func PossibleActionTypeValues() []ActionType {
+	return []ActionType{Notify, Rotate}
+}
+
+// DeletionRecoveryLevel enumerates the values for deletion recovery level.
+type DeletionRecoveryLevel string
+
+const (
+	// CustomizedRecoverable ...
+	CustomizedRecoverable DeletionRecoveryLevel = "CustomizedRecoverable"
+	// CustomizedRecoverableProtectedSubscription ...
+	CustomizedRecoverableProtectedSubscription DeletionRecoveryLevel = "CustomizedRecoverable+ProtectedSubscription"
+	// CustomizedRecoverablePurgeable ...
+	CustomizedRecoverablePurgeable DeletionRecoveryLevel = "CustomizedRecoverable+Purgeable"
+	// Purgeable ...
+	Purgeable DeletionRecoveryLevel = "Purgeable"
+	// Recoverable ...
+	Recoverable DeletionRecoveryLevel = "Recoverable"
+	// RecoverableProtectedSubscription ...
+	RecoverableProtectedSubscription DeletionRecoveryLevel = "Recoverable+ProtectedSubscription"
+	// RecoverablePurgeable ...
+	RecoverablePurgeable DeletionRecoveryLevel = "Recoverable+Purgeable"
+)
+
+// PossibleDeletionRecoveryLevelValues returns an array of possible values for the DeletionRecoveryLevel const type.
+func PossibleDeletionRecoveryLevelValues() []DeletionRecoveryLevel {
+	return []DeletionRecoveryLevel{CustomizedRecoverable, CustomizedRecoverableProtectedSubscription, CustomizedRecoverablePurgeable, Purgeable, Recoverable, RecoverableProtectedSubscription, RecoverablePurgeable}
+}
+
+// JSONWebKeyCurveName enumerates the values for json web key curve name.
+type JSONWebKeyCurveName string
+
+const (
+	// P256 The NIST P-256 elliptic curve, AKA SECG curve SECP256R1.
+	P256 JSONWebKeyCurveName = "P-256"
+	// P256K The SECG SECP256K1 elliptic curve.
+	P256K JSONWebKeyCurveName = "P-256K"
+	// P384 The NIST P-384 elliptic curve, AKA SECG curve SECP384R1.
+	P384 JSONWebKeyCurveName = "P-384"
+	// P521 The NIST P-521 elliptic curve, AKA SECG curve SECP521R1.
+	P521 JSONWebKeyCurveName = "P-521"
+)
+
+// PossibleJSONWebKeyCurveNameValues returns an array of possible values for the JSONWebKeyCurveName const type.
+func PossibleJSONWebKeyCurveNameValues() []JSONWebKeyCurveName {
+	return []JSONWebKeyCurveName{P256, P256K, P384, P521}
+}
+
+// JSONWebKeyOperation enumerates the values for json web key operation.
+type JSONWebKeyOperation string
+
+const (
+	// Decrypt ...
+	Decrypt JSONWebKeyOperation = "decrypt"
+	// Encrypt ...
+	Encrypt JSONWebKeyOperation = "encrypt"
+	// Export ...
+	Export JSONWebKeyOperation = "export"
+	// Import ...
+	Import JSONWebKeyOperation = "import"
+	// Sign ...
+	Sign JSONWebKeyOperation = "sign"
+	// UnwrapKey ...
+	UnwrapKey JSONWebKeyOperation = "unwrapKey"
+	// Verify ...
+	Verify JSONWebKeyOperation = "verify"
+	// WrapKey ...
+	WrapKey JSONWebKeyOperation = "wrapKey"
+)
+
+// PossibleJSONWebKeyOperationValues returns an array of possible values for the JSONWebKeyOperation const type.
+func PossibleJSONWebKeyOperationValues() []JSONWebKeyOperation {
+	return []JSONWebKeyOperation{Decrypt, Encrypt, Export, Import, Sign, UnwrapKey, Verify, WrapKey}
+}
+
+// JSONWebKeyType enumerates the values for json web key type.
+type JSONWebKeyType string
+
+const (
+	// EC Elliptic Curve.
+	EC JSONWebKeyType = "EC"
+	// ECHSM Elliptic Curve with a private key which is stored in the HSM.
+	ECHSM JSONWebKeyType = "EC-HSM"
+	// Oct Octet sequence (used to represent symmetric keys)
+	Oct JSONWebKeyType = "oct"
+	// OctHSM Octet sequence (used to represent symmetric keys) which is stored the HSM.
+	OctHSM JSONWebKeyType = "oct-HSM"
+	// RSA RSA (https://tools.ietf.org/html/rfc3447)
+	RSA JSONWebKeyType = "RSA"
+	// RSAHSM RSA with a private key which is stored in the HSM.
+	RSAHSM JSONWebKeyType = "RSA-HSM"
+)
+
+// PossibleJSONWebKeyTypeValues returns an array of possible values for the JSONWebKeyType const type.
+func PossibleJSONWebKeyTypeValues() []JSONWebKeyType {
+	return []JSONWebKeyType{EC, ECHSM, Oct, OctHSM, RSA, RSAHSM}
+}
+
+// OperationStatus enumerates the values for operation status.
+type OperationStatus string
+
+const (
+	// Failed ...
+	Failed OperationStatus = "Failed"
+	// InProgress ...
+	InProgress OperationStatus = "InProgress"
+	// Success ...
+	Success OperationStatus = "Success"
+)
+
+// PossibleOperationStatusValues returns an array of possible values for the OperationStatus const type.
+func PossibleOperationStatusValues() []OperationStatus {
+	return []OperationStatus{Failed, InProgress, Success}
+}
+
+// RoleDefinitionType enumerates the values for role definition type.
+type RoleDefinitionType string
+
+const (
+	// MicrosoftAuthorizationroleDefinitions ...
+	MicrosoftAuthorizationroleDefinitions RoleDefinitionType = "Microsoft.Authorization/roleDefinitions"
+)
+
+// PossibleRoleDefinitionTypeValues returns an array of possible values for the RoleDefinitionType const type.
+func PossibleRoleDefinitionTypeValues() []RoleDefinitionType {
+	return []RoleDefinitionType{MicrosoftAuthorizationroleDefinitions}
+}
+
+// RoleScope enumerates the values for role scope.
+type RoleScope string
+
+const (
+	// Global Global scope
+	Global RoleScope = "/"
+	// Keys Keys scope
+	Keys RoleScope = "/keys"
+)
+
+// PossibleRoleScopeValues returns an array of possible values for the RoleScope const type.
+func PossibleRoleScopeValues() []RoleScope {
+	return []RoleScope{Global, Keys}
+}
+
+// RoleType enumerates the values for role type.
+type RoleType string
+
+const (
+	// BuiltInRole Built in role.
+	BuiltInRole RoleType = "AKVBuiltInRole"
+	// CustomRole Custom role.
+	CustomRole RoleType = "CustomRole"
+)
+
+// PossibleRoleTypeValues returns an array of possible values for the RoleType const type.
+func PossibleRoleTypeValues() []RoleType {
+	return []RoleType{BuiltInRole, CustomRole}
+}
@@ -0,0 +1,165 @@
+package keyvault
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+// HSMSecurityDomainClient is the key vault client which manages the Security Domain of a Managed HSM.
+type HSMSecurityDomainClient struct {
+	BaseClient
+}
+
+// NewHSMSecurityDomainClient creates an instance of the HSMSecurityDomainClient client.
+func NewHSMSecurityDomainClient() HSMSecurityDomainClient {
+	return HSMSecurityDomainClient{New()}
+}
+
+// Download retrieves the Security Domain from the HSM enclave, which activates the Managed HSM. This operation is
+// asynchronous - the status of which can be retrieved using DownloadPending.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// certificateInfoObject - security domain download operation requires customer to provide N certificates
+// (minimum 3 and maximum 10) containing public key in JWK format.
+func (client HSMSecurityDomainClient) Download(ctx context.Context, vaultBaseURL string, certificateInfoObject CertificateInfoObject) (result SecurityDomainObject, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/HSMSecurityDomainClient.Download")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.DownloadPreparer(ctx, vaultBaseURL, certificateInfoObject)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "Download", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.DownloadSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "Download", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.DownloadResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "Download", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DownloadPreparer prepares the Download request.
+func (client HSMSecurityDomainClient) DownloadPreparer(ctx context.Context, vaultBaseURL string, certificateInfoObject CertificateInfoObject) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPath("/securitydomain/download"),
+		autorest.WithJSON(certificateInfoObject),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// DownloadSender sends the Download request. The method will close the
+// http.Response Body if it receives an error.
+func (client HSMSecurityDomainClient) DownloadSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// DownloadResponder handles the response to the Download request. The method always
+// closes the http.Response Body.
+func (client HSMSecurityDomainClient) DownloadResponder(resp *http.Response) (result SecurityDomainObject, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// DownloadPending retrieves the status of the Security Domain download operation.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+func (client HSMSecurityDomainClient) DownloadPending(ctx context.Context, vaultBaseURL string) (result SecurityDomainOperationStatus, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/HSMSecurityDomainClient.DownloadPending")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.DownloadPendingPreparer(ctx, vaultBaseURL)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "DownloadPending", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.DownloadPendingSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "DownloadPending", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.DownloadPendingResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.HSMSecurityDomainClient", "DownloadPending", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DownloadPendingPreparer prepares the DownloadPending request.
+func (client HSMSecurityDomainClient) DownloadPendingPreparer(ctx context.Context, vaultBaseURL string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPath("/securitydomain/download/pending"))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// DownloadPendingSender sends the DownloadPending request. The method will close the
+// http.Response Body if it receives an error.
+func (client HSMSecurityDomainClient) DownloadPendingSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// DownloadPendingResponder handles the response to the DownloadPending request. The method always
+// closes the http.Response Body.
+func (client HSMSecurityDomainClient) DownloadPendingResponder(resp *http.Response) (result SecurityDomainOperationStatus, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
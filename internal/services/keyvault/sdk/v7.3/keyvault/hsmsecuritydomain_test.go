@@ -0,0 +1,62 @@
+package keyvault
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestHSMSecurityDomainDownload(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPost,
+		path:         "/securitydomain/download",
+		requestBody:  `{"certificates":[{"kty":"RSA","key_ops":["verify","encrypt","wrapKey"],"n":"AQAB","e":"AQAB","x5c":["MIIB"],"alg":"RSA-OAEP-256"}],"required":1}`,
+		statusCode:   http.StatusAccepted,
+		responseBody: `{"value":"security-domain"}`,
+	})
+	defer server.Close()
+
+	required := int32(1)
+	result, err := NewHSMSecurityDomainClient().Download(context.TODO(), server.URL, CertificateInfoObject{
+		Certificates: &[]SecurityDomainJSONWebKey{
+			{
+				Kty:    stringPtr("RSA"),
+				KeyOps: &[]string{"verify", "encrypt", "wrapKey"},
+				N:      stringPtr("AQAB"),
+				E:      stringPtr("AQAB"),
+				X5c:    &[]string{"MIIB"},
+				Alg:    stringPtr("RSA-OAEP-256"),
+			},
+		},
+		Required: &required,
+	})
+	if err != nil {
+		t.Fatalf("downloading the security domain: %+v", err)
+	}
+	if result.Value == nil || *result.Value != "security-domain" {
+		t.Fatalf("expected the security domain to be decoded but got %+v", result.Value)
+	}
+}
+
+func TestHSMSecurityDomainDownloadPending(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "/securitydomain/download/pending",
+		statusCode:   http.StatusOK,
+		responseBody: `{"status":"Failed","status_details":"the certificates couldn't be used"}`,
+
+		withoutAPIVersion: true,
+	})
+	defer server.Close()
+
+	result, err := NewHSMSecurityDomainClient().DownloadPending(context.TODO(), server.URL)
+	if err != nil {
+		t.Fatalf("polling the security domain download: %+v", err)
+	}
+	if result.Status != Failed {
+		t.Fatalf("expected the status to be %q but got %q", Failed, result.Status)
+	}
+	if result.StatusDetails == nil || *result.StatusDetails != "the certificates couldn't be used" {
+		t.Fatalf("expected the status details to be decoded but got %+v", result.StatusDetails)
+	}
+}
@@ -0,0 +1,277 @@
+package keyvault
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// The package's fully qualified name.
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyvault"
+
+// CertificateInfoObject the certificates used to encrypt the Security Domain when it's downloaded.
+type CertificateInfoObject struct {
+	// Certificates - Certificates needed from customer
+	Certificates *[]SecurityDomainJSONWebKey `json:"certificates,omitempty"`
+	// Required - Customer to specify the number of certificates (minimum 2 and maximum 10) to restore security domain
+	Required *int32 `json:"required,omitempty"`
+}
+
+// DeletedKeyBundle a DeletedKeyBundle consisting of a WebKey plus its Attributes and deletion info
+type DeletedKeyBundle struct {
+	autorest.Response `json:"-"`
+	// RecoveryID - The url of the recovery object, used to identify and recover the deleted key.
+	RecoveryID *string `json:"recoveryId,omitempty"`
+	// ScheduledPurgeDate - READ-ONLY; The time when the key is scheduled to be purged, in UTC
+	ScheduledPurgeDate *date.UnixTime `json:"scheduledPurgeDate,omitempty"`
+	// DeletedDate - READ-ONLY; The time when the key was deleted, in UTC
+	DeletedDate *date.UnixTime `json:"deletedDate,omitempty"`
+	// Key - The Json web key.
+	Key *JSONWebKey `json:"key,omitempty"`
+	// Attributes - The key management attributes.
+	Attributes *KeyAttributes `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// Managed - READ-ONLY; True if the key's lifetime is managed by key vault. If this is a key backing a certificate, then managed will be true.
+	Managed *bool `json:"managed,omitempty"`
+}
+
+// JSONWebKey as of http://tools.ietf.org/html/draft-ietf-jose-json-web-key-18
+type JSONWebKey struct {
+	// Kid - Key identifier.
+	Kid *string `json:"kid,omitempty"`
+	// Kty - JsonWebKey Key Type (kty), as defined in https://tools.ietf.org/html/draft-ietf-jose-json-web-algorithms-40. Possible values include: 'EC', 'ECHSM', 'RSA', 'RSAHSM', 'Oct', 'OctHSM'
+	Kty    JSONWebKeyType `json:"kty,omitempty"`
+	KeyOps *[]string      `json:"key_ops,omitempty"`
+	// N - RSA modulus.
+	N *string `json:"n,omitempty"`
+	// E - RSA public exponent.
+	E *string `json:"e,omitempty"`
+	// Crv - Elliptic curve name. For valid values, see JsonWebKeyCurveName. Possible values include: 'P256', 'P384', 'P521', 'P256K'
+	Crv JSONWebKeyCurveName `json:"crv,omitempty"`
+	// X - X component of an EC public key.
+	X *string `json:"x,omitempty"`
+	// Y - Y component of an EC public key.
+	Y *string `json:"y,omitempty"`
+}
+
+// KeyAttributes the attributes of a key managed by the key vault service.
+type KeyAttributes struct {
+	// RecoverableDays - READ-ONLY; softDelete data retention days. Value should be >=7 and <=90 when softDelete enabled, otherwise 0.
+	RecoverableDays *int32 `json:"recoverableDays,omitempty"`
+	// RecoveryLevel - READ-ONLY; Reflects the deletion recovery level currently in effect for keys in the current vault. If it contains 'Purgeable' the key can be permanently deleted by a privileged user; otherwise, only the system can purge the key, at the end of the retention interval. Possible values include: 'Purgeable', 'RecoverablePurgeable', 'Recoverable', 'RecoverableProtectedSubscription', 'CustomizedRecoverablePurgeable', 'CustomizedRecoverable', 'CustomizedRecoverableProtectedSubscription'
+	RecoveryLevel DeletionRecoveryLevel `json:"recoveryLevel,omitempty"`
+	// Exportable - Indicates if the private key can be exported.
+	Exportable *bool `json:"exportable,omitempty"`
+	// Enabled - Determines whether the object is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// NotBefore - Not before date in UTC.
+	NotBefore *date.UnixTime `json:"nbf,omitempty"`
+	// Expires - Expiry date in UTC.
+	Expires *date.UnixTime `json:"exp,omitempty"`
+	// Created - READ-ONLY; Creation time in UTC.
+	Created *date.UnixTime `json:"created,omitempty"`
+	// Updated - READ-ONLY; Last updated time in UTC.
+	Updated *date.UnixTime `json:"updated,omitempty"`
+}
+
+// KeyBundle a KeyBundle consisting of a WebKey plus its attributes.
+type KeyBundle struct {
+	autorest.Response `json:"-"`
+	// Key - The Json web key.
+	Key *JSONWebKey `json:"key,omitempty"`
+	// Attributes - The key management attributes.
+	Attributes *KeyAttributes `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// Managed - READ-ONLY; True if the key's lifetime is managed by key vault. If this is a key backing a certificate, then managed will be true.
+	Managed *bool `json:"managed,omitempty"`
+}
+
+// KeyCreateParameters the key create parameters.
+type KeyCreateParameters struct {
+	// Kty - The type of key to create. For valid values, see JsonWebKeyType. Possible values include: 'EC', 'ECHSM', 'RSA', 'RSAHSM', 'Oct', 'OctHSM'
+	Kty JSONWebKeyType `json:"kty,omitempty"`
+	// KeySize - The key size in bits. For example: 2048, 3072, or 4096 for RSA.
+	KeySize *int32 `json:"key_size,omitempty"`
+	// PublicExponent - The public exponent for a RSA key.
+	PublicExponent *int32                 `json:"public_exponent,omitempty"`
+	KeyOps         *[]JSONWebKeyOperation `json:"key_ops,omitempty"`
+	KeyAttributes  *KeyAttributes         `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// Curve - Elliptic curve name. For valid values, see JsonWebKeyCurveName. Possible values include: 'P256', 'P384', 'P521', 'P256K'
+	Curve JSONWebKeyCurveName `json:"crv,omitempty"`
+}
+
+// KeyRotationPolicy management policy for a key.
+type KeyRotationPolicy struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The key policy id.
+	ID *string `json:"id,omitempty"`
+	// LifetimeActions - Actions that will be performed by Key Vault over the lifetime of a key. For preview, lifetimeActions can only have two items at maximum: one for rotate, one for notify. Notification time would be default to 30 days before expiry and it is not configurable.
+	LifetimeActions *[]LifetimeActions `json:"lifetimeActions,omitempty"`
+	// Attributes - The key rotation policy attributes.
+	Attributes *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+}
+
+// KeyRotationPolicyAttributes the key rotation policy attributes.
+type KeyRotationPolicyAttributes struct {
+	// ExpiryTime - The expiryTime will be applied on the new key version. It should be at least 28 days. It will be in ISO 8601 Format. Examples: 90 days: P90D, 3 months: P3M, 48 hours: PT48H, 1 year and 10 days: P1Y10D
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	// Created - READ-ONLY; The key rotation policy created time in UTC.
+	Created *date.UnixTime `json:"created,omitempty"`
+	// Updated - READ-ONLY; The key rotation policy's last updated time in UTC.
+	Updated *date.UnixTime `json:"updated,omitempty"`
+}
+
+// KeyUpdateParameters the key update parameters.
+type KeyUpdateParameters struct {
+	// KeyOps - Json web key operations. For more information on possible key operations, see JsonWebKeyOperation.
+	KeyOps        *[]JSONWebKeyOperation `json:"key_ops,omitempty"`
+	KeyAttributes *KeyAttributes         `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+}
+
+// LifetimeActions action and its trigger that will be performed by Key Vault over the lifetime of a key.
+type LifetimeActions struct {
+	// Trigger - The condition that will execute the action.
+	Trigger *LifetimeActionsTrigger `json:"trigger,omitempty"`
+	// Action - The action that will be executed.
+	Action *LifetimeActionsType `json:"action,omitempty"`
+}
+
+// LifetimeActionsTrigger a condition to be satisfied for an action to be executed.
+type LifetimeActionsTrigger struct {
+	// TimeAfterCreate - Time after creation to attempt to rotate. It only applies to rotate. It will be in ISO 8601 duration format. Example: 90 days : "P90D"
+	TimeAfterCreate *string `json:"timeAfterCreate,omitempty"`
+	// TimeBeforeExpiry - Time before expiry to attempt to rotate or notify. It will be in ISO 8601 duration format. Example: 90 days : "P90D"
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+// LifetimeActionsType the action that will be executed.
+type LifetimeActionsType struct {
+	// Type - The type of the action. Possible values include: 'Rotate', 'Notify'
+	Type ActionType `json:"type,omitempty"`
+}
+
+// Permission role definition permissions.
+type Permission struct {
+	// Actions - Action permissions that are granted.
+	Actions *[]string `json:"actions,omitempty"`
+	// NotActions - Action permissions that are excluded but not denied. They may be granted by other role definitions assigned to a principal.
+	NotActions *[]string `json:"notActions,omitempty"`
+	// DataActions - Data action permissions that are granted.
+	DataActions *[]string `json:"dataActions,omitempty"`
+	// NotDataActions - Data action permissions that are excluded but not denied. They may be granted by other role definitions assigned to a principal.
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
+
+// RoleAssignment role Assignments
+type RoleAssignment struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The role assignment ID.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The role assignment name.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The role assignment type.
+	Type *string `json:"type,omitempty"`
+	// Properties - Role assignment properties.
+	Properties *RoleAssignmentPropertiesWithScope `json:"properties,omitempty"`
+}
+
+// RoleAssignmentCreateParameters role assignment create parameters.
+type RoleAssignmentCreateParameters struct {
+	// Properties - Role assignment properties.
+	Properties *RoleAssignmentProperties `json:"properties,omitempty"`
+}
+
+// RoleAssignmentProperties role assignment properties.
+type RoleAssignmentProperties struct {
+	// RoleDefinitionID - The role definition ID used in the role assignment.
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	// PrincipalID - The principal ID assigned to the role. This maps to the ID inside the Active Directory. It can point to a user, service principal, or security group.
+	PrincipalID *string `json:"principalId,omitempty"`
+}
+
+// RoleAssignmentPropertiesWithScope role assignment properties with scope.
+type RoleAssignmentPropertiesWithScope struct {
+	// Scope - The role scope. Possible values include: 'Global', 'Keys'
+	Scope RoleScope `json:"scope,omitempty"`
+	// RoleDefinitionID - The role definition ID.
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	// PrincipalID - The principal ID.
+	PrincipalID *string `json:"principalId,omitempty"`
+}
+
+// RoleDefinition role definition.
+type RoleDefinition struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The role definition ID.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The role definition name.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The role definition type. Possible values include: 'MicrosoftAuthorizationroleDefinitions'
+	Type RoleDefinitionType `json:"type,omitempty"`
+	// Properties - Role definition properties.
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// RoleDefinitionCreateParameters role definition create parameters.
+type RoleDefinitionCreateParameters struct {
+	// Properties - Role definition properties.
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// RoleDefinitionProperties role definition properties.
+type RoleDefinitionProperties struct {
+	// RoleName - The role name.
+	RoleName *string `json:"roleName,omitempty"`
+	// Description - The role definition description.
+	Description *string `json:"description,omitempty"`
+	// RoleType - The role type. Possible values include: 'BuiltInRole', 'CustomRole'
+	RoleType RoleType `json:"type,omitempty"`
+	// Permissions - Role definition permissions.
+	Permissions *[]Permission `json:"permissions,omitempty"`
+	// AssignableScopes - Role definition assignable scopes.
+	AssignableScopes *[]RoleScope `json:"assignableScopes,omitempty"`
+}
+
+// SecurityDomainJSONWebKey the public key of a certificate used to encrypt the Security Domain, in JWK format.
+type SecurityDomainJSONWebKey struct {
+	// Kid - Key identifier.
+	Kid *string `json:"kid,omitempty"`
+	// Kty - JsonWebKey Key Type (kty), as defined in https://tools.ietf.org/html/draft-ietf-jose-json-web-algorithms-40. For security domain this value must be RSA
+	Kty    *string   `json:"kty,omitempty"`
+	KeyOps *[]string `json:"key_ops,omitempty"`
+	// N - RSA modulus.
+	N *string `json:"n,omitempty"`
+	// E - RSA public exponent.
+	E *string `json:"e,omitempty"`
+	// X5c - X509 certificate chain parameter
+	X5c *[]string `json:"x5c,omitempty"`
+	// Use - Public Key Use Parameter. This is optional and if present must be enc.
+	Use *string `json:"use,omitempty"`
+	// X5t - X509 certificate SHA1 thumbprint. This is optional.
+	X5t *string `json:"x5t,omitempty"`
+	// X5tS256 - X509 certificate SHA256 thumbprint.
+	X5tS256 *string `json:"x5t#S256,omitempty"`
+	// Alg - Algorithm intended for use with the key.
+	Alg *string `json:"alg,omitempty"`
+}
+
+// SecurityDomainObject the Security Domain, encrypted using the specified certificates.
+type SecurityDomainObject struct {
+	autorest.Response `json:"-"`
+	// Value - The Security Domain.
+	Value *string `json:"value,omitempty"`
+}
+
+// SecurityDomainOperationStatus the status of a Security Domain operation.
+type SecurityDomainOperationStatus struct {
+	autorest.Response `json:"-"`
+	// Status - operation status. Possible values include: 'Success', 'InProgress', 'Failed'
+	Status OperationStatus `json:"status,omitempty"`
+	// StatusDetails - Details of the operation status.
+	StatusDetails *string `json:"status_details,omitempty"`
+}
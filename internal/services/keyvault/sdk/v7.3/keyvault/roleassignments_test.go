@@ -0,0 +1,88 @@
+package keyvault
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// the scope is substituted as-is (matching the Azure SDK for Go), so scopes beginning with `/` result in a leading `//`
+
+func TestRoleAssignmentsCreate(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPut,
+		path:         "//keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000001",
+		requestBody:  `{"properties":{"roleDefinitionId":"Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b","principalId":"00000000-0000-0000-0000-000000000002"}}`,
+		statusCode:   http.StatusCreated,
+		responseBody: `{"id":"/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000001","name":"00000000-0000-0000-0000-000000000001","type":"Microsoft.Authorization/roleAssignments","properties":{"scope":"/keys","roleDefinitionId":"Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b","principalId":"00000000-0000-0000-0000-000000000002"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleAssignmentsClient().Create(context.TODO(), server.URL, "/keys", "00000000-0000-0000-0000-000000000001", RoleAssignmentCreateParameters{
+		Properties: &RoleAssignmentProperties{
+			RoleDefinitionID: stringPtr("Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"),
+			PrincipalID:      stringPtr("00000000-0000-0000-0000-000000000002"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating the role assignment: %+v", err)
+	}
+
+	if result.Properties == nil || result.Properties.Scope != Keys {
+		t.Fatalf("expected the scope to be %q but got %+v", Keys, result.Properties)
+	}
+	if result.Properties.PrincipalID == nil || *result.Properties.PrincipalID != "00000000-0000-0000-0000-000000000002" {
+		t.Fatalf("expected the principal ID to be decoded but got %+v", result.Properties.PrincipalID)
+	}
+}
+
+func TestRoleAssignmentsCreateUnexpectedStatus(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPut,
+		path:         "///providers/Microsoft.Authorization/roleAssignments/example",
+		requestBody:  `{}`,
+		statusCode:   http.StatusForbidden,
+		responseBody: `{"error":{"code":"Forbidden","message":"Operation not allowed"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleAssignmentsClient().Create(context.TODO(), server.URL, "/", "example", RoleAssignmentCreateParameters{})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if result.Response.Response == nil || result.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected the status code to be %d but got %+v", http.StatusForbidden, result.Response.Response)
+	}
+}
+
+func TestRoleAssignmentsGet(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "///providers/Microsoft.Authorization/roleAssignments/example",
+		statusCode:   http.StatusOK,
+		responseBody: `{"id":"/providers/Microsoft.Authorization/roleAssignments/example","name":"example","properties":{"scope":"/"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleAssignmentsClient().Get(context.TODO(), server.URL, "/", "example")
+	if err != nil {
+		t.Fatalf("retrieving the role assignment: %+v", err)
+	}
+	if result.Name == nil || *result.Name != "example" || result.Properties == nil || result.Properties.Scope != Global {
+		t.Fatalf("expected the role assignment to be decoded but got %+v", result)
+	}
+}
+
+func TestRoleAssignmentsDelete(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodDelete,
+		path:         "///providers/Microsoft.Authorization/roleAssignments/example",
+		statusCode:   http.StatusOK,
+		responseBody: `{"name":"example"}`,
+	})
+	defer server.Close()
+
+	if _, err := NewRoleAssignmentsClient().Delete(context.TODO(), server.URL, "/", "example"); err != nil {
+		t.Fatalf("deleting the role assignment: %+v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package keyvault
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRoleDefinitionsCreateOrUpdate(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodPut,
+		path:         "///providers/Microsoft.Authorization/roleDefinitions/example",
+		requestBody:  `{"properties":{"roleName":"Example","description":"An example role","type":"CustomRole","permissions":[{"dataActions":["Microsoft.KeyVault/managedHsm/keys/read/action"]}],"assignableScopes":["/"]}}`,
+		statusCode:   http.StatusCreated,
+		responseBody: `{"id":"/providers/Microsoft.Authorization/roleDefinitions/example","name":"example","type":"Microsoft.Authorization/roleDefinitions","properties":{"roleName":"Example","type":"CustomRole","permissions":[{"dataActions":["Microsoft.KeyVault/managedHsm/keys/read/action"]}],"assignableScopes":["/"]}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleDefinitionsClient().CreateOrUpdate(context.TODO(), server.URL, "/", "example", RoleDefinitionCreateParameters{
+		Properties: &RoleDefinitionProperties{
+			RoleName:    stringPtr("Example"),
+			Description: stringPtr("An example role"),
+			RoleType:    CustomRole,
+			Permissions: &[]Permission{
+				{
+					DataActions: &[]string{"Microsoft.KeyVault/managedHsm/keys/read/action"},
+				},
+			},
+			AssignableScopes: &[]RoleScope{Global},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating the role definition: %+v", err)
+	}
+
+	if result.Type != MicrosoftAuthorizationroleDefinitions {
+		t.Fatalf("expected the type to be %q but got %q", MicrosoftAuthorizationroleDefinitions, result.Type)
+	}
+	if result.Properties == nil || result.Properties.RoleType != CustomRole {
+		t.Fatalf("expected the role type to be %q but got %+v", CustomRole, result.Properties)
+	}
+	if result.Properties.Permissions == nil || len(*result.Properties.Permissions) != 1 {
+		t.Fatalf("expected a single permission but got %+v", result.Properties.Permissions)
+	}
+	if actions := (*result.Properties.Permissions)[0].DataActions; actions == nil || !reflect.DeepEqual(*actions, []string{"Microsoft.KeyVault/managedHsm/keys/read/action"}) {
+		t.Fatalf("expected the data actions to be decoded but got %+v", actions)
+	}
+}
+
+func TestRoleDefinitionsGet(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodGet,
+		path:         "//keys/providers/Microsoft.Authorization/roleDefinitions/example",
+		statusCode:   http.StatusOK,
+		responseBody: `{"name":"example","properties":{"roleName":"Example","type":"AKVBuiltInRole","assignableScopes":["/keys"]}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleDefinitionsClient().Get(context.TODO(), server.URL, "/keys", "example")
+	if err != nil {
+		t.Fatalf("retrieving the role definition: %+v", err)
+	}
+	if result.Properties == nil || result.Properties.RoleType != BuiltInRole {
+		t.Fatalf("expected the role type to be %q but got %+v", BuiltInRole, result.Properties)
+	}
+	if result.Properties.AssignableScopes == nil || !reflect.DeepEqual(*result.Properties.AssignableScopes, []RoleScope{Keys}) {
+		t.Fatalf("expected the assignable scopes to be decoded but got %+v", result.Properties.AssignableScopes)
+	}
+}
+
+func TestRoleDefinitionsDelete(t *testing.T) {
+	server := newRoundTripServer(t, roundTrip{
+		method:       http.MethodDelete,
+		path:         "///providers/Microsoft.Authorization/roleDefinitions/example",
+		statusCode:   http.StatusNotFound,
+		responseBody: `{"error":{"code":"RoleDefinitionNotFound"}}`,
+	})
+	defer server.Close()
+
+	result, err := NewRoleDefinitionsClient().Delete(context.TODO(), server.URL, "/", "example")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if result.Response.Response == nil || result.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the status code to be %d but got %+v", http.StatusNotFound, result.Response.Response)
+	}
+}
@@ -0,0 +1,13 @@
+package keyvault
+
+import "github.com/Azure/azure-sdk-for-go/version"
+
+// UserAgent returns the UserAgent string to use when sending http.Requests.
+func UserAgent() string {
+	return "Azure-SDK-For-Go/" + Version() + " keyvault/7.3"
+}
+
+// Version returns the semantic version (see http://semver.org) of the client.
+func Version() string {
+	return version.Number
+}
@@ -77,5 +77,5 @@ func dataSourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("data_ingestion_uri", clusterProperties.DataIngestionURI)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -309,7 +309,7 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		Sku:               sku,
 		Zones:             zones,
 		ClusterProperties: &clusterProperties,
-		Tags:              tags.Expand(t),
+		Tags:              tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -444,7 +444,7 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("engine", clusterProperties.EngineType)
 	}
 
-	return tags.FlattenAndSet(d, clusterResponse.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, clusterResponse.Tags)
 }
 
 func resourceKustoClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
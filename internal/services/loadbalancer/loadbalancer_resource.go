@@ -285,7 +285,7 @@ func resourceArmLoadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tier: network.LoadBalancerSkuTier(d.Get("sku_tier").(string)),
 	}
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	properties := network.LoadBalancerPropertiesFormat{}
 
@@ -374,7 +374,7 @@ func resourceArmLoadBalancerRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmLoadBalancerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
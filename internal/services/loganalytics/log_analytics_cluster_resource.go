@@ -130,7 +130,7 @@ func resourceLogAnalyticsClusterCreate(d *pluginsdk.ResourceData, meta interface
 			Capacity: utils.Int64(int64(d.Get("size_gb").(int))),
 			Name:     operationalinsights.CapacityReservation,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -193,7 +193,7 @@ func resourceLogAnalyticsClusterRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("size_gb", capacity)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogAnalyticsClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -216,7 +216,7 @@ func resourceLogAnalyticsClusterUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.ClusterName, parameters); err != nil {
@@ -136,7 +136,7 @@ func resourceLogAnalyticsSolutionCreateUpdate(d *pluginsdk.ResourceData, meta in
 		Properties: &operationsmanagement.SolutionProperties{
 			WorkspaceResourceID: utils.String(workspaceID),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SolutionName, parameters)
@@ -211,7 +211,7 @@ func resourceLogAnalyticsSolutionRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("setting `plan`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogAnalyticsSolutionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
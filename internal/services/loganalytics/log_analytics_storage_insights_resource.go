@@ -133,7 +133,7 @@ func resourceLogAnalyticsStorageInsightsCreateUpdate(d *pluginsdk.ResourceData,
 		StorageInsightProperties: &operationalinsights.StorageInsightProperties{
 			StorageAccount: expandStorageInsightConfigStorageAccount(storageAccountId, storageAccountKey),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, ok := d.GetOk("table_names"); ok {
@@ -186,7 +186,7 @@ func resourceLogAnalyticsStorageInsightsRead(d *pluginsdk.ResourceData, meta int
 		d.Set("table_names", utils.FlattenStringSlice(props.Tables))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogAnalyticsStorageInsightsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -66,5 +66,5 @@ func dataSourceLogicAppIntegrationAccountRead(d *pluginsdk.ResourceData, meta in
 	d.Set("resource_group_name", resourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("sku_name", string(resp.Sku.Name))
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
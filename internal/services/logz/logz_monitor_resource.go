@@ -209,7 +209,7 @@ func resourceLogzMonitorCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			UserInfo:                   expandMonitorUserInfo(d.Get("user").([]interface{})),
 			MonitoringStatus:           monitoringStatus,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, id.ResourceGroup, id.MonitorName, &props)
@@ -260,7 +260,7 @@ func resourceLogzMonitorRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("user", d.Get("user"))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogzMonitorUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -286,7 +286,7 @@ func resourceLogzMonitorUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("tags") {
-		body.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		body.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.MonitorName, &body); err != nil {
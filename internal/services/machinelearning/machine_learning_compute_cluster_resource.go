@@ -211,7 +211,7 @@ func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		Properties: computeClusterProperties,
 		Identity:   identity,
 		Location:   computeClusterProperties.ComputeLocation,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 		Sku:        workspace.Sku,
 	}
 
@@ -296,7 +296,7 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 	d.Set("identity", identity)
 
-	return tags.FlattenAndSet(d, computeResource.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, computeResource.Tags)
 }
 
 func resourceComputeClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
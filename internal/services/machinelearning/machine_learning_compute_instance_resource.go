@@ -194,7 +194,7 @@ func resourceComputeInstanceCreate(d *pluginsdk.ResourceData, meta interface{})
 		},
 		Identity: identity,
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, parameters)
@@ -263,7 +263,7 @@ func resourceComputeInstanceRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("compute resource %s is not a ComputeInstance Compute", id)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceComputeInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
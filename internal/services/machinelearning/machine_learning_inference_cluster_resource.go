@@ -177,7 +177,7 @@ func resourceAksInferenceClusterCreate(d *pluginsdk.ResourceData, meta interface
 		Properties: expandAksComputeProperties(&aks, d),
 		Identity:   identity,
 		Location:   utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := mlComputeClient.CreateOrUpdate(ctx, workspaceID.ResourceGroup, workspaceID.Name, name, inferenceClusterParameters)
@@ -248,7 +248,7 @@ func resourceAksInferenceClusterRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("identity", identity)
 
-	return tags.FlattenAndSet(d, computeResource.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, computeResource.Tags)
 }
 
 func resourceAksInferenceClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
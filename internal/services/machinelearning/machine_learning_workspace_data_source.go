@@ -86,5 +86,5 @@ func dataSourceArmAMLWorkspaceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -155,7 +155,7 @@ func resourceManagedApplicationDefinitionCreateUpdate(d *pluginsdk.ResourceData,
 			IsEnabled:      utils.Bool(d.Get("package_enabled").(bool)),
 			LockLevel:      managedapplications.ApplicationLockLevel(d.Get("lock_level").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("create_ui_definition"); ok {
@@ -239,7 +239,7 @@ func resourceManagedApplicationDefinitionRead(d *pluginsdk.ResourceData, meta in
 		d.Set("package_file_uri", v.(string))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceManagedApplicationDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -161,7 +161,7 @@ func resourceManagedApplicationCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	parameters := managedapplications.Application{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location"))),
 		Kind:     utils.String(d.Get("kind").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
@@ -264,7 +264,7 @@ func resourceManagedApplicationRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceManagedApplicationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -86,7 +86,7 @@ func dataSourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("x_ms_client_id", props.UniqueId)
 		}
 
-		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags)); err != nil {
 			return err
 		}
 	}
@@ -150,7 +150,7 @@ func resourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("x_ms_client_id", props.UniqueId)
 		}
 
-		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags)); err != nil {
 			return err
 		}
 	}
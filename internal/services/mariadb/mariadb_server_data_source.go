@@ -130,5 +130,5 @@ func dataSourceMariaDbServerRead(d *pluginsdk.ResourceData, meta interface{}) er
 			return fmt.Errorf("setting `storage_profile`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -373,7 +373,7 @@ func resourceMariaDbServerCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		Location:   &location,
 		Properties: props,
 		Sku:        sku,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, id.ResourceGroup, id.Name, server)
@@ -427,7 +427,7 @@ func resourceMariaDbServerUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 			Version:                    mariadb.ServerVersion(d.Get("version").(string)),
 		},
 		Sku:  sku,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, properties)
@@ -496,7 +496,7 @@ func resourceMariaDbServerRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("fqdn", props.FullyQualifiedDomainName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMariaDbServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -180,7 +180,7 @@ func resourceMediaServicesAccountCreateUpdate(d *pluginsdk.ResourceData, meta in
 			StorageAccounts: storageAccounts,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -247,7 +247,7 @@ func resourceMediaServicesAccountRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("flattening `key_delivery_access_control`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMediaServicesAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -362,7 +362,7 @@ func resourceMediaLiveEventCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	parameters := media.LiveEvent{
 		LiveEventProperties: &media.LiveEventProperties{},
 		Location:            utils.String(location),
-		Tags:                tags.Expand(t),
+		Tags:                tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	autoStart := utils.Bool(false)
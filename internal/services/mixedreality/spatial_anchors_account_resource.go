@@ -92,7 +92,7 @@ func resourceSpatialAnchorsAccountCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	account := mixedreality.SpatialAnchorsAccount{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, id.ResourceGroup, id.Name, account); err != nil {
@@ -132,7 +132,7 @@ func resourceSpatialAnchorsAccountRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("account_id", props.AccountID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSpatialAnchorsAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,16 +20,19 @@ type Client struct {
 	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
-	ActionGroupsClient               *classic.ActionGroupsClient
-	ActivityLogAlertsClient          *insights.ActivityLogAlertsClient
-	AlertRulesClient                 *classic.AlertRulesClient
-	DiagnosticSettingsClient         *classic.DiagnosticSettingsClient
-	DiagnosticSettingsCategoryClient *classic.DiagnosticSettingsCategoryClient
-	LogProfilesClient                *classic.LogProfilesClient
-	MetricAlertsClient               *classic.MetricAlertsClient
-	PrivateLinkScopesClient          *classic.PrivateLinkScopesClient
-	PrivateLinkScopedResourcesClient *classic.PrivateLinkScopedResourcesClient
-	ScheduledQueryRulesClient        *classic.ScheduledQueryRulesClient
+	ActionGroupsClient                   *classic.ActionGroupsClient
+	ActivityLogAlertsClient              *insights.ActivityLogAlertsClient
+	AlertRulesClient                     *classic.AlertRulesClient
+	DataCollectionEndpointsClient        *classic.DataCollectionEndpointsClient
+	DataCollectionRulesClient            *classic.DataCollectionRulesClient
+	DataCollectionRuleAssociationsClient *classic.DataCollectionRuleAssociationsClient
+	DiagnosticSettingsClient             *classic.DiagnosticSettingsClient
+	DiagnosticSettingsCategoryClient     *classic.DiagnosticSettingsCategoryClient
+	LogProfilesClient                    *classic.LogProfilesClient
+	MetricAlertsClient                   *classic.MetricAlertsClient
+	PrivateLinkScopesClient              *classic.PrivateLinkScopesClient
+	PrivateLinkScopedResourcesClient     *classic.PrivateLinkScopedResourcesClient
+	ScheduledQueryRulesClient            *classic.ScheduledQueryRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -54,6 +57,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertRulesClient := classic.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	DataCollectionEndpointsClient := classic.NewDataCollectionEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	DataCollectionRulesClient := classic.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	DataCollectionRuleAssociationsClient := classic.NewDataCollectionRuleAssociationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionRuleAssociationsClient.Client, o.ResourceManagerAuthorizer)
+
 	DiagnosticSettingsClient := classic.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -76,19 +88,22 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ScheduledQueryRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AADDiagnosticSettingsClient:      &AADDiagnosticSettingsClient,
-		AutoscaleSettingsClient:          &AutoscaleSettingsClient,
-		ActionRulesClient:                &ActionRulesClient,
-		SmartDetectorAlertRulesClient:    &SmartDetectorAlertRulesClient,
-		ActionGroupsClient:               &ActionGroupsClient,
-		ActivityLogAlertsClient:          &ActivityLogAlertsClient,
-		AlertRulesClient:                 &AlertRulesClient,
-		DiagnosticSettingsClient:         &DiagnosticSettingsClient,
-		DiagnosticSettingsCategoryClient: &DiagnosticSettingsCategoryClient,
-		LogProfilesClient:                &LogProfilesClient,
-		MetricAlertsClient:               &MetricAlertsClient,
-		PrivateLinkScopesClient:          &PrivateLinkScopesClient,
-		PrivateLinkScopedResourcesClient: &PrivateLinkScopedResourcesClient,
-		ScheduledQueryRulesClient:        &ScheduledQueryRulesClient,
+		AADDiagnosticSettingsClient:          &AADDiagnosticSettingsClient,
+		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
+		ActionRulesClient:                    &ActionRulesClient,
+		SmartDetectorAlertRulesClient:        &SmartDetectorAlertRulesClient,
+		ActionGroupsClient:                   &ActionGroupsClient,
+		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
+		AlertRulesClient:                     &AlertRulesClient,
+		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
+		DataCollectionRulesClient:            &DataCollectionRulesClient,
+		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
+		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
+		LogProfilesClient:                    &LogProfilesClient,
+		MetricAlertsClient:                   &MetricAlertsClient,
+		PrivateLinkScopesClient:              &PrivateLinkScopesClient,
+		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
+		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
 	}
 }
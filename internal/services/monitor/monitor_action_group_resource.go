@@ -411,7 +411,7 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	armRoleReceiversRaw := d.Get("arm_role_receiver").([]interface{})
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	parameters := insights.ActionGroupResource{
 		Location: utils.String(azure.NormalizeLocation("Global")),
@@ -506,7 +506,7 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `arm_role_receiver`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorActionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -135,7 +135,7 @@ func resourceMonitorActionRuleActionGroupCreateUpdate(d *pluginsdk.ResourceData,
 			Status:        actionRuleStatus,
 			Type:          alertsmanagement.TypeActionGroup,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateUpdate(ctx, id.ResourceGroup, id.Name, actionRule); err != nil {
@@ -181,7 +181,7 @@ func resourceMonitorActionRuleActionGroupRead(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorActionRuleActionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
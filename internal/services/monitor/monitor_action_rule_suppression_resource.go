@@ -198,7 +198,7 @@ func resourceMonitorActionRuleSuppressionCreateUpdate(d *pluginsdk.ResourceData,
 			Status:            actionRuleStatus,
 			Type:              alertsmanagement.TypeSuppression,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateUpdate(ctx, id.ResourceGroup, id.Name, actionRule); err != nil {
@@ -246,7 +246,7 @@ func resourceMonitorActionRuleSuppressionRead(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorActionRuleSuppressionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
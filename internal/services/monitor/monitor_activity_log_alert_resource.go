@@ -275,7 +275,7 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta
 	actionRaw := d.Get("action").(*pluginsdk.Set).List()
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	parameters := insights.ActivityLogAlertResource{
 		Location: utils.String(azure.NormalizeLocation("Global")),
@@ -333,7 +333,7 @@ func resourceMonitorActivityLogAlertRead(d *pluginsdk.ResourceData, meta interfa
 			return fmt.Errorf("setting `action`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorActivityLogAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
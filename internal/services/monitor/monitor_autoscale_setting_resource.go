@@ -445,7 +445,7 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta
 	}
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	parameters := insights.AutoscaleSettingResource{
 		Location: utils.String(location),
@@ -512,7 +512,7 @@ func resourceMonitorAutoScaleSettingRead(d *pluginsdk.ResourceData, meta interfa
 
 	// Return a new tag map filtered by the specified tag names.
 	tagMap := tags.Filter(resp.Tags, "$type")
-	return tags.FlattenAndSet(d, tagMap)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagMap)
 }
 
 func resourceMonitorAutoScaleSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,205 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorDataCollectionEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Read:   resourceMonitorDataCollectionEndpointRead,
+		Update: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Delete: resourceMonitorDataCollectionEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataCollectionEndpointName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.KnownDataCollectionEndpointResourceKindLinux),
+					string(insights.KnownDataCollectionEndpointResourceKindWindows),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"configuration_access_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"logs_ingestion_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionEndpointID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_endpoint", id.ID())
+		}
+	}
+
+	publicNetworkAccess := insights.KnownPublicNetworkAccessOptionsDisabled
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = insights.KnownPublicNetworkAccessOptionsEnabled
+	}
+
+	parameters := insights.DataCollectionEndpointResource{
+		Kind:     insights.KnownDataCollectionEndpointResourceKind(d.Get("kind").(string)),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		DataCollectionEndpointResourceProperties: &insights.DataCollectionEndpointResourceProperties{
+			NetworkAcls: &insights.DataCollectionEndpointNetworkAcls{
+				PublicNetworkAccess: publicNetworkAccess,
+			},
+		},
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.DataCollectionEndpointResourceProperties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.Name, &parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionEndpointRead(d, meta)
+}
+
+func resourceMonitorDataCollectionEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s does not exist - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("kind", string(resp.Kind))
+
+	if props := resp.DataCollectionEndpointResourceProperties; props != nil {
+		d.Set("description", props.Description)
+
+		publicNetworkAccessEnabled := true
+		if props.NetworkAcls != nil {
+			publicNetworkAccessEnabled = props.NetworkAcls.PublicNetworkAccess != insights.KnownPublicNetworkAccessOptionsDisabled
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		configurationAccessEndpoint := ""
+		if props.ConfigurationAccess != nil && props.ConfigurationAccess.Endpoint != nil {
+			configurationAccessEndpoint = *props.ConfigurationAccess.Endpoint
+		}
+		d.Set("configuration_access_endpoint", configurationAccessEndpoint)
+
+		logsIngestionEndpoint := ""
+		if props.LogsIngestion != nil && props.LogsIngestion.Endpoint != nil {
+			logsIngestionEndpoint = *props.LogsIngestion.Endpoint
+		}
+		d.Set("logs_ingestion_endpoint", logsIngestionEndpoint)
+	}
+
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
+}
+
+func resourceMonitorDataCollectionEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// dataCollectionEndpointAssociationName is the name the API requires for an association
+// which links a Data Collection Endpoint (rather than a Data Collection Rule) to a resource
+const dataCollectionEndpointAssociationName = "configurationAccessEndpoint"
+
+func resourceMonitorDataCollectionRuleAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleAssociationRead,
+		Update: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionRuleAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dataCollectionEndpointAssociationName,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_collection_rule_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.DataCollectionRuleID,
+				ExactlyOneOf:     []string{"data_collection_endpoint_id", "data_collection_rule_id"},
+			},
+
+			"data_collection_endpoint_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.DataCollectionEndpointID,
+				ExactlyOneOf:     []string{"data_collection_endpoint_id", "data_collection_rule_id"},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionRuleAssociationID(d.Get("target_resource_id").(string), d.Get("name").(string))
+
+	dataCollectionEndpointId := d.Get("data_collection_endpoint_id").(string)
+	if dataCollectionEndpointId != "" && id.Name != dataCollectionEndpointAssociationName {
+		return fmt.Errorf("`name` must be %q when `data_collection_endpoint_id` is specified", dataCollectionEndpointAssociationName)
+	}
+	if dataCollectionEndpointId == "" && id.Name == dataCollectionEndpointAssociationName {
+		return fmt.Errorf("`name` cannot be %q when `data_collection_rule_id` is specified", dataCollectionEndpointAssociationName)
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceUri, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule_association", id.ID())
+		}
+	}
+
+	parameters := insights.DataCollectionRuleAssociationProxyOnlyResource{
+		DataCollectionRuleAssociationProxyOnlyResourceProperties: &insights.DataCollectionRuleAssociationProxyOnlyResourceProperties{},
+	}
+
+	if v := d.Get("data_collection_rule_id").(string); v != "" {
+		parameters.DataCollectionRuleAssociationProxyOnlyResourceProperties.DataCollectionRuleID = utils.String(v)
+	}
+
+	if dataCollectionEndpointId != "" {
+		parameters.DataCollectionRuleAssociationProxyOnlyResourceProperties.DataCollectionEndpointID = utils.String(dataCollectionEndpointId)
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.DataCollectionRuleAssociationProxyOnlyResourceProperties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id.ResourceUri, id.Name, &parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionRuleAssociationRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceUri, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s does not exist - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("target_resource_id", id.ResourceUri)
+
+	if props := resp.DataCollectionRuleAssociationProxyOnlyResourceProperties; props != nil {
+		d.Set("data_collection_rule_id", props.DataCollectionRuleID)
+		d.Set("data_collection_endpoint_id", props.DataCollectionEndpointID)
+		d.Set("description", props.Description)
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceUri, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorDataCollectionRuleAssociationResource struct{}
+
+func TestAccMonitorDataCollectionRuleAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule_association", "test")
+	r := MonitorDataCollectionRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDataCollectionRuleAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule_association", "test")
+	r := MonitorDataCollectionRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorDataCollectionRuleAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule_association", "test")
+	r := MonitorDataCollectionRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withDescription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("acc test monitor_data_collection_rule_association"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDataCollectionRuleAssociation_endpoint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule_association", "test")
+	r := MonitorDataCollectionRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.endpoint(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("configurationAccessEndpoint"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataCollectionRuleAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.DataCollectionRuleAssociationsClient.Get(ctx, id.ResourceUri, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.DataCollectionRuleAssociationProxyOnlyResourceProperties != nil), nil
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dcra-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                = "acctestVM-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+
+  disable_password_authentication = false
+
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctest-dcr-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  destinations {
+    azure_monitor_metrics {
+      name = "test-destination-metrics"
+    }
+  }
+
+  data_flow {
+    streams      = ["Microsoft-InsightsMetrics"]
+    destinations = ["test-destination-metrics"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_data_collection_rule_association" "test" {
+  name                    = "acctestdcra-%d"
+  target_resource_id      = azurerm_linux_virtual_machine.test.id
+  data_collection_rule_id = azurerm_monitor_data_collection_rule.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_data_collection_rule_association" "import" {
+  name                    = azurerm_monitor_data_collection_rule_association.test.name
+  target_resource_id      = azurerm_monitor_data_collection_rule_association.test.target_resource_id
+  data_collection_rule_id = azurerm_monitor_data_collection_rule_association.test.data_collection_rule_id
+}
+`, r.basic(data))
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) withDescription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_data_collection_rule_association" "test" {
+  name                    = "acctestdcra-%d"
+  target_resource_id      = azurerm_linux_virtual_machine.test.id
+  data_collection_rule_id = azurerm_monitor_data_collection_rule.test.id
+  description             = "acc test monitor_data_collection_rule_association"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDataCollectionRuleAssociationResource) endpoint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_data_collection_endpoint" "test" {
+  name                = "acctest-dce-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_data_collection_rule_association" "test" {
+  target_resource_id          = azurerm_linux_virtual_machine.test.id
+  data_collection_endpoint_id = azurerm_monitor_data_collection_endpoint.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,917 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorDataCollectionRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleRead,
+		Update: resourceMonitorDataCollectionRuleCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionRuleID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(monitorDataCollectionRuleCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataCollectionRuleName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.KnownDataCollectionRuleResourceKindLinux),
+					string(insights.KnownDataCollectionRuleResourceKindWindows),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"destinations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"workspace_resource_id": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppress.CaseDifference,
+										ValidateFunc:     loganalyticsValidate.LogAnalyticsWorkspaceID,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+
+						"azure_monitor_metrics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+					},
+				},
+			},
+
+			"data_flow": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"streams": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(insights.KnownDataFlowStreamsMicrosoftEvent),
+									string(insights.KnownDataFlowStreamsMicrosoftInsightsMetrics),
+									string(insights.KnownDataFlowStreamsMicrosoftPerf),
+									string(insights.KnownDataFlowStreamsMicrosoftSyslog),
+									string(insights.KnownDataFlowStreamsMicrosoftWindowsEvent),
+								}, false),
+							},
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"syslog": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"facility_names": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice(monitorDataCollectionRuleSyslogFacilityNames(), false),
+										},
+									},
+
+									"log_levels": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice(monitorDataCollectionRuleSyslogLogLevels(), false),
+										},
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownSyslogDataSourceStreamsMicrosoftSyslog),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"performance_counter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"counter_specifiers": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"sampling_frequency_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 300),
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownPerfCounterDataSourceStreamsMicrosoftInsightsMetrics),
+												string(insights.KnownPerfCounterDataSourceStreamsMicrosoftPerf),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"windows_event_log": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"x_path_queries": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownWindowsEventLogDataSourceStreamsMicrosoftEvent),
+												string(insights.KnownWindowsEventLogDataSourceStreamsMicrosoftWindowsEvent),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"extension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"extension_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftEvent),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftInsightsMetrics),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftPerf),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftSyslog),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftWindowsEvent),
+											}, false),
+										},
+									},
+
+									"extension_json": {
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+									},
+
+									"input_data_sources": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule", id.ID())
+		}
+	}
+
+	dataSources, err := expandMonitorDataCollectionRuleDataSources(d.Get("data_sources").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `data_sources`: %+v", err)
+	}
+
+	parameters := insights.DataCollectionRuleResource{
+		Kind:     insights.KnownDataCollectionRuleResourceKind(d.Get("kind").(string)),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		DataCollectionRuleResourceProperties: &insights.DataCollectionRuleResourceProperties{
+			DataFlows:    expandMonitorDataCollectionRuleDataFlows(d.Get("data_flow").([]interface{})),
+			DataSources:  dataSources,
+			Destinations: expandMonitorDataCollectionRuleDestinations(d.Get("destinations").([]interface{})),
+		},
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.DataCollectionRuleResourceProperties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.Name, &parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionRuleRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s does not exist - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("kind", string(resp.Kind))
+
+	if props := resp.DataCollectionRuleResourceProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("immutable_id", props.ImmutableID)
+
+		if err := d.Set("data_flow", flattenMonitorDataCollectionRuleDataFlows(props.DataFlows)); err != nil {
+			return fmt.Errorf("setting `data_flow`: %+v", err)
+		}
+
+		dataSources, err := flattenMonitorDataCollectionRuleDataSources(props.DataSources)
+		if err != nil {
+			return fmt.Errorf("flattening `data_sources`: %+v", err)
+		}
+		if err := d.Set("data_sources", dataSources); err != nil {
+			return fmt.Errorf("setting `data_sources`: %+v", err)
+		}
+
+		if err := d.Set("destinations", flattenMonitorDataCollectionRuleDestinations(props.Destinations)); err != nil {
+			return fmt.Errorf("setting `destinations`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
+}
+
+func resourceMonitorDataCollectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// monitorDataCollectionRuleCustomizeDiff ensures that each `data_flow` only references destinations
+// which are defined within the `destinations` block, which the API otherwise only rejects at apply time.
+func monitorDataCollectionRuleCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	destinations := make(map[string]bool)
+	if raw := diff.Get("destinations").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		block := raw[0].(map[string]interface{})
+		for _, item := range block["log_analytics"].([]interface{}) {
+			if item == nil {
+				continue
+			}
+			destinations[item.(map[string]interface{})["name"].(string)] = true
+		}
+		for _, item := range block["azure_monitor_metrics"].([]interface{}) {
+			if item == nil {
+				continue
+			}
+			destinations[item.(map[string]interface{})["name"].(string)] = true
+		}
+	}
+
+	// names which aren't known until apply time can't be checked here
+	if destinations[""] {
+		return nil
+	}
+
+	for i, item := range diff.Get("data_flow").([]interface{}) {
+		if item == nil {
+			continue
+		}
+		for _, destination := range item.(map[string]interface{})["destinations"].([]interface{}) {
+			name, ok := destination.(string)
+			if !ok || name == "" {
+				continue
+			}
+			if !destinations[name] {
+				return fmt.Errorf("`data_flow.%d.destinations` references %q which is not defined in the `destinations` block", i, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func monitorDataCollectionRuleSyslogFacilityNames() []string {
+	results := make([]string, 0)
+	for _, v := range insights.PossibleKnownSyslogDataSourceFacilityNamesValues() {
+		results = append(results, string(v))
+	}
+	return results
+}
+
+func monitorDataCollectionRuleSyslogLogLevels() []string {
+	results := make([]string, 0)
+	for _, v := range insights.PossibleKnownSyslogDataSourceLogLevelsValues() {
+		results = append(results, string(v))
+	}
+	return results
+}
+
+func expandMonitorDataCollectionRuleDataFlows(input []interface{}) *[]insights.DataFlow {
+	results := make([]insights.DataFlow, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownDataFlowStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownDataFlowStreams(stream.(string)))
+		}
+
+		results = append(results, insights.DataFlow{
+			Streams:      &streams,
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+		})
+	}
+	return &results
+}
+
+func expandMonitorDataCollectionRuleDataSources(input []interface{}) (*insights.DataCollectionRuleDataSources, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	extensions, err := expandMonitorDataCollectionRuleExtensions(v["extension"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &insights.DataCollectionRuleDataSources{
+		Extensions:          extensions,
+		PerformanceCounters: expandMonitorDataCollectionRulePerformanceCounters(v["performance_counter"].([]interface{})),
+		Syslog:              expandMonitorDataCollectionRuleSyslog(v["syslog"].([]interface{})),
+		WindowsEventLogs:    expandMonitorDataCollectionRuleWindowsEventLogs(v["windows_event_log"].([]interface{})),
+	}, nil
+}
+
+func expandMonitorDataCollectionRuleExtensions(input []interface{}) (*[]insights.ExtensionDataSource, error) {
+	results := make([]insights.ExtensionDataSource, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownExtensionDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownExtensionDataSourceStreams(stream.(string)))
+		}
+
+		result := insights.ExtensionDataSource{
+			Name:          utils.String(v["name"].(string)),
+			ExtensionName: utils.String(v["extension_name"].(string)),
+			Streams:       &streams,
+		}
+
+		if extensionSettings := v["extension_json"].(string); extensionSettings != "" {
+			settings, err := pluginsdk.ExpandJsonFromString(extensionSettings)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `extension_json` for extension %q: %+v", v["name"].(string), err)
+			}
+			result.ExtensionSettings = settings
+		}
+
+		if inputDataSources := v["input_data_sources"].([]interface{}); len(inputDataSources) > 0 {
+			result.InputDataSources = utils.ExpandStringSlice(inputDataSources)
+		}
+
+		results = append(results, result)
+	}
+	return &results, nil
+}
+
+func expandMonitorDataCollectionRulePerformanceCounters(input []interface{}) *[]insights.PerfCounterDataSource {
+	results := make([]insights.PerfCounterDataSource, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownPerfCounterDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownPerfCounterDataSourceStreams(stream.(string)))
+		}
+
+		results = append(results, insights.PerfCounterDataSource{
+			Name:                       utils.String(v["name"].(string)),
+			CounterSpecifiers:          utils.ExpandStringSlice(v["counter_specifiers"].([]interface{})),
+			SamplingFrequencyInSeconds: utils.Int32(int32(v["sampling_frequency_in_seconds"].(int))),
+			Streams:                    &streams,
+		})
+	}
+	return &results
+}
+
+func expandMonitorDataCollectionRuleSyslog(input []interface{}) *[]insights.SyslogDataSource {
+	results := make([]insights.SyslogDataSource, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		facilityNames := make([]insights.KnownSyslogDataSourceFacilityNames, 0)
+		for _, facilityName := range v["facility_names"].(*pluginsdk.Set).List() {
+			facilityNames = append(facilityNames, insights.KnownSyslogDataSourceFacilityNames(facilityName.(string)))
+		}
+
+		logLevels := make([]insights.KnownSyslogDataSourceLogLevels, 0)
+		for _, logLevel := range v["log_levels"].(*pluginsdk.Set).List() {
+			logLevels = append(logLevels, insights.KnownSyslogDataSourceLogLevels(logLevel.(string)))
+		}
+
+		streams := make([]insights.KnownSyslogDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownSyslogDataSourceStreams(stream.(string)))
+		}
+
+		results = append(results, insights.SyslogDataSource{
+			Name:          utils.String(v["name"].(string)),
+			FacilityNames: &facilityNames,
+			LogLevels:     &logLevels,
+			Streams:       &streams,
+		})
+	}
+	return &results
+}
+
+func expandMonitorDataCollectionRuleWindowsEventLogs(input []interface{}) *[]insights.WindowsEventLogDataSource {
+	results := make([]insights.WindowsEventLogDataSource, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownWindowsEventLogDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownWindowsEventLogDataSourceStreams(stream.(string)))
+		}
+
+		results = append(results, insights.WindowsEventLogDataSource{
+			Name:         utils.String(v["name"].(string)),
+			XPathQueries: utils.ExpandStringSlice(v["x_path_queries"].([]interface{})),
+			Streams:      &streams,
+		})
+	}
+	return &results
+}
+
+func expandMonitorDataCollectionRuleDestinations(input []interface{}) *insights.DataCollectionRuleDestinations {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	logAnalytics := make([]insights.LogAnalyticsDestination, 0)
+	for _, item := range v["log_analytics"].([]interface{}) {
+		destination := item.(map[string]interface{})
+		logAnalytics = append(logAnalytics, insights.LogAnalyticsDestination{
+			Name:                utils.String(destination["name"].(string)),
+			WorkspaceResourceID: utils.String(destination["workspace_resource_id"].(string)),
+		})
+	}
+
+	result := &insights.DataCollectionRuleDestinations{
+		LogAnalytics: &logAnalytics,
+	}
+
+	if azureMonitorMetrics := v["azure_monitor_metrics"].([]interface{}); len(azureMonitorMetrics) > 0 && azureMonitorMetrics[0] != nil {
+		result.AzureMonitorMetrics = &insights.DestinationsSpecAzureMonitorMetrics{
+			Name: utils.String(azureMonitorMetrics[0].(map[string]interface{})["name"].(string)),
+		}
+	}
+
+	return result
+}
+
+func flattenMonitorDataCollectionRuleDataFlows(input *[]insights.DataFlow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"streams":      streams,
+			"destinations": utils.FlattenStringSlice(item.Destinations),
+		})
+	}
+	return results
+}
+
+func flattenMonitorDataCollectionRuleDataSources(input *insights.DataCollectionRuleDataSources) ([]interface{}, error) {
+	if input == nil {
+		return make([]interface{}, 0), nil
+	}
+
+	extensions, err := flattenMonitorDataCollectionRuleExtensions(input.Extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"extension":           extensions,
+			"performance_counter": flattenMonitorDataCollectionRulePerformanceCounters(input.PerformanceCounters),
+			"syslog":              flattenMonitorDataCollectionRuleSyslog(input.Syslog),
+			"windows_event_log":   flattenMonitorDataCollectionRuleWindowsEventLogs(input.WindowsEventLogs),
+		},
+	}, nil
+}
+
+func flattenMonitorDataCollectionRuleExtensions(input *[]insights.ExtensionDataSource) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		extensionName := ""
+		if item.ExtensionName != nil {
+			extensionName = *item.ExtensionName
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		extensionSettings := ""
+		if item.ExtensionSettings != nil {
+			if settings, ok := item.ExtensionSettings.(map[string]interface{}); ok {
+				v, err := pluginsdk.FlattenJsonToString(settings)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the settings for extension %q: %+v", name, err)
+				}
+				extensionSettings = v
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"extension_name":     extensionName,
+			"streams":            streams,
+			"extension_json":     extensionSettings,
+			"input_data_sources": utils.FlattenStringSlice(item.InputDataSources),
+		})
+	}
+	return results, nil
+}
+
+func flattenMonitorDataCollectionRulePerformanceCounters(input *[]insights.PerfCounterDataSource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		samplingFrequency := 0
+		if item.SamplingFrequencyInSeconds != nil {
+			samplingFrequency = int(*item.SamplingFrequencyInSeconds)
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"counter_specifiers":            utils.FlattenStringSlice(item.CounterSpecifiers),
+			"sampling_frequency_in_seconds": samplingFrequency,
+			"streams":                       streams,
+		})
+	}
+	return results
+}
+
+func flattenMonitorDataCollectionRuleSyslog(input *[]insights.SyslogDataSource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		facilityNames := make([]interface{}, 0)
+		if item.FacilityNames != nil {
+			for _, facilityName := range *item.FacilityNames {
+				facilityNames = append(facilityNames, string(facilityName))
+			}
+		}
+
+		logLevels := make([]interface{}, 0)
+		if item.LogLevels != nil {
+			for _, logLevel := range *item.LogLevels {
+				logLevels = append(logLevels, string(logLevel))
+			}
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"facility_names": facilityNames,
+			"log_levels":     logLevels,
+			"streams":        streams,
+		})
+	}
+	return results
+}
+
+func flattenMonitorDataCollectionRuleWindowsEventLogs(input *[]insights.WindowsEventLogDataSource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"x_path_queries": utils.FlattenStringSlice(item.XPathQueries),
+			"streams":        streams,
+		})
+	}
+	return results
+}
+
+func flattenMonitorDataCollectionRuleDestinations(input *insights.DataCollectionRuleDestinations) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	logAnalytics := make([]interface{}, 0)
+	if input.LogAnalytics != nil {
+		for _, item := range *input.LogAnalytics {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			workspaceResourceId := ""
+			if item.WorkspaceResourceID != nil {
+				workspaceResourceId = *item.WorkspaceResourceID
+			}
+
+			logAnalytics = append(logAnalytics, map[string]interface{}{
+				"name":                  name,
+				"workspace_resource_id": workspaceResourceId,
+			})
+		}
+	}
+
+	azureMonitorMetrics := make([]interface{}, 0)
+	if input.AzureMonitorMetrics != nil && input.AzureMonitorMetrics.Name != nil {
+		azureMonitorMetrics = append(azureMonitorMetrics, map[string]interface{}{
+			"name": *input.AzureMonitorMetrics.Name,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics":         logAnalytics,
+			"azure_monitor_metrics": azureMonitorMetrics,
+		},
+	}
+}
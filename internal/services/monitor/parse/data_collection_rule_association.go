@@ -0,0 +1,59 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type DataCollectionRuleAssociationId struct {
+	ResourceUri string
+	Name        string
+}
+
+func NewDataCollectionRuleAssociationID(resourceUri, name string) DataCollectionRuleAssociationId {
+	return DataCollectionRuleAssociationId{
+		ResourceUri: resourceUri,
+		Name:        name,
+	}
+}
+
+func (id DataCollectionRuleAssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Uri %q", id.ResourceUri),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Data Collection Rule Association", segmentsStr)
+}
+
+func (id DataCollectionRuleAssociationId) ID() string {
+	fmtString := "%s/providers/Microsoft.Insights/dataCollectionRuleAssociations/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.ResourceUri, "/"), id.Name)
+}
+
+// DataCollectionRuleAssociationID parses a DataCollectionRuleAssociation ID into an DataCollectionRuleAssociationId struct
+func DataCollectionRuleAssociationID(input string) (*DataCollectionRuleAssociationId, error) {
+	if _, err := azure.ParseAzureResourceID(input); err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(input, "/providers/Microsoft.Insights/dataCollectionRuleAssociations/")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format `{resourceUri}/providers/Microsoft.Insights/dataCollectionRuleAssociations/{name}` - got %d segments", len(segments))
+	}
+
+	resourceUri, name := segments[0], segments[1]
+	if resourceUri == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceUri' element")
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("ID contained an invalid value for the 'dataCollectionRuleAssociations' element: %q", name)
+	}
+
+	return &DataCollectionRuleAssociationId{
+		ResourceUri: resourceUri,
+		Name:        name,
+	}, nil
+}
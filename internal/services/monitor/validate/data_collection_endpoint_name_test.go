@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataCollectionEndpointName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "ab",
+			expected: false,
+		},
+		{
+			input:    "abc",
+			expected: true,
+		},
+		{
+			input:    "a-b-1",
+			expected: true,
+		},
+		{
+			input:    "-ab",
+			expected: false,
+		},
+		{
+			input:    "ab-",
+			expected: false,
+		},
+		{
+			input:    "a_b",
+			expected: false,
+		},
+		{
+			input:    "a.b",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("s", 44),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("s", 45),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionEndpointName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
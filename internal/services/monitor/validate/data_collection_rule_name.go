@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DataCollectionRuleName(i interface{}, k string) (warning []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(v) < 1 || len(v) > 64 {
+		errors = append(errors, fmt.Errorf("length should be between %d and %d", 1, 64))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9_.-]*[a-zA-Z0-9_-]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q only allows alphanumeric characters, periods, underscores and hyphens and cannot end in a period", k))
+		return
+	}
+
+	return
+}
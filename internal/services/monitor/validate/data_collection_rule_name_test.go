@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataCollectionRuleName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: true,
+		},
+		{
+			input:    "8",
+			expected: true,
+		},
+		{
+			input:    "a_-.b",
+			expected: true,
+		},
+		{
+			input:    "ab_",
+			expected: true,
+		},
+		{
+			input:    "ab-",
+			expected: true,
+		},
+		{
+			input:    "a+",
+			expected: false,
+		},
+		{
+			input:    "a.",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("s", 64),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("s", 65),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionRuleName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -81,7 +81,7 @@ func dataSourceArmUserAssignedIdentityRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("tenant_id", props.TenantId)
 		}
 
-		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags)); err != nil {
 			return err
 		}
 	}
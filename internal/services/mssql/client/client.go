@@ -18,6 +18,11 @@ type Client struct {
 	FirewallRulesClient                                *sql.FirewallRulesClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
+	ManagedInstanceAdministratorsClient                *sql.ManagedInstanceAdministratorsClient
+	ManagedInstanceAzureADOnlyAuthenticationsClient    *sql.ManagedInstanceAzureADOnlyAuthenticationsClient
+	ManagedInstanceFailoverGroupsClient                *sql.InstanceFailoverGroupsClient
+	ManagedInstancesClient                             *sql.ManagedInstancesClient
 	ReplicationLinksClient                             *sql.ReplicationLinksClient
 	RestorableDroppedDatabasesClient                   *sql.RestorableDroppedDatabasesClient
 	ServerAzureADAdministratorsClient                  *sql.ServerAzureADAdministratorsClient
@@ -63,6 +68,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstancesAdministratorsClient := sql.NewManagedInstanceAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstancesAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceAzureADOnlyAuthenticationsClient := sql.NewManagedInstanceAzureADOnlyAuthenticationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceAzureADOnlyAuthenticationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceFailoverGroupsClient := sql.NewInstanceFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceFailoverGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstancesClient := sql.NewManagedInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstancesClient.Client, o.ResourceManagerAuthorizer)
+
 	failoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&failoverGroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -125,6 +145,11 @@ func NewClient(o *common.ClientOptions) *Client {
 		ElasticPoolsClient:                                 &elasticPoolsClient,
 		JobAgentsClient:                                    &jobAgentsClient,
 		JobCredentialsClient:                               &jobCredentialsClient,
+		ManagedDatabasesClient:                             &managedDatabasesClient,
+		ManagedInstanceAdministratorsClient:                &managedInstancesAdministratorsClient,
+		ManagedInstanceAzureADOnlyAuthenticationsClient:    &managedInstanceAzureADOnlyAuthenticationsClient,
+		ManagedInstanceFailoverGroupsClient:                &managedInstanceFailoverGroupsClient,
+		ManagedInstancesClient:                             &managedInstancesClient,
 		FailoverGroupsClient:                               &failoverGroupsClient,
 		FirewallRulesClient:                                &firewallRulesClient,
 		ReplicationLinksClient:                             &replicationLinksClient,
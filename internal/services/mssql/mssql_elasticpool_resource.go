@@ -215,7 +215,7 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		Name:     &elasticPoolName,
 		Location: &location,
 		Sku:      sku,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		ElasticPoolProperties: &sql.ElasticPoolProperties{
 			LicenseType:         sql.ElasticPoolLicenseType(d.Get("license_type").(string)),
 			PerDatabaseSettings: expandMsSqlElasticPoolPerDatabaseSettings(d),
@@ -303,7 +303,7 @@ func resourceMsSqlElasticPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMsSqlElasticPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
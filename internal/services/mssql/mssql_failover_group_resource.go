@@ -211,7 +211,7 @@ func (r MsSqlFailoverGroupResource) Create() sdk.ResourceFunc {
 					ReadWriteEndpoint: &sql.FailoverGroupReadWriteEndpoint{},
 					PartnerServers:    r.expandPartnerServers(model.PartnerServers),
 				},
-				Tags: tags.FromTypedObject(model.Tags),
+				Tags: tags.FromTypedObject(metadata.Client.Tags, model.Tags),
 			}
 
 			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
@@ -271,7 +271,7 @@ func (r MsSqlFailoverGroupResource) Update() sdk.ResourceFunc {
 					},
 					PartnerServers: r.expandPartnerServers(state.PartnerServers),
 				},
-				Tags: tags.FromTypedObject(state.Tags),
+				Tags: tags.FromTypedObject(metadata.Client.Tags, state.Tags),
 			}
 
 			if state.ReadWriteEndpointFailurePolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) {
@@ -319,7 +319,7 @@ func (r MsSqlFailoverGroupResource) Read() sdk.ResourceFunc {
 			model := MsSqlFailoverGroupModel{
 				Name:     id.Name,
 				ServerId: serverId.ID(),
-				Tags:     tags.ToTypedObject(existing.Tags),
+				Tags:     tags.ToTypedObject(metadata.Client.Tags, existing.Tags, metadata.ResourceData.Get("tags").(map[string]interface{})),
 			}
 
 			if props := existing.FailoverGroupProperties; props != nil {
@@ -0,0 +1,319 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// managedInstanceActiveDirectoryAdministratorName is the only administrator name supported by the API
+const managedInstanceActiveDirectoryAdministratorName = "ActiveDirectory"
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorModel struct {
+	AzureADAuthenticationOnly bool   `tfschema:"azuread_authentication_only"`
+	LoginUsername             string `tfschema:"login_username"`
+	ManagedInstanceId         string `tfschema:"managed_instance_id"`
+	ObjectId                  string `tfschema:"object_id"`
+	TenantId                  string `tfschema:"tenant_id"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorResource struct{}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_active_directory_administrator"
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceActiveDirectoryAdministratorModel{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceAzureActiveDirectoryAdministratorID
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"login_username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"azuread_authentication_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyAuthenticationsClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			var model MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceAzureActiveDirectoryAdministratorID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, managedInstanceActiveDirectoryAdministratorName)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := r.expandAdministrator(model)
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, *parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.AzureADAuthenticationOnly {
+				if err := r.setAzureADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName), true); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyAuthenticationsClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Info("Decoding state...")
+			var state MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChanges("login_username", "object_id", "tenant_id") {
+				parameters, err := r.expandAdministrator(state)
+				if err != nil {
+					return err
+				}
+
+				metadata.Logger.Infof("Updating %s", id)
+
+				future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, *parameters)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+
+				if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("azuread_authentication_only") {
+				if err := r.setAzureADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName), state.AzureADAuthenticationOnly); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyAuthenticationsClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceActiveDirectoryAdministratorModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if props := existing.ManagedInstanceAdministratorProperties; props != nil {
+				if props.Login != nil {
+					model.LoginUsername = *props.Login
+				}
+				if props.Sid != nil {
+					model.ObjectId = props.Sid.String()
+				}
+				if props.TenantID != nil {
+					model.TenantId = props.TenantID.String()
+				}
+			}
+
+			aadOnlyAuthentication, err := aadOnlyAuthenticationsClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil && !utils.ResponseWasNotFound(aadOnlyAuthentication.Response) {
+				return fmt.Errorf("retrieving Azure Active Directory only authentication for %s: %+v", id, err)
+			}
+
+			if props := aadOnlyAuthentication.ManagedInstanceAzureADOnlyAuthProperties; props != nil && props.AzureADOnlyAuthentication != nil {
+				model.AzureADAuthenticationOnly = *props.AzureADOnlyAuthentication
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyAuthenticationsClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// the administrator can't be removed whilst Azure AD only authentication is enabled
+			if state.AzureADAuthenticationOnly {
+				if err := r.setAzureADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName), false); err != nil {
+					return err
+				}
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) expandAdministrator(model MsSqlManagedInstanceActiveDirectoryAdministratorModel) (*sql.ManagedInstanceAdministrator, error) {
+	sid, err := uuid.FromString(model.ObjectId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `object_id`: %+v", err)
+	}
+
+	tid, err := uuid.FromString(model.TenantId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `tenant_id`: %+v", err)
+	}
+
+	return &sql.ManagedInstanceAdministrator{
+		ManagedInstanceAdministratorProperties: &sql.ManagedInstanceAdministratorProperties{
+			AdministratorType: utils.String(managedInstanceActiveDirectoryAdministratorName),
+			Login:             utils.String(model.LoginUsername),
+			Sid:               &sid,
+			TenantID:          &tid,
+		},
+	}, nil
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) setAzureADOnlyAuthentication(ctx context.Context, client *sql.ManagedInstanceAzureADOnlyAuthenticationsClient, managedInstanceId parse.ManagedInstanceId, enabled bool) error {
+	parameters := sql.ManagedInstanceAzureADOnlyAuthentication{
+		ManagedInstanceAzureADOnlyAuthProperties: &sql.ManagedInstanceAzureADOnlyAuthProperties{
+			AzureADOnlyAuthentication: utils.Bool(enabled),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, managedInstanceId.ResourceGroup, managedInstanceId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("setting `azuread_authentication_only` for %s: %+v", managedInstanceId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for `azuread_authentication_only` to be set for %s: %+v", managedInstanceId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorResource struct{}
+
+func TestAccMsSqlManagedInstanceActiveDirectoryAdministrator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_active_directory_administrator", "test")
+	r := MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceActiveDirectoryAdministrator_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_active_directory_administrator", "test")
+	r := MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstanceActiveDirectoryAdministrator_azureADAuthenticationOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_active_directory_administrator", "test")
+	r := MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azuread_authentication_only").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azuread_authentication_only").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azuread_authentication_only").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstanceAdministratorsClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) basic(data acceptance.TestData, aadOnly bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_mssql_managed_instance_active_directory_administrator" "test" {
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  login_username              = "missadmin"
+  object_id                   = data.azurerm_client_config.current.object_id
+  tenant_id                   = data.azurerm_client_config.current.tenant_id
+  azuread_authentication_only = %[2]t
+}
+`, MsSqlManagedInstanceResource{}.basic(data), aadOnly)
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_active_directory_administrator" "import" {
+  managed_instance_id = azurerm_mssql_managed_instance_active_directory_administrator.test.managed_instance_id
+  login_username      = azurerm_mssql_managed_instance_active_directory_administrator.test.login_username
+  object_id           = azurerm_mssql_managed_instance_active_directory_administrator.test.object_id
+  tenant_id           = azurerm_mssql_managed_instance_active_directory_administrator.test.tenant_id
+}
+`, r.basic(data, false))
+}
@@ -0,0 +1,422 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceFailoverGroupModel struct {
+	Location                             string                                `tfschema:"location"`
+	ManagedInstanceId                    string                                `tfschema:"managed_instance_id"`
+	Name                                 string                                `tfschema:"name"`
+	PartnerManagedInstanceId             string                                `tfschema:"partner_managed_instance_id"`
+	PartnerRegion                        []PartnerRegionModel                  `tfschema:"partner_region"`
+	ReadonlyEndpointFailurePolicyEnabled bool                                  `tfschema:"readonly_endpoint_failover_policy_enabled"`
+	ReadWriteEndpointFailurePolicy       []ReadWriteEndpointFailurePolicyModel `tfschema:"read_write_endpoint_failover_policy"`
+	Role                                 string                                `tfschema:"role"`
+}
+
+type PartnerRegionModel struct {
+	Location string `tfschema:"location"`
+	Role     string `tfschema:"role"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceFailoverGroupResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceFailoverGroupResource{}
+var _ sdk.ResourceWithCustomizeDiff = MsSqlManagedInstanceFailoverGroupResource{}
+
+type MsSqlManagedInstanceFailoverGroupResource struct{}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_failover_group"
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceFailoverGroupModel{}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceFailoverGroupID
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+		},
+
+		"location": location.Schema(),
+
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"partner_managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"readonly_endpoint_failover_policy_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"read_write_endpoint_failover_policy": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(sql.ReadWriteEndpointFailoverPolicyAutomatic),
+							string(sql.ReadWriteEndpointFailoverPolicyManual),
+						}, false),
+					},
+
+					"grace_minutes": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(60),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"partner_region": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"role": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlManagedInstanceFailoverGroupModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("DecodeDiff: %+v", err)
+			}
+
+			if model.ManagedInstanceId != "" && model.ManagedInstanceId == model.PartnerManagedInstanceId {
+				return fmt.Errorf("`partner_managed_instance_id` must refer to a different Managed Instance than `managed_instance_id`")
+			}
+
+			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
+				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) && rwPolicy[0].GraceMinutes < 60 {
+					return fmt.Errorf("`grace_minutes` should be %d or greater when `mode` is %q", 60, sql.ReadWriteEndpointFailoverPolicyAutomatic)
+				}
+				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyManual) && rwPolicy[0].GraceMinutes > 0 {
+					return fmt.Errorf("`grace_minutes` should not be specified when `mode` is %q", sql.ReadWriteEndpointFailoverPolicyManual)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceFailoverGroupsClient
+			instancesClient := metadata.Client.MSSQL.ManagedInstancesClient
+
+			var model MsSqlManagedInstanceFailoverGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceFailoverGroupID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, location.Normalize(model.Location), model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			partnerId, err := parse.ManagedInstanceID(model.PartnerManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			partner, err := instancesClient.Get(ctx, partnerId.ResourceGroup, partnerId.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving partner %s: %+v", partnerId, err)
+			}
+			if partner.Location == nil {
+				return fmt.Errorf("retrieving partner %s: `location` was nil", partnerId)
+			}
+
+			parameters := sql.InstanceFailoverGroup{
+				InstanceFailoverGroupProperties: &sql.InstanceFailoverGroupProperties{
+					ReadOnlyEndpoint:  r.expandReadOnlyEndpoint(model.ReadonlyEndpointFailurePolicyEnabled),
+					ReadWriteEndpoint: r.expandReadWriteEndpoint(model.ReadWriteEndpointFailurePolicy),
+					PartnerRegions: &[]sql.PartnerRegionInfo{
+						{
+							Location: utils.String(location.Normalize(*partner.Location)),
+						},
+					},
+					ManagedInstancePairs: &[]sql.ManagedInstancePairInfo{
+						{
+							PrimaryManagedInstanceID: utils.String(managedInstanceId.ID()),
+							PartnerManagedInstanceID: utils.String(partnerId.ID()),
+						},
+					},
+				},
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceFailoverGroupsClient
+
+			id, err := parse.ManagedInstanceFailoverGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Info("Decoding state...")
+			var state MsSqlManagedInstanceFailoverGroupModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.InstanceFailoverGroupProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			// the instance pairs and partner regions can't be changed, so send back what's already there
+			parameters := sql.InstanceFailoverGroup{
+				InstanceFailoverGroupProperties: &sql.InstanceFailoverGroupProperties{
+					ReadOnlyEndpoint:     r.expandReadOnlyEndpoint(state.ReadonlyEndpointFailurePolicyEnabled),
+					ReadWriteEndpoint:    r.expandReadWriteEndpoint(state.ReadWriteEndpointFailurePolicy),
+					PartnerRegions:       existing.InstanceFailoverGroupProperties.PartnerRegions,
+					ManagedInstancePairs: existing.InstanceFailoverGroupProperties.ManagedInstancePairs,
+				},
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceFailoverGroupsClient
+
+			id, err := parse.ManagedInstanceFailoverGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceFailoverGroupModel{
+				Name:     id.InstanceFailoverGroupName,
+				Location: location.Normalize(id.LocationName),
+			}
+
+			if props := existing.InstanceFailoverGroupProperties; props != nil {
+				model.Role = string(props.ReplicationRole)
+
+				if props.ReadOnlyEndpoint != nil && props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled {
+					model.ReadonlyEndpointFailurePolicyEnabled = true
+				}
+
+				if endpoint := props.ReadWriteEndpoint; endpoint != nil {
+					model.ReadWriteEndpointFailurePolicy = []ReadWriteEndpointFailurePolicyModel{{
+						Mode: string(endpoint.FailoverPolicy),
+					}}
+
+					if endpoint.FailoverWithDataLossGracePeriodMinutes != nil {
+						model.ReadWriteEndpointFailurePolicy[0].GraceMinutes = *endpoint.FailoverWithDataLossGracePeriodMinutes
+					}
+				}
+
+				if pairs := props.ManagedInstancePairs; pairs != nil && len(*pairs) > 0 {
+					pair := (*pairs)[0]
+
+					if pair.PrimaryManagedInstanceID != nil {
+						primaryId, err := parse.ManagedInstanceID(*pair.PrimaryManagedInstanceID)
+						if err != nil {
+							return fmt.Errorf("parsing `primaryManagedInstanceId`: %+v", err)
+						}
+						model.ManagedInstanceId = primaryId.ID()
+					}
+
+					if pair.PartnerManagedInstanceID != nil {
+						partnerId, err := parse.ManagedInstanceID(*pair.PartnerManagedInstanceID)
+						if err != nil {
+							return fmt.Errorf("parsing `partnerManagedInstanceId`: %+v", err)
+						}
+						model.PartnerManagedInstanceId = partnerId.ID()
+					}
+				}
+
+				if regions := props.PartnerRegions; regions != nil {
+					for _, region := range *regions {
+						partnerRegion := PartnerRegionModel{
+							Role: string(region.ReplicationRole),
+						}
+						if region.Location != nil {
+							partnerRegion.Location = location.Normalize(*region.Location)
+						}
+						model.PartnerRegion = append(model.PartnerRegion, partnerRegion)
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceFailoverGroupsClient
+
+			id, err := parse.ManagedInstanceFailoverGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) expandReadOnlyEndpoint(enabled bool) *sql.InstanceFailoverGroupReadOnlyEndpoint {
+	policy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if enabled {
+		policy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	return &sql.InstanceFailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: policy,
+	}
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) expandReadWriteEndpoint(input []ReadWriteEndpointFailurePolicyModel) *sql.InstanceFailoverGroupReadWriteEndpoint {
+	if len(input) == 0 {
+		return nil
+	}
+
+	endpoint := sql.InstanceFailoverGroupReadWriteEndpoint{
+		FailoverPolicy: sql.ReadWriteEndpointFailoverPolicy(input[0].Mode),
+	}
+	if input[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) {
+		endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(input[0].GraceMinutes)
+	}
+
+	return &endpoint
+}
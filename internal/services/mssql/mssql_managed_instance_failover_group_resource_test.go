@@ -0,0 +1,174 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceFailoverGroupResource struct{}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.manualFailover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceFailoverGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstanceFailoverGroupsClient.Get(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%[2]d"
+  location                    = azurerm_mssql_managed_instance.test.location
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+
+  depends_on = [
+    azurerm_virtual_network_peering.test,
+    azurerm_virtual_network_peering.secondary,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) manualFailover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%[2]d"
+  location                    = azurerm_mssql_managed_instance.test.location
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  readonly_endpoint_failover_policy_enabled = false
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  depends_on = [
+    azurerm_virtual_network_peering.test,
+    azurerm_virtual_network_peering.secondary,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_resource_group" "secondary" {
+  name     = "acctestRG-mi-%[2]d-secondary"
+  location = "%[3]s"
+}
+
+%[4]s
+
+resource "azurerm_virtual_network_peering" "test" {
+  name                      = "primary-to-secondary"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.test.name
+  remote_virtual_network_id = azurerm_virtual_network.secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "secondary" {
+  name                      = "secondary-to-primary"
+  resource_group_name       = azurerm_resource_group.secondary.name
+  virtual_network_name      = azurerm_virtual_network.secondary.name
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_mssql_managed_instance" "secondary" {
+  name                = "acctestsqlserver%[2]d-secondary"
+  resource_group_name = azurerm_resource_group.secondary.name
+  location            = azurerm_resource_group.secondary.location
+
+  license_type       = "BasePrice"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 32
+  subnet_id          = azurerm_subnet.secondary.id
+  vcores             = 4
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  dns_zone_partner_id = azurerm_mssql_managed_instance.test.id
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.secondary,
+    azurerm_subnet_route_table_association.secondary,
+  ]
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger, data.Locations.Secondary, MsSqlManagedInstanceResource{}.network(data, "secondary", 1))
+}
@@ -0,0 +1,576 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceModel struct {
+	AdministratorLogin         string                              `tfschema:"administrator_login"`
+	AdministratorLoginPassword string                              `tfschema:"administrator_login_password"`
+	Collation                  string                              `tfschema:"collation"`
+	DnsZonePartnerId           string                              `tfschema:"dns_zone_partner_id"`
+	Fqdn                       string                              `tfschema:"fqdn"`
+	Identity                   []MsSqlManagedInstanceIdentityModel `tfschema:"identity"`
+	LicenseType                string                              `tfschema:"license_type"`
+	Location                   string                              `tfschema:"location"`
+	MinimumTlsVersion          string                              `tfschema:"minimum_tls_version"`
+	Name                       string                              `tfschema:"name"`
+	ProxyOverride              string                              `tfschema:"proxy_override"`
+	PublicDataEndpointEnabled  bool                                `tfschema:"public_data_endpoint_enabled"`
+	ResourceGroupName          string                              `tfschema:"resource_group_name"`
+	SkuName                    string                              `tfschema:"sku_name"`
+	StorageAccountType         string                              `tfschema:"storage_account_type"`
+	StorageSizeInGb            int                                 `tfschema:"storage_size_in_gb"`
+	SubnetId                   string                              `tfschema:"subnet_id"`
+	Tags                       map[string]string                   `tfschema:"tags"`
+	TimezoneId                 string                              `tfschema:"timezone_id"`
+	VCores                     int                                 `tfschema:"vcores"`
+}
+
+type MsSqlManagedInstanceIdentityModel struct {
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+	Type        string `tfschema:"type"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceResource{}
+var _ sdk.ResourceWithCustomizeDiff = MsSqlManagedInstanceResource{}
+
+type MsSqlManagedInstanceResource struct{}
+
+func (r MsSqlManagedInstanceResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance"
+}
+
+func (r MsSqlManagedInstanceResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceModel{}
+}
+
+func (r MsSqlManagedInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceID
+}
+
+func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateMsSqlServerName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"GP_Gen4",
+				"GP_Gen5",
+				"GP_G8IM",
+				"GP_G8IH",
+				"BC_Gen4",
+				"BC_Gen5",
+				"BC_G8IM",
+				"BC_G8IH",
+			}, false),
+		},
+
+		"administrator_login": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"administrator_login_password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"license_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.ManagedInstanceLicenseTypeLicenseIncluded),
+				string(sql.ManagedInstanceLicenseTypeBasePrice),
+			}, false),
+		},
+
+		"storage_size_in_gb": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.All(validation.IntBetween(32, 16384), validation.IntDivisibleBy(32)),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"vcores": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				4,
+				8,
+				16,
+				24,
+				32,
+				40,
+				48,
+				56,
+				64,
+				80,
+				96,
+				128,
+			}),
+		},
+
+		"collation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "SQL_Latin1_General_CP1_CI_AS",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_zone_partner_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(sql.IdentityTypeSystemAssigned),
+						}, false),
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"minimum_tls_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "1.2",
+			ValidateFunc: validation.StringInSlice([]string{
+				"1.0",
+				"1.1",
+				"1.2",
+			}, false),
+		},
+
+		"proxy_override": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(sql.ManagedInstanceProxyOverrideDefault),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.ManagedInstanceProxyOverrideDefault),
+				string(sql.ManagedInstanceProxyOverrideRedirect),
+				string(sql.ManagedInstanceProxyOverrideProxy),
+			}, false),
+		},
+
+		"public_data_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"storage_account_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(sql.StorageAccountTypeGRS),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.StorageAccountTypeGRS),
+				string(sql.StorageAccountTypeLRS),
+				string(sql.StorageAccountTypeZRS),
+			}, false),
+		},
+
+		"timezone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// the DNS zone partner can only be specified when the instance is created
+			if rd.HasChange("dns_zone_partner_id") {
+				if old, _ := rd.GetChange("dns_zone_partner_id"); old.(string) == "" && rd.Id() != "" {
+					if err := rd.ForceNew("dns_zone_partner_id"); err != nil {
+						return err
+					}
+				}
+			}
+
+			// a System Assigned identity can be added, but not removed, once the instance exists
+			if rd.HasChange("identity") && rd.Id() != "" {
+				if old, _ := rd.GetChange("identity.0.type"); old.(string) == string(sql.IdentityTypeSystemAssigned) {
+					if err := rd.ForceNew("identity"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MsSqlManagedInstanceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewManagedInstanceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sku, err := r.expandSkuName(model.SkuName)
+			if err != nil {
+				return fmt.Errorf("expanding `sku_name` for %s: %+v", id, err)
+			}
+
+			parameters := sql.ManagedInstance{
+				Sku:      sku,
+				Identity: r.expandIdentity(model.Identity),
+				Location: utils.String(location.Normalize(model.Location)),
+				ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+					AdministratorLogin:         utils.String(model.AdministratorLogin),
+					AdministratorLoginPassword: utils.String(model.AdministratorLoginPassword),
+					Collation:                  utils.String(model.Collation),
+					LicenseType:                sql.ManagedInstanceLicenseType(model.LicenseType),
+					MinimalTLSVersion:          utils.String(model.MinimumTlsVersion),
+					ProxyOverride:              sql.ManagedInstanceProxyOverride(model.ProxyOverride),
+					PublicDataEndpointEnabled:  utils.Bool(model.PublicDataEndpointEnabled),
+					StorageAccountType:         sql.StorageAccountType(model.StorageAccountType),
+					StorageSizeInGB:            utils.Int32(int32(model.StorageSizeInGb)),
+					SubnetID:                   utils.String(model.SubnetId),
+					TimezoneID:                 utils.String(model.TimezoneId),
+					VCores:                     utils.Int32(int32(model.VCores)),
+				},
+				Tags: tags.FromTypedObject(metadata.Client.Tags, model.Tags),
+			}
+
+			if model.DnsZonePartnerId != "" {
+				parameters.ManagedInstanceProperties.DNSZonePartner = utils.String(model.DnsZonePartnerId)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if response.WasConflict(future.Response()) {
+					return fmt.Errorf("managed instance names need to be globally unique and %q is already in use", id.Name)
+				}
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Info("Decoding state...")
+			var state MsSqlManagedInstanceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			sku, err := r.expandSkuName(state.SkuName)
+			if err != nil {
+				return fmt.Errorf("expanding `sku_name` for %s: %+v", id, err)
+			}
+
+			properties := sql.ManagedInstanceUpdate{
+				Sku:      sku,
+				Identity: r.expandIdentity(state.Identity),
+				ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+					LicenseType:               sql.ManagedInstanceLicenseType(state.LicenseType),
+					MinimalTLSVersion:         utils.String(state.MinimumTlsVersion),
+					ProxyOverride:             sql.ManagedInstanceProxyOverride(state.ProxyOverride),
+					PublicDataEndpointEnabled: utils.Bool(state.PublicDataEndpointEnabled),
+					StorageSizeInGB:           utils.Int32(int32(state.StorageSizeInGb)),
+					VCores:                    utils.Int32(int32(state.VCores)),
+				},
+				Tags: tags.FromTypedObject(metadata.Client.Tags, state.Tags),
+			}
+
+			if metadata.ResourceData.HasChange("administrator_login_password") {
+				properties.ManagedInstanceProperties.AdministratorLoginPassword = utils.String(state.AdministratorLoginPassword)
+			}
+
+			if metadata.ResourceData.HasChange("dns_zone_partner_id") {
+				properties.ManagedInstanceProperties.DNSZonePartner = utils.String(state.DnsZonePartnerId)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.Name, properties)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Decoding state for %s", id)
+			var state MsSqlManagedInstanceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceModel{
+				Name:              id.Name,
+				Location:          location.NormalizeNilable(existing.Location),
+				ResourceGroupName: id.ResourceGroup,
+				Identity:          r.flattenIdentity(existing.Identity),
+				Tags:              tags.ToTypedObject(metadata.Client.Tags, existing.Tags, metadata.ResourceData.Get("tags").(map[string]interface{})),
+
+				// This value is not returned from the API, so we'll use whatever's in state
+				AdministratorLoginPassword: state.AdministratorLoginPassword,
+				// DNS Zone Partner is not returned from the API either
+				DnsZonePartnerId: state.DnsZonePartnerId,
+			}
+
+			if sku := existing.Sku; sku != nil && sku.Name != nil {
+				model.SkuName = *sku.Name
+			}
+
+			if props := existing.ManagedInstanceProperties; props != nil {
+				model.LicenseType = string(props.LicenseType)
+				model.ProxyOverride = string(props.ProxyOverride)
+				model.StorageAccountType = string(props.StorageAccountType)
+
+				if props.AdministratorLogin != nil {
+					model.AdministratorLogin = *props.AdministratorLogin
+				}
+				if props.Collation != nil {
+					model.Collation = *props.Collation
+				}
+				if props.FullyQualifiedDomainName != nil {
+					model.Fqdn = *props.FullyQualifiedDomainName
+				}
+				if props.MinimalTLSVersion != nil {
+					model.MinimumTlsVersion = *props.MinimalTLSVersion
+				}
+				if props.PublicDataEndpointEnabled != nil {
+					model.PublicDataEndpointEnabled = *props.PublicDataEndpointEnabled
+				}
+				if props.StorageSizeInGB != nil {
+					model.StorageSizeInGb = int(*props.StorageSizeInGB)
+				}
+				if props.SubnetID != nil {
+					model.SubnetId = *props.SubnetID
+				}
+				if props.TimezoneID != nil {
+					model.TimezoneId = *props.TimezoneID
+				}
+				if props.VCores != nil {
+					model.VCores = int(*props.VCores)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) expandSkuName(skuName string) (*sql.Sku, error) {
+	parts := strings.Split(skuName, "_")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("sku_name (%s) has the wrong number of parts (%d) after splitting on _", skuName, len(parts))
+	}
+
+	var tier string
+	switch parts[0] {
+	case "GP":
+		tier = "GeneralPurpose"
+	case "BC":
+		tier = "BusinessCritical"
+	default:
+		return nil, fmt.Errorf("sku_name %s has unknown sku tier %s", skuName, parts[0])
+	}
+
+	return &sql.Sku{
+		Name:   utils.String(skuName),
+		Tier:   utils.String(tier),
+		Family: utils.String(parts[1]),
+	}, nil
+}
+
+func (r MsSqlManagedInstanceResource) expandIdentity(input []MsSqlManagedInstanceIdentityModel) *sql.ResourceIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &sql.ResourceIdentity{
+		Type: sql.IdentityType(input[0].Type),
+	}
+}
+
+func (r MsSqlManagedInstanceResource) flattenIdentity(input *sql.ResourceIdentity) []MsSqlManagedInstanceIdentityModel {
+	if input == nil || input.Type == sql.IdentityTypeNone {
+		return nil
+	}
+
+	model := MsSqlManagedInstanceIdentityModel{
+		Type: string(input.Type),
+	}
+	if input.PrincipalID != nil {
+		model.PrincipalId = input.PrincipalID.String()
+	}
+	if input.TenantID != nil {
+		model.TenantId = input.TenantID.String()
+	}
+
+	return []MsSqlManagedInstanceIdentityModel{model}
+}
@@ -0,0 +1,264 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceResource struct{}
+
+func TestAccMsSqlManagedInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccMsSqlManagedInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstance_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccMsSqlManagedInstance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func (r MsSqlManagedInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstancesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MsSqlManagedInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance" "test" {
+  name                = "acctestsqlserver%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  license_type       = "BasePrice"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 32
+  subnet_id          = azurerm_subnet.test.id
+  vcores             = 4
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance" "import" {
+  name                = azurerm_mssql_managed_instance.test.name
+  resource_group_name = azurerm_mssql_managed_instance.test.resource_group_name
+  location            = azurerm_mssql_managed_instance.test.location
+
+  license_type       = azurerm_mssql_managed_instance.test.license_type
+  sku_name           = azurerm_mssql_managed_instance.test.sku_name
+  storage_size_in_gb = azurerm_mssql_managed_instance.test.storage_size_in_gb
+  subnet_id          = azurerm_mssql_managed_instance.test.subnet_id
+  vcores             = azurerm_mssql_managed_instance.test.vcores
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+}
+`, r.basic(data))
+}
+
+func (r MsSqlManagedInstanceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance" "test" {
+  name                = "acctestsqlserver%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  license_type       = "LicenseIncluded"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 64
+  subnet_id          = azurerm_subnet.test.id
+  vcores             = 8
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  minimum_tls_version          = "1.2"
+  proxy_override               = "Redirect"
+  public_data_endpoint_enabled = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+
+  tags = {
+    environment = "staging"
+    database    = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlManagedInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mi-%[1]d"
+  location = "%[2]s"
+}
+
+%[3]s
+`, data.RandomInteger, data.Locations.Primary, MsSqlManagedInstanceResource{}.network(data, "test", 0))
+}
+
+// network returns the Virtual Network, Subnet, Network Security Group and Route Table which a Managed Instance
+// requires, named after and placed in the Resource Group with the given resource name. Each network uses the
+// address space 10.{octet}.0.0/16 so that networks for paired instances don't overlap
+func (MsSqlManagedInstanceResource) network(data acceptance.TestData, name string, octet int) string {
+	return fmt.Sprintf(`
+resource "azurerm_network_security_group" "%[2]s" {
+  name                = "acctestnsg-mi-%[1]d-%[2]s"
+  location            = azurerm_resource_group.%[2]s.location
+  resource_group_name = azurerm_resource_group.%[2]s.name
+}
+
+resource "azurerm_virtual_network" "%[2]s" {
+  name                = "acctestvnet-mi-%[1]d-%[2]s"
+  location            = azurerm_resource_group.%[2]s.location
+  resource_group_name = azurerm_resource_group.%[2]s.name
+  address_space       = ["10.%[3]d.0.0/16"]
+}
+
+resource "azurerm_subnet" "%[2]s" {
+  name                 = "subnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.%[2]s.name
+  virtual_network_name = azurerm_virtual_network.%[2]s.name
+  address_prefixes     = ["10.%[3]d.0.0/24"]
+
+  delegation {
+    name = "managedinstancedelegation"
+
+    service_delegation {
+      name = "Microsoft.Sql/managedInstances"
+      actions = [
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+        "Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action",
+        "Microsoft.Network/virtualNetworks/subnets/unprepareNetworkPolicies/action",
+      ]
+    }
+  }
+}
+
+resource "azurerm_subnet_network_security_group_association" "%[2]s" {
+  subnet_id                 = azurerm_subnet.%[2]s.id
+  network_security_group_id = azurerm_network_security_group.%[2]s.id
+}
+
+resource "azurerm_route_table" "%[2]s" {
+  name                          = "acctestrt-mi-%[1]d-%[2]s"
+  location                      = azurerm_resource_group.%[2]s.location
+  resource_group_name           = azurerm_resource_group.%[2]s.name
+  disable_bgp_route_propagation = false
+
+  depends_on = [
+    azurerm_subnet.%[2]s,
+  ]
+}
+
+resource "azurerm_subnet_route_table_association" "%[2]s" {
+  subnet_id      = azurerm_subnet.%[2]s.id
+  route_table_id = azurerm_route_table.%[2]s.id
+}
+`, data.RandomInteger, name, octet)
+}
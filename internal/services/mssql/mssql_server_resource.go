@@ -236,7 +236,7 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	version := d.Get("version").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	metadata := tags.Expand(t)
+	metadata := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -342,7 +342,7 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	version := d.Get("version").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	metadata := tags.Expand(t)
+	metadata := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	props := sql.Server{
 		Location: utils.String(location),
@@ -550,7 +550,7 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("setting `restorable_dropped_database_ids`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMsSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
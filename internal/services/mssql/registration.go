@@ -60,5 +60,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MsSqlFailoverGroupResource{},
+		MsSqlManagedDatabaseResource{},
+		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
+		MsSqlManagedInstanceFailoverGroupResource{},
+		MsSqlManagedInstanceResource{},
 	}
 }
@@ -248,5 +248,5 @@ func dataSourceMySqlServerRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
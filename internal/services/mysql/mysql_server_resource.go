@@ -561,7 +561,7 @@ func resourceMySqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Location:   &location,
 		Properties: props,
 		Sku:        sku,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, id.ResourceGroup, id.Name, server)
@@ -635,7 +635,7 @@ func resourceMySqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			Version:                    mysql.ServerVersion(d.Get("version").(string)),
 		},
 		Sku:  sku,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, properties)
@@ -738,7 +738,7 @@ func resourceMySqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMySqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
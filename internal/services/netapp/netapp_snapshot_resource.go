@@ -107,7 +107,7 @@ func resourceNetAppSnapshotCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
-	if tags.Expand(d.Get("tags").(map[string]interface{})) != nil {
+	if tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})) != nil {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, ignoring values.")
 	}
 
@@ -163,7 +163,7 @@ func resourceNetAppSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) err
 func resourceNetAppSnapshotUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	// Snapshot resource in Azure changed its type to proxied resource, therefore
 	// tags are not supported anymore, ignoring any tags.
-	if tags.Expand(d.Get("tags").(map[string]interface{})) == nil {
+	if tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})) == nil {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, no update will happen in a snapshot at this time.")
 	}
 
@@ -77,7 +77,7 @@ func resourceApplicationSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, met
 
 	securityGroup := network.ApplicationSecurityGroup{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, securityGroup)
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceApplicationSecurityGroupRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApplicationSecurityGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
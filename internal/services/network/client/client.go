@@ -3,6 +3,8 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routingintent"
 )
 
 type Client struct {
@@ -32,7 +34,9 @@ type Client struct {
 	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
 	RoutesClient                           *network.RoutesClient
 	RouteFiltersClient                     *network.RouteFiltersClient
+	RouteMapsClient                        *routemaps.RouteMapsClient
 	RouteTablesClient                      *network.RouteTablesClient
+	RoutingIntentClient                    *routingintent.RoutingIntentClient
 	SecurityGroupClient                    *network.SecurityGroupsClient
 	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
 	SecurityRuleClient                     *network.SecurityRulesClient
@@ -200,6 +204,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	VirtualHubClient := network.NewVirtualHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteMapsClient := routemaps.NewRouteMapsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RouteMapsClient.Client, o.ResourceManagerAuthorizer)
+
+	RoutingIntentClient := routingintent.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
@@ -248,7 +258,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
 		RoutesClient:                           &RoutesClient,
 		RouteFiltersClient:                     &RouteFiltersClient,
+		RouteMapsClient:                        &RouteMapsClient,
 		RouteTablesClient:                      &RouteTablesClient,
+		RoutingIntentClient:                    &RoutingIntentClient,
 		SecurityGroupClient:                    &SecurityGroupClient,
 		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
 		SecurityRuleClient:                     &SecurityRuleClient,
@@ -92,7 +92,7 @@ func resourceIpGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		IPGroupPropertiesFormat: &network.IPGroupPropertiesFormat{
 			IPAddresses: utils.ExpandStringSlice(ipAddresses),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, sg)
@@ -151,7 +151,7 @@ func resourceIpGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceIpGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -143,7 +143,7 @@ func resourceNatGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		Sku: &network.NatGatewaySku{
 			Name: network.NatGatewaySkuName(skuName),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.Expand(meta.(*clients.Client).Tags, t),
 		Zones: utils.ExpandStringSlice(zones),
 	}
 
@@ -223,7 +223,7 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
-		parameters.Tags = tags.Expand(t)
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, t)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -285,7 +285,7 @@ func resourceNatGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -78,5 +78,5 @@ func dataSourceNetworkDDoSProtectionPlanRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -101,7 +101,7 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -161,7 +161,7 @@ func resourceNetworkDDoSProtectionPlanRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	return tags.FlattenAndSet(d, plan.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, plan.Tags)
 }
 
 func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -138,7 +138,7 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	parameters := network.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
 			ContainerNetworkInterfaceConfigurations: expandNetworkProfileContainerNetworkInterface(d),
 		},
@@ -192,7 +192,7 @@ func resourceNetworkProfileRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	return tags.FlattenAndSet(d, profile.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, profile.Tags)
 }
 
 func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
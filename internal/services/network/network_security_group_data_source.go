@@ -169,5 +169,5 @@ func dataSourceNetworkSecurityGroupRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
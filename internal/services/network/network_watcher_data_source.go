@@ -58,5 +58,5 @@ func dataSourceNetworkWatcherRead(d *pluginsdk.ResourceData, meta interface{}) e
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
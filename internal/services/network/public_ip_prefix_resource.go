@@ -181,7 +181,7 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			PrefixLength:           utils.Int32(int32(prefixLength)),
 			PublicIPAddressVersion: network.IPVersion(ipVersion),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.Expand(meta.(*clients.Client).Tags, t),
 		Zones: zones,
 	}
 
@@ -252,7 +252,7 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePublicIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) error {
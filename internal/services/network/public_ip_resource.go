@@ -270,7 +270,7 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.Expand(meta.(*clients.Client).Tags, t),
 		Zones: zones,
 	}
 
@@ -395,7 +395,7 @@ func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePublicIpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -94,6 +94,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_route_filter":                              resourceRouteFilter(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
+		"azurerm_route_map":                                 resourceRouteMap(),
+		"azurerm_route_server":                              resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
 		"azurerm_subnet_service_endpoint_storage_policy":    resourceSubnetServiceEndpointStoragePolicy(),
 		"azurerm_subnet_network_security_group_association": resourceSubnetNetworkSecurityGroupAssociation(),
@@ -106,6 +109,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
 		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
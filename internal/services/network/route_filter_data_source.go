@@ -98,7 +98,7 @@ func dataSourceRouteFilterRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenRouteFilterDataSourceRules(input *[]network.RouteFilterRule) []interface{} {
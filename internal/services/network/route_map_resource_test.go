@@ -0,0 +1,254 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RouteMapResource struct{}
+
+func TestAccRouteMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_map", "test")
+	r := RouteMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRouteMap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_map", "test")
+	r := RouteMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRouteMap_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_map", "test")
+	r := RouteMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRouteMap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_map", "test")
+	r := RouteMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RouteMapResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := routemaps.ParseRouteMapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.RouteMapsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r RouteMapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_map" "test" {
+  name           = "acctestrm-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RouteMapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_map" "import" {
+  name           = azurerm_route_map.test.name
+  virtual_hub_id = azurerm_route_map.test.virtual_hub_id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r RouteMapResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_map" "test" {
+  name           = "acctestrm-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8", "172.16.0.0/12"]
+    }
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+  }
+
+  rule {
+    name                 = "rule2"
+    next_step_if_matched = "Terminate"
+
+    match_criterion {
+      match_condition = "Equals"
+      community       = ["12345:12345"]
+    }
+
+    action {
+      type = "Replace"
+
+      parameter {
+        community = ["54321:54321"]
+      }
+    }
+  }
+
+  rule {
+    name                 = "rule3"
+    next_step_if_matched = "Terminate"
+
+    match_criterion {
+      match_condition = "NotContains"
+      as_path         = ["65001"]
+    }
+
+    action {
+      type = "Drop"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (RouteMapResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rm-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
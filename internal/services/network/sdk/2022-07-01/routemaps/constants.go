@@ -0,0 +1,142 @@
+package routemaps
+
+import "strings"
+
+type NextStep string
+
+const (
+	NextStepContinue  NextStep = "Continue"
+	NextStepTerminate NextStep = "Terminate"
+	NextStepUnknown   NextStep = "Unknown"
+)
+
+func PossibleValuesForNextStep() []string {
+	return []string{
+		string(NextStepContinue),
+		string(NextStepTerminate),
+		string(NextStepUnknown),
+	}
+}
+
+func parseNextStep(input string) (*NextStep, error) {
+	vals := map[string]NextStep{
+		"continue":  NextStepContinue,
+		"terminate": NextStepTerminate,
+		"unknown":   NextStepUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NextStep(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type RouteMapActionType string
+
+const (
+	RouteMapActionTypeAdd     RouteMapActionType = "Add"
+	RouteMapActionTypeDrop    RouteMapActionType = "Drop"
+	RouteMapActionTypeRemove  RouteMapActionType = "Remove"
+	RouteMapActionTypeReplace RouteMapActionType = "Replace"
+	RouteMapActionTypeUnknown RouteMapActionType = "Unknown"
+)
+
+func PossibleValuesForRouteMapActionType() []string {
+	return []string{
+		string(RouteMapActionTypeAdd),
+		string(RouteMapActionTypeDrop),
+		string(RouteMapActionTypeRemove),
+		string(RouteMapActionTypeReplace),
+		string(RouteMapActionTypeUnknown),
+	}
+}
+
+func parseRouteMapActionType(input string) (*RouteMapActionType, error) {
+	vals := map[string]RouteMapActionType{
+		"add":     RouteMapActionTypeAdd,
+		"drop":    RouteMapActionTypeDrop,
+		"remove":  RouteMapActionTypeRemove,
+		"replace": RouteMapActionTypeReplace,
+		"unknown": RouteMapActionTypeUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RouteMapActionType(input)
+	return &out, nil
+}
+
+type RouteMapMatchCondition string
+
+const (
+	RouteMapMatchConditionContains    RouteMapMatchCondition = "Contains"
+	RouteMapMatchConditionEquals      RouteMapMatchCondition = "Equals"
+	RouteMapMatchConditionNotContains RouteMapMatchCondition = "NotContains"
+	RouteMapMatchConditionNotEquals   RouteMapMatchCondition = "NotEquals"
+	RouteMapMatchConditionUnknown     RouteMapMatchCondition = "Unknown"
+)
+
+func PossibleValuesForRouteMapMatchCondition() []string {
+	return []string{
+		string(RouteMapMatchConditionContains),
+		string(RouteMapMatchConditionEquals),
+		string(RouteMapMatchConditionNotContains),
+		string(RouteMapMatchConditionNotEquals),
+		string(RouteMapMatchConditionUnknown),
+	}
+}
+
+func parseRouteMapMatchCondition(input string) (*RouteMapMatchCondition, error) {
+	vals := map[string]RouteMapMatchCondition{
+		"contains":    RouteMapMatchConditionContains,
+		"equals":      RouteMapMatchConditionEquals,
+		"notcontains": RouteMapMatchConditionNotContains,
+		"notequals":   RouteMapMatchConditionNotEquals,
+		"unknown":     RouteMapMatchConditionUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RouteMapMatchCondition(input)
+	return &out, nil
+}
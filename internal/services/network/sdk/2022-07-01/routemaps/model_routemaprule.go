@@ -0,0 +1,8 @@
+package routemaps
+
+type RouteMapRule struct {
+	Actions           *[]Action    `json:"actions,omitempty"`
+	MatchCriteria     *[]Criterion `json:"matchCriteria,omitempty"`
+	Name              *string      `json:"name,omitempty"`
+	NextStepIfMatched *NextStep    `json:"nextStepIfMatched,omitempty"`
+}
@@ -0,0 +1,15 @@
+package routingintent
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoutingIntentClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoutingIntentClientWithBaseURI(endpoint string) RoutingIntentClient {
+	return RoutingIntentClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
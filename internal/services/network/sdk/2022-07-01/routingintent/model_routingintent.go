@@ -0,0 +1,9 @@
+package routingintent
+
+type RoutingIntent struct {
+	Etag       *string                  `json:"etag,omitempty"`
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *RoutingIntentProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package routingintent
+
+type RoutingPolicy struct {
+	Destinations []string `json:"destinations"`
+	Name         string   `json:"name"`
+	NextHop      string   `json:"nextHop"`
+}
@@ -73,13 +73,19 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	lockKeys := []string{
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+		locks.NameKey(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName),
+	}
+	if err := locks.MultipleWithContext(ctx, lockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultiple(lockKeys)
 
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
+	// the Subnet is locked separately since the Virtual Network must be locked first, matching the Subnet resource
+	if err := locks.ByNameWithContext(ctx, parsedSubnetId.Name, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
@@ -218,13 +224,19 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	lockKeys := []string{
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+		locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName),
+	}
+	if err := locks.MultipleWithContext(ctx, lockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultiple(lockKeys)
 
-	locks.ByName(id.Name, SubnetResourceName)
+	// the Subnet is locked separately since the Virtual Network must be locked first, matching the Subnet resource
+	if err := locks.ByNameWithContext(ctx, id.Name, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.Name, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
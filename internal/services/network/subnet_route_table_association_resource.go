@@ -73,15 +73,18 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	lockKeys := []string{
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	}
+	if err := locks.MultipleWithContext(ctx, lockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultiple(lockKeys)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -230,11 +233,14 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	lockKeys := []string{
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	}
+	if err := locks.MultipleWithContext(ctx, lockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultiple(lockKeys)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
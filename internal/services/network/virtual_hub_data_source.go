@@ -94,5 +94,5 @@ func dataSourceVirtualHubRead(d *pluginsdk.ResourceData, meta interface{}) error
 	defaultRouteTable := parse.NewHubRouteTableID(virtualHub.SubscriptionId, virtualHub.ResourceGroup, virtualHub.Name, "defaultRouteTable")
 	d.Set("default_route_table_id", defaultRouteTable.ID())
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
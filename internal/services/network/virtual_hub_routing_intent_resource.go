@@ -0,0 +1,258 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routingintent"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+const (
+	routingIntentDestinationInternet       = "Internet"
+	routingIntentDestinationPrivateTraffic = "PrivateTraffic"
+)
+
+func resourceVirtualHubRoutingIntent() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualHubRoutingIntentCreateUpdate,
+		Read:   resourceVirtualHubRoutingIntentRead,
+		Update: resourceVirtualHubRoutingIntentCreateUpdate,
+		Delete: resourceVirtualHubRoutingIntentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := routingintent.ParseRoutingIntentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"routing_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									routingIntentDestinationInternet,
+									routingIntentDestinationPrivateTraffic,
+								}, false),
+							},
+						},
+
+						"next_hop": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualHubRoutingIntentCustomizeDiff),
+	}
+}
+
+func resourceVirtualHubRoutingIntentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := routingintent.NewRoutingIntentID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_routing_intent", id.ID())
+		}
+	}
+
+	parameters := routingintent.RoutingIntent{
+		Name: &id.RoutingIntentName,
+		Properties: &routingintent.RoutingIntentProperties{
+			RoutingPolicies: expandVirtualHubRoutingIntentRoutingPolicies(d.Get("routing_policy").([]interface{})),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubRoutingIntentRead(d, meta)
+}
+
+func resourceVirtualHubRoutingIntentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routingintent.ParseRoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RoutingIntentName)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroupName, id.VirtualHubName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("routing_policy", flattenVirtualHubRoutingIntentRoutingPolicies(props.RoutingPolicies)); err != nil {
+				return fmt.Errorf("setting `routing_policy`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRoutingIntentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routingintent.ParseRoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// virtualHubRoutingIntentCustomizeDiff ensures that each type of traffic is routed by at most one routing policy,
+// which the API otherwise only rejects once the Virtual Hub has been (slowly) reconfigured
+func virtualHubRoutingIntentCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	destinations := make(map[string]string)
+	for _, item := range diff.Get("routing_policy").([]interface{}) {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		for _, destination := range v["destinations"].([]interface{}) {
+			destination, ok := destination.(string)
+			if !ok || destination == "" {
+				continue
+			}
+			if existing, ok := destinations[destination]; ok {
+				return fmt.Errorf("the destination %q can only be used by a single `routing_policy` but is used by both %q and %q", destination, existing, name)
+			}
+			destinations[destination] = name
+		}
+	}
+
+	return nil
+}
+
+func expandVirtualHubRoutingIntentRoutingPolicies(input []interface{}) *[]routingintent.RoutingPolicy {
+	results := make([]routingintent.RoutingPolicy, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		destinations := make([]string, 0)
+		for _, destination := range v["destinations"].([]interface{}) {
+			destinations = append(destinations, destination.(string))
+		}
+
+		results = append(results, routingintent.RoutingPolicy{
+			Name:         v["name"].(string),
+			Destinations: destinations,
+			NextHop:      v["next_hop"].(string),
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualHubRoutingIntentRoutingPolicies(input *[]routingintent.RoutingPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"name":         item.Name,
+			"destinations": item.Destinations,
+			"next_hop":     item.NextHop,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,233 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routingintent"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualHubRoutingIntentResource struct{}
+
+func TestAccVirtualHubRoutingIntent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_duplicateDestination(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateDestination(data),
+			ExpectError: regexp.MustCompile("the destination \"Internet\" can only be used by a single `routing_policy`"),
+		},
+	})
+}
+
+func (r VirtualHubRoutingIntentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := routingintent.ParseRoutingIntentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.RoutingIntentClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VirtualHubRoutingIntentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRoutingIntentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "import" {
+  name           = azurerm_virtual_hub_routing_intent.test.name
+  virtual_hub_id = azurerm_virtual_hub_routing_intent.test.virtual_hub_id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubRoutingIntentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  routing_policy {
+    name         = "PrivateTrafficPolicy"
+    destinations = ["PrivateTraffic"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRoutingIntentResource) duplicateDestination(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  routing_policy {
+    name         = "AllTrafficPolicy"
+    destinations = ["PrivateTraffic", "Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (VirtualHubRoutingIntentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctest-fw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "AZFW_Hub"
+  firewall_policy_id  = azurerm_firewall_policy.test.id
+  threat_intel_mode   = ""
+
+  virtual_hub {
+    virtual_hub_id  = azurerm_virtual_hub.test.id
+    public_ip_count = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
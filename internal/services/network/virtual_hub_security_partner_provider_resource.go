@@ -96,7 +96,7 @@ func resourceVirtualHubSecurityPartnerProviderCreate(d *pluginsdk.ResourceData,
 		SecurityPartnerProviderPropertiesFormat: &network.SecurityPartnerProviderPropertiesFormat{
 			SecurityProviderName: network.SecurityProviderName(d.Get("security_provider_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("virtual_hub_id"); ok {
@@ -160,7 +160,7 @@ func resourceVirtualHubSecurityPartnerProviderRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualHubSecurityPartnerProviderUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -176,7 +176,7 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *pluginsdk.ResourceData,
 	parameters := network.TagsObject{}
 
 	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		parameters.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
@@ -426,7 +426,7 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &id.Name,
 		Location:                              &location,
-		Tags:                                  tags.Expand(t),
+		Tags:                                  tags.Expand(meta.(*clients.Client).Tags, t),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
@@ -509,7 +509,7 @@ func resourceVirtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualNetworkGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -189,7 +189,7 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Name:                           utils.String(id.Name),
 		Location:                       utils.String(location),
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags:                           tags.Expand(t),
+		Tags:                           tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("flow_timeout_in_minutes"); ok {
@@ -298,7 +298,7 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("vm_protection_enabled", props.EnableVMProtection)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
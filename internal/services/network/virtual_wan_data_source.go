@@ -112,7 +112,7 @@ func dataSourceVirtualWanRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenVirtualWanProperties(input *[]network.SubResource) []interface{} {
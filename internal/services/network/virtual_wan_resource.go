@@ -123,7 +123,7 @@ func resourceVirtualWanCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	wan := network.VirtualWAN{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		VirtualWanProperties: &network.VirtualWanProperties{
 			DisableVpnEncryption:           utils.Bool(disableVpnEncryption),
 			AllowBranchToBranchTraffic:     utils.Bool(allowBranchToBranchTraffic),
@@ -181,7 +181,7 @@ func resourceVirtualWanRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("type", props.Type)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualWanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -193,7 +193,7 @@ func dataSourceVPNGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("virtual_hub_id", virtualHubId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func dataSourceFlattenVPNGatewayBGPSettings(input *network.BgpSettings) []interface{} {
@@ -228,7 +228,7 @@ func resourceVPNGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			VpnGatewayScaleUnit:         utils.Int32(int32(scaleUnit)),
 			IsRoutingPreferenceInternet: utils.Bool(d.Get("routing_preference").(string) == "Internet"),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
@@ -293,7 +293,7 @@ func resourceVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		existing.VpnGatewayScaleUnit = utils.Int32(int32(d.Get("scale_unit").(int)))
 	}
 	if d.HasChange("tags") {
-		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		existing.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	bgpSettingsRaw := d.Get("bgp_settings").([]interface{})
@@ -375,7 +375,7 @@ func resourceVPNGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("routing_preference", isRoutingPreferenceInternet)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVPNGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -489,7 +489,7 @@ func resourceVPNServerConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta
 	parameters := network.VpnServerConfiguration{
 		Location:                         utils.String(location),
 		VpnServerConfigurationProperties: &props,
-		Tags:                             tags.Expand(t),
+		Tags:                             tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -584,7 +584,7 @@ func resourceVPNServerConfigurationRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVPNServerConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -60,5 +60,5 @@ func dataSourceWebApplicationFirewallPolicy(d *pluginsdk.ResourceData, meta inte
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -128,7 +128,7 @@ func resourceNotificationHubNamespaceCreateUpdate(d *pluginsdk.ResourceData, met
 			NamespaceType: notificationhubs.NamespaceType(d.Get("namespace_type").(string)),
 			Enabled:       utils.Bool(d.Get("enabled").(bool)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -194,7 +194,7 @@ func resourceNotificationHubNamespaceRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("servicebus_endpoint", props.ServiceBusEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceNotificationHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
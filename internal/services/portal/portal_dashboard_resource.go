@@ -72,7 +72,7 @@ func resourceDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	dashboard := portal.Dashboard{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	var dashboardProperties portal.DashboardProperties
@@ -122,7 +122,7 @@ func resourceDashboardRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("dashboard_properties", string(props))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDashboardDelete(d *pluginsdk.ResourceData, meta interface{}) error {
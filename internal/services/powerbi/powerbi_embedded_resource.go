@@ -120,7 +120,7 @@ func resourcePowerBIEmbeddedCreate(d *pluginsdk.ResourceData, meta interface{})
 		Sku: capacities.CapacitySku{
 			Name: d.Get("sku_name").(string),
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
@@ -175,7 +175,7 @@ func resourcePowerBIEmbeddedRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 		d.Set("sku_name", model.Sku.Name)
 
-		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
 			return err
 		}
 	}
@@ -214,7 +214,7 @@ func resourcePowerBIEmbeddedUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("tags") {
-		parameters.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+		parameters.Tags = tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
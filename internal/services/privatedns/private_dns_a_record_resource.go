@@ -100,7 +100,7 @@ func resourcePrivateDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta inter
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.AName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata: tags.Expand(t),
+			Metadata: tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:      &ttl,
 			ARecords: expandAzureRmPrivateDnsARecords(d),
 		},
@@ -146,7 +146,7 @@ func resourcePrivateDnsARecordRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsARecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -96,7 +96,7 @@ func resourcePrivateDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta in
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.AAAAName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:    tags.Expand(t),
+			Metadata:    tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:         &ttl,
 			AaaaRecords: expandAzureRmPrivateDnsAaaaRecords(d),
 		},
@@ -141,7 +141,7 @@ func resourcePrivateDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{
 	if err := d.Set("records", flattenAzureRmPrivateDnsAaaaRecords(resp.AaaaRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsAaaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -103,7 +103,7 @@ func resourcePrivateDnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta i
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.CNAMEName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata: tags.Expand(t),
+			Metadata: tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:      &ttl,
 			CnameRecord: &privatedns.CnameRecord{
 				Cname: &record,
@@ -154,7 +154,7 @@ func resourcePrivateDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsCNameRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
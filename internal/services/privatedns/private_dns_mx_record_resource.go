@@ -118,7 +118,7 @@ func resourcePrivateDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.MXName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:       &ttl,
 			MxRecords: expandAzureRmPrivateDnsMxRecords(d),
 		},
@@ -163,7 +163,7 @@ func resourcePrivateDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
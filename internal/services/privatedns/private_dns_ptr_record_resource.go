@@ -103,7 +103,7 @@ func resourcePrivateDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta int
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.PTRName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.Expand(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			PtrRecords: expandAzureRmPrivateDnsPtrRecords(d),
 		},
@@ -151,7 +151,7 @@ func resourcePrivateDnsPtrRecordRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsPtrRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,272 @@
+package privatedns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsResolverForwardingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverForwardingRuleCreateUpdate,
+		Read:   resourcePrivateDnsResolverForwardingRuleRead,
+		Update: resourcePrivateDnsResolverForwardingRuleCreateUpdate,
+		Delete: resourcePrivateDnsResolverForwardingRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := forwardingrules.ParseForwardingRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_forwarding_ruleset_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+			},
+
+			"domain_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					func(i interface{}, k string) (warnings []string, errors []error) {
+						v, ok := i.(string)
+						if !ok {
+							errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+							return
+						}
+
+						if !strings.HasSuffix(v, ".") {
+							errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name ending with a `.`, got %q", k, v))
+						}
+						return
+					},
+				),
+			},
+
+			"target_dns_servers": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_address": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      53,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"metadata": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcePrivateDnsResolverForwardingRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	rulesetId, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(d.Get("dns_forwarding_ruleset_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_forwarding_rule", id.ID())
+		}
+	}
+
+	state := forwardingrules.ForwardingRuleStateDisabled
+	if d.Get("enabled").(bool) {
+		state = forwardingrules.ForwardingRuleStateEnabled
+	}
+
+	parameters := forwardingrules.ForwardingRule{
+		Properties: forwardingrules.ForwardingRuleProperties{
+			DomainName:          d.Get("domain_name").(string),
+			ForwardingRuleState: &state,
+			Metadata:            expandPrivateDnsResolverMetadata(d.Get("metadata").(map[string]interface{})),
+			TargetDnsServers:    expandPrivateDnsResolverTargetDnsServers(d.Get("target_dns_servers").([]interface{})),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsResolverForwardingRuleRead(d, meta)
+}
+
+func resourcePrivateDnsResolverForwardingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := forwardingrules.ParseForwardingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ForwardingRuleName)
+	d.Set("dns_forwarding_ruleset_id", dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("domain_name", props.DomainName)
+		d.Set("enabled", props.ForwardingRuleState == nil || *props.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled)
+
+		if err := d.Set("target_dns_servers", flattenPrivateDnsResolverTargetDnsServers(props.TargetDnsServers)); err != nil {
+			return fmt.Errorf("setting `target_dns_servers`: %+v", err)
+		}
+
+		if err := d.Set("metadata", flattenPrivateDnsResolverMetadata(props.Metadata)); err != nil {
+			return fmt.Errorf("setting `metadata`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverForwardingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := forwardingrules.ParseForwardingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandPrivateDnsResolverTargetDnsServers(input []interface{}) []forwardingrules.TargetDnsServer {
+	results := make([]forwardingrules.TargetDnsServer, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, forwardingrules.TargetDnsServer{
+			IPAddress: v["ip_address"].(string),
+			Port:      utils.Int64(int64(v["port"].(int))),
+		})
+	}
+
+	return results
+}
+
+func flattenPrivateDnsResolverTargetDnsServers(input []forwardingrules.TargetDnsServer) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		port := 53
+		if item.Port != nil {
+			port = int(*item.Port)
+		}
+
+		results = append(results, map[string]interface{}{
+			"ip_address": item.IPAddress,
+			"port":       port,
+		})
+	}
+
+	return results
+}
+
+func expandPrivateDnsResolverMetadata(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return &output
+}
+
+func flattenPrivateDnsResolverMetadata(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = v
+	}
+
+	return output
+}
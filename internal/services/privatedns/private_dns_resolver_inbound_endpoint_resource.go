@@ -0,0 +1,243 @@
+package privatedns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsResolverInboundEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverInboundEndpointCreateUpdate,
+		Read:   resourcePrivateDnsResolverInboundEndpointRead,
+		Update: resourcePrivateDnsResolverInboundEndpointCreateUpdate,
+		Delete: resourcePrivateDnsResolverInboundEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := inboundendpoints.ParseInboundEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_dns_resolver_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: dnsresolvers.ValidateDnsResolverID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"ip_configurations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+
+						"private_ip_allocation_method": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  string(inboundendpoints.IPAllocationMethodDynamic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(inboundendpoints.IPAllocationMethodDynamic),
+								string(inboundendpoints.IPAllocationMethodStatic),
+							}, false),
+						},
+
+						"private_ip_address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourcePrivateDnsResolverInboundEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dnsResolverId, err := dnsresolvers.ParseDnsResolverID(d.Get("private_dns_resolver_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := inboundendpoints.NewInboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroupName, dnsResolverId.DnsResolverName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_inbound_endpoint", id.ID())
+		}
+	}
+
+	parameters := inboundendpoints.InboundEndpoint{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: inboundendpoints.InboundEndpointProperties{
+			IPConfigurations: expandPrivateDnsResolverInboundEndpointIPConfigurations(d.Get("ip_configurations").([]interface{})),
+		},
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsResolverInboundEndpointRead(d, meta)
+}
+
+func resourcePrivateDnsResolverInboundEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := inboundendpoints.ParseInboundEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.InboundEndpointName)
+	d.Set("private_dns_resolver_id", dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("ip_configurations", flattenPrivateDnsResolverInboundEndpointIPConfigurations(model.Properties.IPConfigurations)); err != nil {
+			return fmt.Errorf("setting `ip_configurations`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverInboundEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := inboundendpoints.ParseInboundEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandPrivateDnsResolverInboundEndpointIPConfigurations(input []interface{}) []inboundendpoints.IPConfiguration {
+	results := make([]inboundendpoints.IPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		allocationMethod := inboundendpoints.IPAllocationMethod(v["private_ip_allocation_method"].(string))
+		ipConfiguration := inboundendpoints.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: inboundendpoints.SubResource{
+				Id: v["subnet_id"].(string),
+			},
+		}
+
+		if privateIpAddress := v["private_ip_address"].(string); privateIpAddress != "" {
+			ipConfiguration.PrivateIPAddress = utils.String(privateIpAddress)
+		}
+
+		results = append(results, ipConfiguration)
+	}
+
+	return results
+}
+
+func flattenPrivateDnsResolverInboundEndpointIPConfigurations(input []inboundendpoints.IPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		allocationMethod := ""
+		if item.PrivateIPAllocationMethod != nil {
+			allocationMethod = string(*item.PrivateIPAllocationMethod)
+		}
+
+		privateIpAddress := ""
+		if item.PrivateIPAddress != nil {
+			privateIpAddress = *item.PrivateIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"subnet_id":                    item.Subnet.Id,
+			"private_ip_allocation_method": allocationMethod,
+			"private_ip_address":           privateIpAddress,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,150 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverInboundEndpointResource struct {
+}
+
+func TestAccPrivateDnsResolverInboundEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_inbound_endpoint", "test")
+	r := PrivateDnsResolverInboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configurations.0.private_ip_address").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverInboundEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_inbound_endpoint", "test")
+	r := PrivateDnsResolverInboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverInboundEndpoint_static(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_inbound_endpoint", "test")
+	r := PrivateDnsResolverInboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.static(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configurations.0.private_ip_address").HasValue("10.0.0.10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateDnsResolverInboundEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := inboundendpoints.ParseInboundEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.InboundEndpointsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PrivateDnsResolverInboundEndpointResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "inbound" {
+  name                 = "inbound"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/28"]
+
+  delegation {
+    name = "Microsoft.Network.dnsResolvers"
+    service_delegation {
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+      name    = "Microsoft.Network/dnsResolvers"
+    }
+  }
+}
+`, PrivateDnsResolverResource{}.basic(data))
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_inbound_endpoint" "test" {
+  name                    = "acctest-drie-%d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+
+  ip_configurations {
+    subnet_id = azurerm_subnet.inbound.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_inbound_endpoint" "import" {
+  name                    = azurerm_private_dns_resolver_inbound_endpoint.test.name
+  private_dns_resolver_id = azurerm_private_dns_resolver_inbound_endpoint.test.private_dns_resolver_id
+  location                = azurerm_private_dns_resolver_inbound_endpoint.test.location
+
+  ip_configurations {
+    subnet_id = azurerm_subnet.inbound.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) static(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_inbound_endpoint" "test" {
+  name                    = "acctest-drie-%d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+
+  ip_configurations {
+    subnet_id                    = azurerm_subnet.inbound.id
+    private_ip_allocation_method = "Static"
+    private_ip_address           = "10.0.0.10"
+  }
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,145 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverOutboundEndpointResource struct {
+}
+
+func TestAccPrivateDnsResolverOutboundEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_outbound_endpoint", "test")
+	r := PrivateDnsResolverOutboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverOutboundEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_outbound_endpoint", "test")
+	r := PrivateDnsResolverOutboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverOutboundEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_outbound_endpoint", "test")
+	r := PrivateDnsResolverOutboundEndpointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateDnsResolverOutboundEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := outboundendpoints.ParseOutboundEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.OutboundEndpointsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PrivateDnsResolverOutboundEndpointResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "outbound" {
+  name                 = "outbound"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/28"]
+
+  delegation {
+    name = "Microsoft.Network.dnsResolvers"
+    service_delegation {
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+      name    = "Microsoft.Network/dnsResolvers"
+    }
+  }
+}
+`, PrivateDnsResolverResource{}.basic(data))
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_outbound_endpoint" "test" {
+  name                    = "acctest-droe-%d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+  subnet_id               = azurerm_subnet.outbound.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_outbound_endpoint" "import" {
+  name                    = azurerm_private_dns_resolver_outbound_endpoint.test.name
+  private_dns_resolver_id = azurerm_private_dns_resolver_outbound_endpoint.test.private_dns_resolver_id
+  location                = azurerm_private_dns_resolver_outbound_endpoint.test.location
+  subnet_id               = azurerm_private_dns_resolver_outbound_endpoint.test.subnet_id
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_outbound_endpoint" "test" {
+  name                    = "acctest-droe-%d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+  subnet_id               = azurerm_subnet.outbound.id
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
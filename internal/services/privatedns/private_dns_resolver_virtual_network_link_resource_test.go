@@ -0,0 +1,134 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverVirtualNetworkLinkResource struct {
+}
+
+func TestAccPrivateDnsResolverVirtualNetworkLink_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_virtual_network_link", "test")
+	r := PrivateDnsResolverVirtualNetworkLinkResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverVirtualNetworkLink_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_virtual_network_link", "test")
+	r := PrivateDnsResolverVirtualNetworkLinkResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverVirtualNetworkLink_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_virtual_network_link", "test")
+	r := PrivateDnsResolverVirtualNetworkLinkResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateDnsResolverVirtualNetworkLinkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualnetworklinks.ParseVirtualNetworkLinkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.ResolverVirtualNetworkLinksClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PrivateDnsResolverVirtualNetworkLinkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "spoke" {
+  name                = "acctestvnet-spoke-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+`, PrivateDnsResolverDnsForwardingRulesetResource{}.basic(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_virtual_network_link" "test" {
+  name                      = "acctest-drvl-%d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+  virtual_network_id        = azurerm_virtual_network.spoke.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_virtual_network_link" "import" {
+  name                      = azurerm_private_dns_resolver_virtual_network_link.test.name
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_virtual_network_link.test.dns_forwarding_ruleset_id
+  virtual_network_id        = azurerm_private_dns_resolver_virtual_network_link.test.virtual_network_id
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_virtual_network_link" "test" {
+  name                      = "acctest-drvl-%d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+  virtual_network_id        = azurerm_virtual_network.spoke.id
+
+  metadata = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
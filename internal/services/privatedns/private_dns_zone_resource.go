@@ -171,7 +171,7 @@ func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	parameters := privatedns.PrivateZone{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	etag := ""
@@ -190,8 +190,9 @@ func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		soaRecord := expandPrivateDNSZoneSOARecord(soaRecordRaw)
 		rsParameters := privatedns.RecordSet{
 			RecordSetProperties: &privatedns.RecordSetProperties{
-				TTL:       utils.Int64(int64(soaRecordRaw["ttl"].(int))),
-				Metadata:  tags.Expand(soaRecordRaw["tags"].(map[string]interface{})),
+				TTL: utils.Int64(int64(soaRecordRaw["ttl"].(int))),
+				// the metadata of the SOA Record isn't a Resource's Tags, so the Provider's Default Tags don't apply
+				Metadata:  tags.Expand(tags.ProviderConfiguration{}, soaRecordRaw["tags"].(map[string]interface{})),
 				SoaRecord: soaRecord,
 			},
 		}
@@ -249,7 +250,7 @@ func resourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePrivateDnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -297,7 +298,8 @@ func flattenPrivateDNSZoneSOARecord(input *privatedns.RecordSet) []interface{} {
 
 	metaData := make(map[string]interface{})
 	if input.Metadata != nil {
-		metaData = tags.Flatten(input.Metadata)
+		// the metadata of the SOA Record isn't a Resource's Tags, so the Provider's Default/Ignored Tags don't apply
+		metaData = tags.Flatten(tags.ProviderConfiguration{}, input.Metadata)
 	}
 
 	fqdn := ""
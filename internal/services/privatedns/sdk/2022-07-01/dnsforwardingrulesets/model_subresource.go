@@ -0,0 +1,5 @@
+package dnsforwardingrulesets
+
+type SubResource struct {
+	Id string `json:"id"`
+}
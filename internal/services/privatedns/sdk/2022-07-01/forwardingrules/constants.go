@@ -0,0 +1,71 @@
+package forwardingrules
+
+import "strings"
+
+type ForwardingRuleState string
+
+const (
+	ForwardingRuleStateDisabled ForwardingRuleState = "Disabled"
+	ForwardingRuleStateEnabled  ForwardingRuleState = "Enabled"
+)
+
+func PossibleValuesForForwardingRuleState() []string {
+	return []string{
+		string(ForwardingRuleStateDisabled),
+		string(ForwardingRuleStateEnabled),
+	}
+}
+
+func parseForwardingRuleState(input string) (*ForwardingRuleState, error) {
+	vals := map[string]ForwardingRuleState{
+		"disabled": ForwardingRuleStateDisabled,
+		"enabled":  ForwardingRuleStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ForwardingRuleState(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
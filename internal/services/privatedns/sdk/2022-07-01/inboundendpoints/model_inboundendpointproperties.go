@@ -0,0 +1,7 @@
+package inboundendpoints
+
+type InboundEndpointProperties struct {
+	IPConfigurations  []IPConfiguration  `json:"ipConfigurations"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualnetworklinks
+
+type SubResource struct {
+	Id string `json:"id"`
+}
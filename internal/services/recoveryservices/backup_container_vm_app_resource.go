@@ -0,0 +1,198 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	vmParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	vmValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceBackupProtectionContainerVMApp() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionContainerVMAppCreate,
+		Read:   resourceBackupProtectionContainerVMAppRead,
+		Delete: resourceBackupProtectionContainerVMAppDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProtectionContainerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: vmValidate.VirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceBackupProtectionContainerVMAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vmId, err := vmParse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProtectionContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), "Azure", backupProtectionContainerVMAppName(*vmId))
+
+	existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_backup_container_vm_app", id.ID())
+	}
+
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureVMAppContainerProtectionContainer{
+			SourceResourceID:     utils.String(vmId.ID()),
+			FriendlyName:         utils.String(vmId.Name),
+			BackupManagementType: backup.ManagementTypeAzureWorkload,
+			WorkloadType:         backup.WorkloadType(d.Get("workload_type").(string)),
+			ContainerType:        backup.ContainerTypeVMAppContainer1,
+		},
+	}
+
+	resp, err := client.Register(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.Response.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return fmt.Errorf("registering %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if _, err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opStatusClient, id.VaultName, id.ResourceGroup, operationID, d); err != nil {
+		return fmt.Errorf("waiting for registration of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBackupProtectionContainerVMAppRead(d, meta)
+}
+
+func resourceBackupProtectionContainerVMAppRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if properties, ok := resp.Properties.AsAzureVMAppContainerProtectionContainer(); ok && properties != nil {
+		d.Set("virtual_machine_id", properties.SourceResourceID)
+		d.Set("workload_type", string(properties.WorkloadType))
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionContainerVMAppDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Unregister(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.Name)
+	if err != nil {
+		return fmt.Errorf("unregistering %s: %+v", *id, err)
+	}
+
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("unregistering %s: Location header missing or empty", *id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["backupOperationResults"]
+	if _, err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroup, operationID, d); err != nil {
+		return fmt.Errorf("waiting for unregistration of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// backupProtectionContainerVMAppName returns the name Azure Backup assigns to the workload container for a Virtual Machine
+func backupProtectionContainerVMAppName(id vmParse.VirtualMachineId) string {
+	return fmt.Sprintf("VMAppContainer;compute;%s;%s", id.ResourceGroup, id.Name)
+}
@@ -65,5 +65,5 @@ func dataSourceBackupPolicyFileShareRead(d *pluginsdk.ResourceData, meta interfa
 	id := strings.Replace(*protectionPolicy.ID, "Subscriptions", "subscriptions", 1)
 	d.SetId(id)
 
-	return tags.FlattenAndSet(d, protectionPolicy.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, protectionPolicy.Tags)
 }
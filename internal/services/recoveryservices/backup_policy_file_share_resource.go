@@ -318,7 +318,7 @@ func resourceBackupProtectionPolicyFileShareCreateUpdate(d *pluginsdk.ResourceDa
 	}
 
 	policy := backup.ProtectionPolicyResource{
-		Tags:       tags.Expand(t),
+		Tags:       tags.Expand(meta.(*clients.Client).Tags, t),
 		Properties: AzureFileShareProtectionPolicyProperties,
 	}
 
@@ -407,7 +407,7 @@ func resourceBackupProtectionPolicyFileShareRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBackupProtectionPolicyFileShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
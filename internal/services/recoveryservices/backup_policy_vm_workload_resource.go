@@ -0,0 +1,801 @@
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceBackupProtectionPolicyVMWorkload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionPolicyVMWorkloadCreateUpdate,
+		Read:   resourceBackupProtectionPolicyVMWorkloadRead,
+		Update: resourceBackupProtectionPolicyVMWorkloadCreateUpdate,
+		Delete: resourceBackupProtectionPolicyVMWorkloadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackupPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+
+			"settings": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"time_zone": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"compression_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"protection_policy": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"policy_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(backup.PolicyTypeFull),
+								string(backup.PolicyTypeDifferential),
+								string(backup.PolicyTypeLog),
+							}, false),
+						},
+
+						"backup": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"frequency": { // only for Full and Differential
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: suppress.CaseDifference,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(backup.ScheduleRunTypeDaily),
+											string(backup.ScheduleRunTypeWeekly),
+										}, true),
+									},
+
+									"frequency_in_minutes": { // only for Log
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntInSlice([]int{15, 30, 60, 120, 240, 480, 720, 1440}),
+									},
+
+									"time": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringMatch(
+											regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"), // time must be on the hour or half past
+											"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+										),
+									},
+
+									"weekdays": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validation.IsDayOfTheWeek(true),
+										},
+									},
+								},
+							},
+						},
+
+						"simple_retention": { // only for Differential and Log
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(7, 35),
+									},
+								},
+							},
+						},
+
+						"retention_daily": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(7, 9999),
+									},
+								},
+							},
+						},
+
+						"retention_weekly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 5163),
+									},
+
+									"weekdays": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validation.IsDayOfTheWeek(true),
+										},
+									},
+								},
+							},
+						},
+
+						"retention_monthly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1188),
+									},
+
+									"weeks": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(backup.WeekOfMonthFirst),
+												string(backup.WeekOfMonthSecond),
+												string(backup.WeekOfMonthThird),
+												string(backup.WeekOfMonthFourth),
+												string(backup.WeekOfMonthLast),
+											}, true),
+										},
+									},
+
+									"weekdays": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validation.IsDayOfTheWeek(true),
+										},
+									},
+								},
+							},
+						},
+
+						"retention_yearly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 99),
+									},
+
+									"months": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validation.IsMonth(true),
+										},
+									},
+
+									"weeks": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(backup.WeekOfMonthFirst),
+												string(backup.WeekOfMonthSecond),
+												string(backup.WeekOfMonthThird),
+												string(backup.WeekOfMonthFourth),
+												string(backup.WeekOfMonthLast),
+											}, true),
+										},
+									},
+
+									"weekdays": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validation.IsDayOfTheWeek(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceBackupProtectionPolicyVMWorkloadCustomizeDiff),
+	}
+}
+
+// a workload policy is made up of exactly one Full sub policy, optionally accompanied by a Differential
+// and/or a Log sub policy - each of which only supports a subset of the schedule & retention options
+func resourceBackupProtectionPolicyVMWorkloadCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	policies := make(map[string]map[string]interface{})
+	for _, item := range diff.Get("protection_policy").(*pluginsdk.Set).List() {
+		policy := item.(map[string]interface{})
+		policyType := policy["policy_type"].(string)
+		if policyType == "" {
+			continue
+		}
+
+		if _, exists := policies[policyType]; exists {
+			return fmt.Errorf("only one `protection_policy` with a `policy_type` of %q can be specified", policyType)
+		}
+		policies[policyType] = policy
+	}
+
+	if _, hasFull := policies[string(backup.PolicyTypeFull)]; !hasFull {
+		return fmt.Errorf("a `protection_policy` with a `policy_type` of %q must be specified", string(backup.PolicyTypeFull))
+	}
+
+	fullFrequency := ""
+	for policyType, policy := range policies {
+		schedule := map[string]interface{}{}
+		if v := policy["backup"].([]interface{}); len(v) > 0 && v[0] != nil {
+			schedule = v[0].(map[string]interface{})
+		}
+		frequency, _ := schedule["frequency"].(string)
+		frequencyInMinutes, _ := schedule["frequency_in_minutes"].(int)
+		timeOfDay, _ := schedule["time"].(string)
+		weekdays := 0
+		if v, ok := schedule["weekdays"].(*pluginsdk.Set); ok {
+			weekdays = v.Len()
+		}
+
+		hasSimpleRetention := len(policy["simple_retention"].([]interface{})) > 0
+		hasDaily := len(policy["retention_daily"].([]interface{})) > 0
+		hasWeekly := len(policy["retention_weekly"].([]interface{})) > 0
+		hasLongTermRetention := hasDaily || hasWeekly || len(policy["retention_monthly"].([]interface{})) > 0 || len(policy["retention_yearly"].([]interface{})) > 0
+
+		switch policyType {
+		case string(backup.PolicyTypeFull):
+			if frequencyInMinutes != 0 {
+				return fmt.Errorf("`backup.0.frequency_in_minutes` cannot be set when `policy_type` is %q", policyType)
+			}
+			if timeOfDay == "" {
+				return fmt.Errorf("`backup.0.time` must be set when `policy_type` is %q", policyType)
+			}
+			if hasSimpleRetention {
+				return fmt.Errorf("`simple_retention` cannot be set when `policy_type` is %q", policyType)
+			}
+
+			fullFrequency = strings.ToLower(frequency)
+			switch fullFrequency {
+			case "daily":
+				if !hasDaily {
+					return fmt.Errorf("`retention_daily` must be set when `backup.0.frequency` is `Daily`")
+				}
+				if weekdays > 0 {
+					return fmt.Errorf("`backup.0.weekdays` cannot be set when `backup.0.frequency` is `Daily`")
+				}
+			case "weekly":
+				if hasDaily {
+					return fmt.Errorf("`retention_daily` cannot be set when `backup.0.frequency` is `Weekly`")
+				}
+				if !hasWeekly {
+					return fmt.Errorf("`retention_weekly` must be set when `backup.0.frequency` is `Weekly`")
+				}
+				if weekdays == 0 {
+					return fmt.Errorf("`backup.0.weekdays` must be set when `backup.0.frequency` is `Weekly`")
+				}
+			case "":
+				return fmt.Errorf("`backup.0.frequency` must be set when `policy_type` is %q", policyType)
+			}
+
+		case string(backup.PolicyTypeDifferential):
+			if frequencyInMinutes != 0 {
+				return fmt.Errorf("`backup.0.frequency_in_minutes` cannot be set when `policy_type` is %q", policyType)
+			}
+			if !strings.EqualFold(frequency, string(backup.ScheduleRunTypeWeekly)) {
+				return fmt.Errorf("`backup.0.frequency` must be `Weekly` when `policy_type` is %q", policyType)
+			}
+			if timeOfDay == "" || weekdays == 0 {
+				return fmt.Errorf("`backup.0.time` and `backup.0.weekdays` must be set when `policy_type` is %q", policyType)
+			}
+			if !hasSimpleRetention || hasLongTermRetention {
+				return fmt.Errorf("only `simple_retention` can be used as the retention when `policy_type` is %q", policyType)
+			}
+
+		case string(backup.PolicyTypeLog):
+			if frequency != "" || timeOfDay != "" || weekdays > 0 {
+				return fmt.Errorf("only `backup.0.frequency_in_minutes` can be set when `policy_type` is %q", policyType)
+			}
+			if frequencyInMinutes == 0 {
+				return fmt.Errorf("`backup.0.frequency_in_minutes` must be set when `policy_type` is %q", policyType)
+			}
+			if !hasSimpleRetention || hasLongTermRetention {
+				return fmt.Errorf("only `simple_retention` can be used as the retention when `policy_type` is %q", policyType)
+			}
+		}
+	}
+
+	if _, hasDifferential := policies[string(backup.PolicyTypeDifferential)]; hasDifferential && fullFrequency == "daily" {
+		return fmt.Errorf("a `protection_policy` with a `policy_type` of %q cannot be used when the %q backup `frequency` is `Daily`", string(backup.PolicyTypeDifferential), string(backup.PolicyTypeFull))
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionPolicyVMWorkloadCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewBackupPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_backup_policy_vm_workload", id.ID())
+		}
+	}
+
+	subProtectionPolicies, err := expandBackupProtectionPolicyVMWorkloadSubProtectionPolicies(d.Get("protection_policy").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	policy := backup.ProtectionPolicyResource{
+		Properties: &backup.AzureVMWorkloadProtectionPolicy{
+			BackupManagementType: backup.BackupManagementTypeAzureWorkload,
+			WorkLoadType:         backup.WorkloadType(d.Get("workload_type").(string)),
+			Settings:             expandBackupProtectionPolicyVMWorkloadSettings(d.Get("settings").([]interface{})),
+			SubProtectionPolicy:  subProtectionPolicies,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.VaultName, id.ResourceGroup, id.Name, policy); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if _, err := resourceBackupProtectionPolicyVMWaitForUpdate(ctx, client, id.VaultName, id.ResourceGroup, id.Name, d); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBackupProtectionPolicyVMWorkloadRead(d, meta)
+}
+
+func resourceBackupProtectionPolicyVMWorkloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if properties, ok := resp.Properties.AsAzureVMWorkloadProtectionPolicy(); ok && properties != nil {
+		d.Set("workload_type", string(properties.WorkLoadType))
+
+		if err := d.Set("settings", flattenBackupProtectionPolicyVMWorkloadSettings(properties.Settings)); err != nil {
+			return fmt.Errorf("setting `settings`: %+v", err)
+		}
+
+		if err := d.Set("protection_policy", flattenBackupProtectionPolicyVMWorkloadSubProtectionPolicies(properties.SubProtectionPolicy)); err != nil {
+			return fmt.Errorf("setting `protection_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionPolicyVMWorkloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.VaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	if _, err := resourceBackupProtectionPolicyVMWaitForDeletion(ctx, client, id.VaultName, id.ResourceGroup, id.Name, d); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandBackupProtectionPolicyVMWorkloadSettings(input []interface{}) *backup.Settings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	compression := v["compression_enabled"].(bool)
+
+	return &backup.Settings{
+		TimeZone:         utils.String(v["time_zone"].(string)),
+		Issqlcompression: utils.Bool(compression),
+		IsCompression:    utils.Bool(compression),
+	}
+}
+
+func expandBackupProtectionPolicyVMWorkloadSubProtectionPolicies(input []interface{}) (*[]backup.SubProtectionPolicy, error) {
+	results := make([]backup.SubProtectionPolicy, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		policyType := backup.PolicyType(v["policy_type"].(string))
+
+		schedule := map[string]interface{}{}
+		if s := v["backup"].([]interface{}); len(s) > 0 && s[0] != nil {
+			schedule = s[0].(map[string]interface{})
+		}
+
+		subPolicy := backup.SubProtectionPolicy{
+			PolicyType: policyType,
+		}
+
+		if policyType == backup.PolicyTypeLog {
+			subPolicy.SchedulePolicy = &backup.LogSchedulePolicy{
+				SchedulePolicyType:      backup.SchedulePolicyTypeLogSchedulePolicy,
+				ScheduleFrequencyInMins: utils.Int32(int32(schedule["frequency_in_minutes"].(int))),
+			}
+			subPolicy.RetentionPolicy = expandBackupProtectionPolicyVMWorkloadSimpleRetention(v["simple_retention"].([]interface{}))
+			results = append(results, subPolicy)
+			continue
+		}
+
+		// the schedule & retention times for Full and Differential backups must all match the time of day of the schedule
+		timeOfDay := schedule["time"].(string)
+		dateOfDay, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", timeOfDay))
+		if err != nil {
+			return nil, fmt.Errorf("generating time from %q for %q protection policy: %+v", timeOfDay, string(policyType), err)
+		}
+		times := []date.Time{{Time: dateOfDay}}
+
+		simpleSchedule := &backup.SimpleSchedulePolicy{
+			SchedulePolicyType:   backup.SchedulePolicyTypeSimpleSchedulePolicy,
+			ScheduleRunFrequency: backup.ScheduleRunType(schedule["frequency"].(string)),
+			ScheduleRunTimes:     &times,
+		}
+		if weekdays, ok := schedule["weekdays"].(*pluginsdk.Set); ok && weekdays.Len() > 0 {
+			days := make([]backup.DayOfWeek, 0)
+			for _, day := range weekdays.List() {
+				days = append(days, backup.DayOfWeek(day.(string)))
+			}
+			simpleSchedule.ScheduleRunDays = &days
+		}
+		subPolicy.SchedulePolicy = simpleSchedule
+
+		if policyType == backup.PolicyTypeDifferential {
+			subPolicy.RetentionPolicy = expandBackupProtectionPolicyVMWorkloadSimpleRetention(v["simple_retention"].([]interface{}))
+		} else {
+			subPolicy.RetentionPolicy = expandBackupProtectionPolicyVMWorkloadLongTermRetention(v, times)
+		}
+
+		results = append(results, subPolicy)
+	}
+
+	return &results, nil
+}
+
+func expandBackupProtectionPolicyVMWorkloadSimpleRetention(input []interface{}) *backup.SimpleRetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &backup.SimpleRetentionPolicy{
+		RetentionPolicyType: backup.RetentionPolicyTypeSimpleRetentionPolicy,
+		RetentionDuration: &backup.RetentionDuration{
+			Count:        utils.Int32(int32(v["count"].(int))),
+			DurationType: backup.RetentionDurationTypeDays,
+		},
+	}
+}
+
+func expandBackupProtectionPolicyVMWorkloadLongTermRetention(input map[string]interface{}, times []date.Time) *backup.LongTermRetentionPolicy {
+	retention := &backup.LongTermRetentionPolicy{
+		RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
+	}
+
+	if rb := input["retention_daily"].([]interface{}); len(rb) > 0 && rb[0] != nil {
+		block := rb[0].(map[string]interface{})
+		retention.DailySchedule = &backup.DailyRetentionSchedule{
+			RetentionTimes: &times,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: backup.RetentionDurationTypeDays,
+			},
+		}
+	}
+
+	if rb := input["retention_weekly"].([]interface{}); len(rb) > 0 && rb[0] != nil {
+		block := rb[0].(map[string]interface{})
+		weekly := expandBackupProtectionPolicyVMRetentionWeeklyFormat(block)
+		retention.WeeklySchedule = &backup.WeeklyRetentionSchedule{
+			DaysOfTheWeek:  weekly.DaysOfTheWeek,
+			RetentionTimes: &times,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: backup.RetentionDurationTypeWeeks,
+			},
+		}
+	}
+
+	if rb := input["retention_monthly"].([]interface{}); len(rb) > 0 && rb[0] != nil {
+		block := rb[0].(map[string]interface{})
+		retention.MonthlySchedule = &backup.MonthlyRetentionSchedule{
+			RetentionScheduleFormatType: backup.RetentionScheduleFormatWeekly,
+			RetentionScheduleWeekly:     expandBackupProtectionPolicyVMRetentionWeeklyFormat(block),
+			RetentionTimes:              &times,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: backup.RetentionDurationTypeMonths,
+			},
+		}
+	}
+
+	if rb := input["retention_yearly"].([]interface{}); len(rb) > 0 && rb[0] != nil {
+		block := rb[0].(map[string]interface{})
+		yearly := &backup.YearlyRetentionSchedule{
+			RetentionScheduleFormatType: backup.RetentionScheduleFormatWeekly,
+			RetentionScheduleWeekly:     expandBackupProtectionPolicyVMRetentionWeeklyFormat(block),
+			RetentionTimes:              &times,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: backup.RetentionDurationTypeYears,
+			},
+		}
+
+		if v, ok := block["months"].(*pluginsdk.Set); ok {
+			months := make([]backup.MonthOfYear, 0)
+			for _, month := range v.List() {
+				months = append(months, backup.MonthOfYear(month.(string)))
+			}
+			yearly.MonthsOfYear = &months
+		}
+
+		retention.YearlySchedule = yearly
+	}
+
+	return retention
+}
+
+func flattenBackupProtectionPolicyVMWorkloadSettings(input *backup.Settings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	timeZone := ""
+	if input.TimeZone != nil {
+		timeZone = *input.TimeZone
+	}
+
+	compression := false
+	if input.IsCompression != nil {
+		compression = *input.IsCompression
+	} else if input.Issqlcompression != nil {
+		compression = *input.Issqlcompression
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"time_zone":           timeZone,
+			"compression_enabled": compression,
+		},
+	}
+}
+
+func flattenBackupProtectionPolicyVMWorkloadSubProtectionPolicies(input *[]backup.SubProtectionPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		policy := map[string]interface{}{
+			"policy_type":       string(item.PolicyType),
+			"backup":            []interface{}{},
+			"simple_retention":  []interface{}{},
+			"retention_daily":   []interface{}{},
+			"retention_weekly":  []interface{}{},
+			"retention_monthly": []interface{}{},
+			"retention_yearly":  []interface{}{},
+		}
+
+		if item.SchedulePolicy != nil {
+			if schedule, ok := item.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
+				policy["backup"] = flattenBackupProtectionPolicyVMSchedule(schedule)
+			}
+
+			if schedule, ok := item.SchedulePolicy.AsLogSchedulePolicy(); ok && schedule != nil {
+				frequencyInMinutes := 0
+				if schedule.ScheduleFrequencyInMins != nil {
+					frequencyInMinutes = int(*schedule.ScheduleFrequencyInMins)
+				}
+				policy["backup"] = []interface{}{
+					map[string]interface{}{
+						"frequency_in_minutes": frequencyInMinutes,
+					},
+				}
+			}
+		}
+
+		if item.RetentionPolicy != nil {
+			if retention, ok := item.RetentionPolicy.AsSimpleRetentionPolicy(); ok && retention != nil {
+				if duration := retention.RetentionDuration; duration != nil && duration.Count != nil {
+					policy["simple_retention"] = []interface{}{
+						map[string]interface{}{
+							"count": int(*duration.Count),
+						},
+					}
+				}
+			}
+
+			if retention, ok := item.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
+				if s := retention.DailySchedule; s != nil {
+					policy["retention_daily"] = normaliseBackupProtectionPolicyVMWorkloadRetentionCount(flattenBackupProtectionPolicyVMRetentionDaily(s))
+				}
+				if s := retention.WeeklySchedule; s != nil {
+					policy["retention_weekly"] = normaliseBackupProtectionPolicyVMWorkloadRetentionCount(flattenBackupProtectionPolicyVMRetentionWeekly(s))
+				}
+				if s := retention.MonthlySchedule; s != nil {
+					policy["retention_monthly"] = normaliseBackupProtectionPolicyVMWorkloadRetentionCount(flattenBackupProtectionPolicyVMRetentionMonthly(s))
+				}
+				if s := retention.YearlySchedule; s != nil {
+					policy["retention_yearly"] = normaliseBackupProtectionPolicyVMWorkloadRetentionCount(flattenBackupProtectionPolicyVMRetentionYearly(s))
+				}
+			}
+		}
+
+		results = append(results, policy)
+	}
+
+	return results
+}
+
+// the VM policy flatten functions return the retention `count` as an int32, which can't be hashed within a Set
+func normaliseBackupProtectionPolicyVMWorkloadRetentionCount(input []interface{}) []interface{} {
+	for _, item := range input {
+		block := item.(map[string]interface{})
+		if v, ok := block["count"].(int32); ok {
+			block["count"] = int(v)
+		}
+	}
+
+	return input
+}
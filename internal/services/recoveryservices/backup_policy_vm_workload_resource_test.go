@@ -0,0 +1,383 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectionPolicyVMWorkloadResource struct {
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protection_policy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protection_policy.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_differentialWithDailyFull(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.differentialWithDailyFull(data),
+			ExpectError: regexp.MustCompile("cannot be used when the \"Full\" backup `frequency` is `Daily`"),
+		},
+	})
+}
+
+func TestAccBackupProtectionPolicyVMWorkload_missingFull(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm_workload", "test")
+	r := BackupProtectionPolicyVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.missingFull(data),
+			ExpectError: regexp.MustCompile("a `protection_policy` with a `policy_type` of \"Full\" must be specified"),
+		},
+	})
+}
+
+func (t BackupProtectionPolicyVMWorkloadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackupPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectionPoliciesClient.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (BackupProtectionPolicyVMWorkloadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-backup-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  soft_delete_enabled = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r BackupProtectionPolicyVMWorkloadResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r BackupProtectionPolicyVMWorkloadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "import" {
+  name                = azurerm_backup_policy_vm_workload.test.name
+  resource_group_name = azurerm_backup_policy_vm_workload.test.resource_group_name
+  recovery_vault_name = azurerm_backup_policy_vm_workload.test.recovery_vault_name
+  workload_type       = azurerm_backup_policy_vm_workload.test.workload_type
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r BackupProtectionPolicyVMWorkloadResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone           = "UTC"
+    compression_enabled = true
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Weekly"
+      time      = "15:00"
+      weekdays  = ["Sunday"]
+    }
+
+    retention_weekly {
+      count    = 4
+      weekdays = ["Sunday"]
+    }
+
+    retention_monthly {
+      count    = 12
+      weekdays = ["Sunday"]
+      weeks    = ["First"]
+    }
+
+    retention_yearly {
+      count    = 2
+      weekdays = ["Sunday"]
+      weeks    = ["First"]
+      months   = ["January"]
+    }
+  }
+
+  protection_policy {
+    policy_type = "Differential"
+
+    backup {
+      frequency = "Weekly"
+      time      = "18:00"
+      weekdays  = ["Wednesday", "Friday"]
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 60
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r BackupProtectionPolicyVMWorkloadResource) differentialWithDailyFull(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Differential"
+
+    backup {
+      frequency = "Weekly"
+      time      = "18:00"
+      weekdays  = ["Wednesday"]
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r BackupProtectionPolicyVMWorkloadResource) missingFull(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -121,12 +121,25 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *pluginsdk.Resource
 		}
 
 		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			if !isProtectedItemSoftDeleted(existing) {
+				return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			}
+
+			// the Protected VM exists in the soft delete state, does the user want us to recover it?
+			if !meta.(*clients.Client).Features.Services.Bool(featuresBlockName, featureRecoverSoftDeletedBackupProtectedVM) {
+				return fmt.Errorf(optedOutOfRecoveringSoftDeletedBackupProtectedVMErrorFmt(protectedItemName, vaultName, resourceGroup))
+			}
+
+			log.Printf("[DEBUG] Recovering Soft-Deleted Azure Backup Protected VM %q (Resource Group %q)..", protectedItemName, resourceGroup)
+			if err := recoverSoftDeletedProtectedItem(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, existing); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Recovered Soft-Deleted Azure Backup Protected VM %q (Resource Group %q).", protectedItemName, resourceGroup)
 		}
 	}
 
 	item := backup.ProtectedItemResource{
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 		Properties: &backup.AzureIaaSComputeVMProtectedItem{
 			PolicyID:           &policyId,
 			ProtectedItemType:  backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
@@ -200,7 +213,7 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceRecoveryServicesBackupProtectedVMDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -335,3 +348,20 @@ func expandDiskLunList(input []interface{}) []interface{} {
 	}
 	return result
 }
+
+func optedOutOfRecoveringSoftDeletedBackupProtectedVMErrorFmt(name, vaultName, resourceGroup string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted Azure Backup Protected VM exists with the Name %q in the Recovery
+Services Vault %q (Resource Group %q), however automatically recovering this Protected VM
+has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted Protected VM when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import".
+`, name, vaultName, resourceGroup)
+}
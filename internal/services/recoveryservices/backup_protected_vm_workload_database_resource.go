@@ -0,0 +1,359 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	vmParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	vmValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceBackupProtectedVMWorkloadDatabase() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectedVMWorkloadDatabaseCreate,
+		Read:   resourceBackupProtectedVMWorkloadDatabaseRead,
+		Update: resourceBackupProtectedVMWorkloadDatabaseUpdate,
+		Delete: resourceBackupProtectedVMWorkloadDatabaseDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProtectedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(80 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_vm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: vmValidate.VirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+
+			// the SQL Server instance or SAP HANA system which hosts the database
+			"instance_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"backup_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BackupPolicyID,
+			},
+		},
+	}
+}
+
+func resourceBackupProtectedVMWorkloadDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	protectableClient := meta.(*clients.Client).RecoveryServices.ProtectableItemsClient
+	protectionContainerClient := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opResultClient := meta.(*clients.Client).RecoveryServices.ProtectionContainerOperationResultsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	workloadType := d.Get("workload_type").(string)
+	instanceName := d.Get("instance_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	vmId, err := vmParse.VirtualMachineID(d.Get("source_vm_id").(string))
+	if err != nil {
+		return err
+	}
+	containerName := backupProtectionContainerVMAppName(*vmId)
+
+	// databases created after the Virtual Machine was registered with the vault are only discovered once an
+	// inquiry has been run against the container, which is a long running operation tracked via the Location header
+	filter := fmt.Sprintf("workloadType eq '%s'", workloadType)
+	inquiry, err := protectionContainerClient.Inquire(ctx, vaultName, resourceGroup, "Azure", containerName, filter)
+	if err != nil {
+		return fmt.Errorf("inquiring workloads in Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	locationURL, err := inquiry.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("inquiring workloads in Protection Container %q (Vault %q): Location header missing or empty", containerName, vaultName)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"202"},
+		Target:     []string{"200", "204"},
+		Refresh:    protectionContainerOperationResultsRefreshFunc(ctx, opResultClient, vaultName, resourceGroup, containerName, operationID),
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	if _, err := state.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for inquiry of Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	// the protected item name is the system name of the protectable item, which is only known to Azure Backup
+	protectedItemName := ""
+	iterator, err := protectableClient.ListComplete(ctx, vaultName, resourceGroup, "backupManagementType eq 'AzureWorkload'", "")
+	if err != nil {
+		return fmt.Errorf("listing protectable items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.ID != nil && item.Name != nil && item.Properties != nil && strings.Contains(strings.ToLower(*item.ID), strings.ToLower(fmt.Sprintf("/protectionContainers/%s/", containerName))) {
+			if friendlyName, parentName, ok := backupProtectableWorkloadDatabaseNames(item.Properties, workloadType); ok && strings.EqualFold(friendlyName, databaseName) && strings.EqualFold(parentName, instanceName) {
+				protectedItemName = *item.Name
+				break
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing protectable items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+	}
+
+	// a database which is already protected is no longer listed as protectable - so we construct its system name
+	if protectedItemName == "" {
+		protectedItemName = fmt.Sprintf("%s;%s;%s", workloadType, instanceName, databaseName)
+	}
+
+	id := parse.NewProtectedItemID(subscriptionId, resourceGroup, vaultName, "Azure", containerName, protectedItemName)
+
+	existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_backup_protected_vm_workload_database", id.ID())
+	}
+
+	if err := resourceBackupProtectedVMWorkloadDatabaseCreateOrUpdate(d, meta, id); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBackupProtectedVMWorkloadDatabaseRead(d, meta)
+}
+
+func resourceBackupProtectedVMWorkloadDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.ProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := resourceBackupProtectedVMWorkloadDatabaseCreateOrUpdate(d, meta, *id); err != nil {
+		return err
+	}
+
+	return resourceBackupProtectedVMWorkloadDatabaseRead(d, meta)
+}
+
+func resourceBackupProtectedVMWorkloadDatabaseCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}, id parse.ProtectedItemId) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId := d.Get("backup_policy_id").(string)
+	sourceVmId := d.Get("source_vm_id").(string)
+
+	item := backup.ProtectedItemResource{}
+	switch d.Get("workload_type").(string) {
+	case string(backup.WorkloadTypeSAPHanaDatabase):
+		item.Properties = &backup.AzureVMWorkloadSAPHanaDatabaseProtectedItem{
+			PolicyID:          utils.String(policyId),
+			SourceResourceID:  utils.String(sourceVmId),
+			WorkloadType:      backup.DataSourceTypeSAPHanaDatabase,
+			ProtectedItemType: backup.ProtectedItemTypeAzureVMWorkloadSAPHanaDatabase,
+		}
+	default:
+		item.Properties = &backup.AzureVMWorkloadSQLDatabaseProtectedItem{
+			PolicyID:          utils.String(policyId),
+			SourceResourceID:  utils.String(sourceVmId),
+			WorkloadType:      backup.DataSourceTypeSQLDataBase,
+			ProtectedItemType: backup.ProtectedItemTypeAzureVMWorkloadSQLDatabase,
+		}
+	}
+
+	resp, err := client.CreateOrUpdate(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, item)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("creating/updating %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroup, operationID, d); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func resourceBackupProtectedVMWorkloadDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if properties := resp.Properties; properties != nil {
+		var friendlyName, parentName, sourceResourceId, policyId *string
+		var workloadType backup.DataSourceType
+
+		if item, ok := properties.AsAzureVMWorkloadSQLDatabaseProtectedItem(); ok && item != nil {
+			friendlyName, parentName, sourceResourceId, policyId, workloadType = item.FriendlyName, item.ParentName, item.SourceResourceID, item.PolicyID, item.WorkloadType
+		} else if item, ok := properties.AsAzureVMWorkloadSAPHanaDatabaseProtectedItem(); ok && item != nil {
+			friendlyName, parentName, sourceResourceId, policyId, workloadType = item.FriendlyName, item.ParentName, item.SourceResourceID, item.PolicyID, item.WorkloadType
+		} else {
+			return fmt.Errorf("retrieving %s: expected a SQL Server or SAP HANA database protected item", *id)
+		}
+
+		d.Set("database_name", friendlyName)
+		d.Set("instance_name", parentName)
+		d.Set("source_vm_id", sourceResourceId)
+		d.Set("workload_type", string(workloadType))
+
+		backupPolicyId := ""
+		if policyId != nil {
+			backupPolicyId = handleAzureSdkForGoBug2824(*policyId)
+		}
+		d.Set("backup_policy_id", backupPolicyId)
+	}
+
+	return nil
+}
+
+func resourceBackupProtectedVMWorkloadDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("deleting %s: Location header missing or empty", *id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["backupOperationResults"] // This is different for create and delete requests ¯\_(ツ)_/¯
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroup, operationID, d); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// backupProtectableWorkloadDatabaseNames returns the database and instance names of a protectable SQL Server or SAP HANA database
+func backupProtectableWorkloadDatabaseNames(input backup.BasicWorkloadProtectableItem, workloadType string) (friendlyName string, parentName string, ok bool) {
+	switch workloadType {
+	case string(backup.WorkloadTypeSQLDataBase):
+		if item, isSql := input.AsAzureVMWorkloadSQLDatabaseProtectableItem(); isSql && item != nil && item.FriendlyName != nil && item.ParentName != nil {
+			return *item.FriendlyName, *item.ParentName, true
+		}
+	case string(backup.WorkloadTypeSAPHanaDatabase):
+		if item, isHana := input.AsAzureVMWorkloadSAPHanaDatabaseProtectableItem(); isHana && item != nil && item.FriendlyName != nil && item.ParentName != nil {
+			return *item.FriendlyName, *item.ParentName, true
+		}
+	}
+
+	return "", "", false
+}
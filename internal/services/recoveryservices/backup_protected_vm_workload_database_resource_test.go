@@ -0,0 +1,199 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectedVMWorkloadDatabaseResource struct {
+}
+
+func TestAccBackupProtectedVMWorkloadDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload_database", "test")
+	r := BackupProtectedVMWorkloadDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("database_name").HasValue("model"),
+				check.That(data.ResourceName).Key("instance_name").HasValue("MSSQLSERVER"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// vault cannot be deleted unless we unregister all backups
+			Config: r.base(data),
+		},
+	})
+}
+
+func TestAccBackupProtectedVMWorkloadDatabase_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload_database", "test")
+	r := BackupProtectedVMWorkloadDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+		{
+			// vault cannot be deleted unless we unregister all backups
+			Config: r.base(data),
+		},
+	})
+}
+
+func TestAccBackupProtectedVMWorkloadDatabase_updateBackupPolicyId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload_database", "test")
+	r := BackupProtectedVMWorkloadDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				acceptance.TestCheckResourceAttrPair(data.ResourceName, "backup_policy_id", "azurerm_backup_policy_vm_workload.test1", "id"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updatePolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				acceptance.TestCheckResourceAttrPair(data.ResourceName, "backup_policy_id", "azurerm_backup_policy_vm_workload.test2", "id"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// remove the protected item before the associated policies are deleted
+			Config: r.base(data),
+		},
+	})
+}
+
+func (t BackupProtectedVMWorkloadDatabaseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ProtectedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectedItemsClient.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (BackupProtectedVMWorkloadDatabaseResource) base(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test1" {
+  name                = "acctest-%[2]d-1"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+}
+
+resource "azurerm_backup_policy_vm_workload" "test2" {
+  name                = "acctest-%[2]d-2"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "18:00"
+    }
+
+    retention_daily {
+      count = 10
+    }
+  }
+}
+`, BackupProtectionContainerVMAppResource{}.basic(data), data.RandomInteger)
+}
+
+func (r BackupProtectedVMWorkloadDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload_database" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_vm_id        = azurerm_backup_container_vm_app.test.virtual_machine_id
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = azurerm_backup_policy_vm_workload.test1.id
+}
+`, r.base(data))
+}
+
+func (r BackupProtectedVMWorkloadDatabaseResource) updatePolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload_database" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_vm_id        = azurerm_backup_container_vm_app.test.virtual_machine_id
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = azurerm_backup_policy_vm_workload.test2.id
+}
+`, r.base(data))
+}
+
+func (r BackupProtectedVMWorkloadDatabaseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload_database" "import" {
+  resource_group_name = azurerm_backup_protected_vm_workload_database.test.resource_group_name
+  recovery_vault_name = azurerm_backup_protected_vm_workload_database.test.recovery_vault_name
+  source_vm_id        = azurerm_backup_protected_vm_workload_database.test.source_vm_id
+  workload_type       = azurerm_backup_protected_vm_workload_database.test.workload_type
+  instance_name       = azurerm_backup_protected_vm_workload_database.test.instance_name
+  database_name       = azurerm_backup_protected_vm_workload_database.test.database_name
+  backup_policy_id    = azurerm_backup_protected_vm_workload_database.test.backup_policy_id
+}
+`, r.basic(data))
+}
@@ -1,10 +1,120 @@
 package recoveryservices
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 // This code is a workaround for this bug https://github.com/Azure/azure-sdk-for-go/issues/2824
 func handleAzureSdkForGoBug2824(id string) string {
 	return strings.Replace(id, "/Subscriptions/", "/subscriptions/", 1)
 }
+
+// isProtectedItemSoftDeleted returns whether the specified Protected Item is in the soft deleted state
+func isProtectedItemSoftDeleted(input backup.ProtectedItemResource) bool {
+	if input.Properties == nil {
+		return false
+	}
+
+	if vm, ok := input.Properties.AsAzureIaaSComputeVMProtectedItem(); ok {
+		return vm.IsScheduledForDeferredDelete != nil && *vm.IsScheduledForDeferredDelete
+	}
+
+	if item, ok := input.Properties.AsProtectedItem(); ok {
+		return item.IsScheduledForDeferredDelete != nil && *item.IsScheduledForDeferredDelete
+	}
+
+	return false
+}
+
+// recoverSoftDeletedProtectedItem moves a soft deleted Azure IaaS VM Protected Item back into the
+// `ProtectionStopped` state, from which protection can be resumed or the item permanently deleted
+func recoverSoftDeletedProtectedItem(ctx context.Context, client *backup.ProtectedItemsClient, vaultName, resourceGroup, containerName, protectedItemName string, existing backup.ProtectedItemResource) error {
+	vm, ok := existing.Properties.AsAzureIaaSComputeVMProtectedItem()
+	if !ok {
+		return fmt.Errorf("recovering Soft-Deleted Protected Item %q (Vault %q / Resource Group %q): only Azure IaaS VM Protected Items are supported", protectedItemName, vaultName, resourceGroup)
+	}
+
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureIaaSComputeVMProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
+			WorkloadType:      backup.DataSourceTypeVM,
+			SourceResourceID:  vm.SourceResourceID,
+			VirtualMachineID:  vm.VirtualMachineID,
+			PolicyID:          vm.PolicyID,
+			ProtectionState:   backup.ProtectionStateProtectionStopped,
+			IsRehydrate:       utils.Bool(true),
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("recovering Soft-Deleted Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"SoftDeleted"},
+		Target:     []string{"Recovered"},
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+			}
+
+			if isProtectedItemSoftDeleted(resp) {
+				return resp, "SoftDeleted", nil
+			}
+
+			return resp, "Recovered", nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the recovery of Soft-Deleted Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// waitForProtectedItemDeletion waits for the specified Protected Item to be gone from the Recovery Services Vault
+func waitForProtectedItemDeletion(ctx context.Context, client *backup.ProtectedItemsClient, id parse.ProtectedItemId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Found"},
+		Target:     []string{"NotFound"},
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			return resp, "Found", nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -68,5 +68,5 @@ func dataSourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interfa
 	}
 	d.Set("sku", skuName)
 
-	return tags.FlattenAndSet(d, vault.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, vault.Tags)
 }
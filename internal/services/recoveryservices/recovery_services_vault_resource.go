@@ -1,6 +1,7 @@
 package recoveryservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -129,7 +131,7 @@ func resourceRecoveryServicesVaultCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	vault := recoveryservices.Vault{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		Identity: expandValutIdentity(d.Get("identity").([]interface{})),
 		Sku: &recoveryservices.Sku{
 			Name: recoveryservices.SkuName(d.Get("sku").(string)),
@@ -232,7 +234,7 @@ func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceRecoveryServicesVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -245,6 +247,14 @@ func resourceRecoveryServicesVaultDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	if meta.(*clients.Client).Features.Services.Bool(featuresBlockName, featurePurgeProtectedItemsFromVaultOnDestroy) {
+		log.Printf("[DEBUG] Purging Protected Items from Recovery Service %s..", id.String())
+		if err := purgeProtectedItemsFromVault(ctx, meta.(*clients.Client).RecoveryServices, *id); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Purged Protected Items from Recovery Service %s.", id.String())
+	}
+
 	log.Printf("[DEBUG] Deleting Recovery Service  %s", id.String())
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
@@ -291,3 +301,58 @@ func flattenVaultIdentity(input *recoveryservices.IdentityData) []interface{} {
 		},
 	}
 }
+
+// purgeProtectedItemsFromVault permanently deletes all of the Protected Items within the Recovery Services Vault
+// (recovering any which are Soft-Deleted first), since a Vault containing Protected Items can't be deleted
+func purgeProtectedItemsFromVault(ctx context.Context, recoveryServicesClient *client.Client, id parse.VaultId) error {
+	// disable soft delete so that the Protected Items are deleted immediately, rather than being soft-deleted - this isn't
+	// re-enabled since the Vault is deleted afterwards, as such this remains disabled should the deletion of the Vault fail
+	cfg := backup.ResourceVaultConfigResource{
+		Properties: &backup.ResourceVaultConfig{
+			EnhancedSecurityState:  backup.EnhancedSecurityStateEnabled,
+			SoftDeleteFeatureState: backup.SoftDeleteFeatureStateDisabled,
+		},
+	}
+	if _, err := recoveryServicesClient.VaultsConfigsClient.Update(ctx, id.Name, id.ResourceGroup, cfg); err != nil {
+		return fmt.Errorf("disabling Soft Delete for Recovery Service %s: %+v", id.String(), err)
+	}
+
+	items, err := recoveryServicesClient.ProtectedItemsGroupClient.ListComplete(ctx, id.Name, id.ResourceGroup, "", "")
+	if err != nil {
+		return fmt.Errorf("listing Protected Items within Recovery Service %s: %+v", id.String(), err)
+	}
+
+	for items.NotDone() {
+		item := items.Value()
+		if item.ID == nil {
+			return fmt.Errorf("listing Protected Items within Recovery Service %s: `id` was nil", id.String())
+		}
+
+		itemId, err := parse.ProtectedItemID(handleAzureSdkForGoBug2824(*item.ID))
+		if err != nil {
+			return err
+		}
+
+		if isProtectedItemSoftDeleted(item) {
+			if err := recoverSoftDeletedProtectedItem(ctx, recoveryServicesClient.ProtectedItemsClient, itemId.VaultName, itemId.ResourceGroup, itemId.ProtectionContainerName, itemId.Name, item); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", itemId)
+		resp, err := recoveryServicesClient.ProtectedItemsClient.Delete(ctx, itemId.VaultName, itemId.ResourceGroup, itemId.BackupFabricName, itemId.ProtectionContainerName, itemId.Name)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", itemId, err)
+		}
+
+		if err := waitForProtectedItemDeletion(ctx, recoveryServicesClient.ProtectedItemsClient, *itemId); err != nil {
+			return err
+		}
+
+		if err := items.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Protected Items within Recovery Service %s: %+v", id.String(), err)
+		}
+	}
+
+	return nil
+}
@@ -1,11 +1,21 @@
 package recoveryservices
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var _ sdk.ServiceRegistrationWithFeatureFlags = Registration{}
+
+const (
+	featuresBlockName                            = "recovery_service"
+	featureRecoverSoftDeletedBackupProtectedVM   = "recover_soft_deleted_backup_protected_vm"
+	featurePurgeProtectedItemsFromVaultOnDestroy = "purge_protected_items_from_vault_on_destroy"
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Recovery Services"
@@ -32,10 +42,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_backup_container_storage_account":           resourceBackupProtectionContainerStorageAccount(),
+		"azurerm_backup_container_vm_app":                    resourceBackupProtectionContainerVMApp(),
 		"azurerm_backup_policy_file_share":                   resourceBackupProtectionPolicyFileShare(),
 		"azurerm_backup_protected_file_share":                resourceBackupProtectedFileShare(),
 		"azurerm_backup_protected_vm":                        resourceRecoveryServicesBackupProtectedVM(),
 		"azurerm_backup_policy_vm":                           resourceBackupProtectionPolicyVM(),
+		"azurerm_backup_policy_vm_workload":                  resourceBackupProtectionPolicyVMWorkload(),
+		"azurerm_backup_protected_vm_workload_database":      resourceBackupProtectedVMWorkloadDatabase(),
 		"azurerm_recovery_services_vault":                    resourceRecoveryServicesVault(),
 		"azurerm_site_recovery_fabric":                       resourceSiteRecoveryFabric(),
 		"azurerm_site_recovery_network_mapping":              resourceSiteRecoveryNetworkMapping(),
@@ -45,3 +58,23 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_site_recovery_replication_policy":           resourceSiteRecoveryReplicationPolicy(),
 	}
 }
+
+// FeatureFlags returns the Feature Flags exposed by this Service within the `features` block
+func (r Registration) FeatureFlags() map[string][]features.FeatureFlag {
+	return map[string][]features.FeatureFlag{
+		featuresBlockName: {
+			{
+				Name:        featureRecoverSoftDeletedBackupProtectedVM,
+				Type:        pluginsdk.TypeBool,
+				Default:     true,
+				Description: "Should the `azurerm_backup_protected_vm` resources recover a Soft-Deleted Protected VM for the same Virtual Machine?",
+			},
+			{
+				Name:        featurePurgeProtectedItemsFromVaultOnDestroy,
+				Type:        pluginsdk.TypeBool,
+				Default:     false,
+				Description: "Should the `azurerm_recovery_services_vault` resources permanently delete all Protected Items (including Soft-Deleted ones) within the Vault when destroyed? This disables Soft Delete on the Vault.",
+			},
+		},
+	}
+}
@@ -135,7 +135,7 @@ func resourceRedisEnterpriseClusterCreate(d *pluginsdk.ResourceData, meta interf
 		ClusterProperties: &redisenterprise.ClusterProperties{
 			MinimumTLSVersion: redisenterprise.TLSVersion(d.Get("minimum_tls_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("zones"); ok {
@@ -212,7 +212,7 @@ func resourceRedisEnterpriseClusterRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("minimum_tls_version", string(props.MinimumTLSVersion))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceRedisEnterpriseClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -227,7 +227,7 @@ func resourceRedisEnterpriseClusterUpdate(d *pluginsdk.ResourceData, meta interf
 	}
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	parameters := redisenterprise.ClusterUpdate{
 		Tags: expandedTags,
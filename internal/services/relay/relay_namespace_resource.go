@@ -121,7 +121,7 @@ func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			Tier: &skuTier,
 		},
 		Properties: &namespaces.RelayNamespaceProperties{},
-		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
@@ -172,7 +172,7 @@ func resourceRelayNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("metric_id", props.MetricId)
 		}
 
-		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
 			return err
 		}
 	}
@@ -135,7 +135,7 @@ func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData,
 			DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
 			Mode:         resources.DeploymentModeIncremental,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if templateRaw, ok := d.GetOk("template_content"); ok {
@@ -246,7 +246,7 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 	}
 
 	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		deployment.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Management Group Template Deployment %q..", id.DeploymentName)
@@ -331,7 +331,7 @@ func managementGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, me
 	}
 	d.Set("template_content", flattenedTemplate)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func managementGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
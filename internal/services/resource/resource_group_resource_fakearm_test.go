@@ -0,0 +1,62 @@
+//go:build fakearm
+// +build fakearm
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+)
+
+func TestResourceGroupFakeARM_lifecycle(t *testing.T) {
+	f := acceptance.NewFakeARMProvider(t)
+	resourceType := "azurerm_resource_group"
+	id := "/subscriptions/" + fakearm.SubscriptionId + "/resourceGroups/example"
+
+	config := map[string]interface{}{
+		"name":     "example",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	}
+
+	state := f.Apply(t, resourceType, nil, config)
+	if state.ID != id {
+		t.Fatalf("expected the ID %q but got %q", id, state.ID)
+	}
+	if _, ok := f.Server.Resource(id); !ok {
+		t.Fatalf("expected the Resource Group to exist in the fake ARM Server")
+	}
+
+	if diff := f.Plan(t, resourceType, state, config); diff != nil && !diff.Empty() {
+		t.Fatalf("expected no changes after applying but got %+v", diff)
+	}
+
+	config["tags"] = map[string]interface{}{
+		"env": "production",
+	}
+	diff := f.Plan(t, resourceType, state, config)
+	if diff == nil || diff.RequiresNew() {
+		t.Fatalf("expected an in-place update when changing the tags but got %+v", diff)
+	}
+	state = f.Apply(t, resourceType, state, config)
+	if v := state.Attributes["tags.env"]; v != "production" {
+		t.Fatalf("expected the tag `env` to be `production` but got %q", v)
+	}
+
+	imported := f.Import(t, resourceType, id)
+	if imported.Attributes["location"] != "westeurope" || imported.Attributes["tags.env"] != "production" {
+		t.Fatalf("expected the imported state to match but got %+v", imported.Attributes)
+	}
+
+	f.Destroy(t, resourceType, state)
+	if _, ok := f.Server.Resource(id); ok {
+		t.Fatalf("expected the Resource Group to have been deleted from the fake ARM Server")
+	}
+	if refreshed := f.Read(t, resourceType, state); refreshed != nil {
+		t.Fatalf("expected the Resource Group to have been removed from the state but got %+v", refreshed)
+	}
+}
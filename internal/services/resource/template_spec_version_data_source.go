@@ -77,5 +77,5 @@ func dataSourceTemplateSpecVersionRead(d *pluginsdk.ResourceData, meta interface
 
 	d.SetId(id.ID())
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -198,7 +198,7 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 			},
 		},
 		Identity: expandSearchServiceIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -284,7 +284,7 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("setting `identity`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
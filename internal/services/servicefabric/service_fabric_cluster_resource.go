@@ -607,7 +607,7 @@ func resourceServiceFabricClusterCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 	cluster := servicefabric.Cluster{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		ClusterProperties: &servicefabric.ClusterProperties{
 			AddOnFeatures:                      addOnFeatures,
 			AzureActiveDirectory:               azureActiveDirectory,
@@ -765,7 +765,7 @@ func resourceServiceFabricClusterRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -173,7 +173,7 @@ func resourceServiceFabricMeshApplicationCreateUpdate(d *pluginsdk.ResourceData,
 			Services: expandServiceFabricMeshApplicationServices(d.Get("service").(*pluginsdk.Set).List()),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -244,7 +244,7 @@ func resourceServiceFabricMeshApplicationRead(d *pluginsdk.ResourceData, meta in
 	if err := d.Set("service", flattenServiceFabricMeshApplicationServices(serviceResp.Values())); err != nil {
 		return fmt.Errorf("setting `service`: %+v", err)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshApplicationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -99,7 +99,7 @@ func resourceServiceFabricMeshLocalNetworkCreateUpdate(d *pluginsdk.ResourceData
 			NetworkAddressPrefix: utils.String(d.Get("network_address_prefix").(string)),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -152,7 +152,7 @@ func resourceServiceFabricMeshLocalNetworkRead(d *pluginsdk.ResourceData, meta i
 	d.Set("network_address_prefix", props.NetworkAddressPrefix)
 	d.Set("description", props.Description)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshLocalNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -100,7 +100,7 @@ func resourceServiceFabricMeshSecretCreateUpdate(d *pluginsdk.ResourceData, meta
 			Kind:        servicefabricmesh.KindInlinedValue,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -153,7 +153,7 @@ func resourceServiceFabricMeshSecretRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("content_type", props.ContentType)
 	d.Set("description", props.Description)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
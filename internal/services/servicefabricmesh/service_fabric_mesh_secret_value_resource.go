@@ -105,7 +105,7 @@ func resourceServiceFabricMeshSecretValueCreateUpdate(d *pluginsdk.ResourceData,
 			Value: utils.String(d.Get("value").(string)),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, secretID.ResourceGroup, secretID.Name, name, parameters); err != nil {
@@ -163,7 +163,7 @@ func resourceServiceFabricMeshSecretValueRead(d *pluginsdk.ResourceData, meta in
 	d.Set("service_fabric_mesh_secret_id", secret.ID)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshSecretValueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
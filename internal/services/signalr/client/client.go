@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2020-05-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
 )
 
 type Client struct {
-	Client *signalr.SignalRClient
+	Client          *signalr.SignalRClient
+	WebPubSubClient *webpubsub.WebPubSubClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	client := signalr.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
+	webPubSubClient := webpubsub.NewWebPubSubClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&webPubSubClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		Client: &client,
+		Client:          &client,
+		WebPubSubClient: &webPubSubClient,
 	}
 }
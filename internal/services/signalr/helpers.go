@@ -0,0 +1,167 @@
+package signalr
+
+import (
+	"fmt"
+
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the SignalR Service and Web PubSub share the same SKUs and Network ACL Request Types, however these are
+// defined as separate types in each SDK - as such the helpers below work with the raw values, which are
+// then cast to the relevant SDK type by each Resource.
+
+var networkACLRequestTypes = []string{
+	"ClientConnection",
+	"RESTAPI",
+	"ServerConnection",
+	"Trace",
+}
+
+type requestTypeRules struct {
+	allowed []string
+	denied  []string
+}
+
+func skuSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Free_F1",
+						"Standard_S1",
+					}, false),
+				},
+
+				"capacity": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntInSlice([]int{1, 2, 5, 10, 20, 50, 100}),
+				},
+			},
+		},
+	}
+}
+
+func expandSku(input []interface{}) (name string, capacity *int64) {
+	v := input[0].(map[string]interface{})
+	return v["name"].(string), utils.Int64(int64(v["capacity"].(int)))
+}
+
+func flattenSku(name string, capacity *int64) []interface{} {
+	skuCapacity := 0
+	if capacity != nil {
+		skuCapacity = int(*capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"capacity": skuCapacity,
+			"name":     name,
+		},
+	}
+}
+
+func requestTypesSchema(conflictsWith []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeSet,
+		Optional:      true,
+		ConflictsWith: conflictsWith,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringInSlice(networkACLRequestTypes, false),
+		},
+	}
+}
+
+func publicNetworkSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"allowed_request_types": requestTypesSchema([]string{"public_network.0.denied_request_types"}),
+
+				"denied_request_types": requestTypesSchema([]string{"public_network.0.allowed_request_types"}),
+			},
+		},
+	}
+}
+
+func privateEndpointSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: networkValidate.PrivateEndpointID,
+				},
+
+				"allowed_request_types": requestTypesSchema(nil),
+
+				"denied_request_types": requestTypesSchema(nil),
+			},
+		},
+	}
+}
+
+func expandRequestTypeRules(input map[string]interface{}) requestTypeRules {
+	return requestTypeRules{
+		allowed: *utils.ExpandStringSlice(input["allowed_request_types"].(*pluginsdk.Set).List()),
+		denied:  *utils.ExpandStringSlice(input["denied_request_types"].(*pluginsdk.Set).List()),
+	}
+}
+
+func expandPublicNetworkRequestTypeRules(input []interface{}) requestTypeRules {
+	if len(input) == 0 || input[0] == nil {
+		return requestTypeRules{
+			allowed: make([]string, 0),
+			denied:  make([]string, 0),
+		}
+	}
+
+	return expandRequestTypeRules(input[0].(map[string]interface{}))
+}
+
+func flattenRequestTypeRules(rules requestTypeRules) map[string]interface{} {
+	return map[string]interface{}{
+		"allowed_request_types": utils.FlattenStringSlice(&rules.allowed),
+		"denied_request_types":  utils.FlattenStringSlice(&rules.denied),
+	}
+}
+
+// validateNetworkACLRules ensures the Request Types configured for the Public Network and each of the
+// Private Endpoints don't duplicate the Default Action, which the API would otherwise reject
+func validateNetworkACLRules(defaultAction string, publicNetwork requestTypeRules, privateEndpoints []requestTypeRules) error {
+	if defaultAction == "Allow" && len(publicNetwork.allowed) != 0 {
+		return fmt.Errorf("when `default_action` is `Allow` for `public_network`, `allowed_request_types` cannot be specified")
+	} else if defaultAction == "Deny" && len(publicNetwork.denied) != 0 {
+		return fmt.Errorf("when `default_action` is `Deny` for `public_network`, `denied_request_types` cannot be specified")
+	}
+
+	for _, privateEndpoint := range privateEndpoints {
+		if len(privateEndpoint.allowed) != 0 && len(privateEndpoint.denied) != 0 {
+			return fmt.Errorf("`allowed_request_types` and `denied_request_types` cannot be set together for `private_endpoint`")
+		}
+
+		if defaultAction == "Allow" && len(privateEndpoint.allowed) != 0 {
+			return fmt.Errorf("when `default_action` is `Allow` for `private_endpoint`, `allowed_request_types` cannot be specified")
+		} else if defaultAction == "Deny" && len(privateEndpoint.denied) != 0 {
+			return fmt.Errorf("when `default_action` is `Deny` for `private_endpoint`, `denied_request_types` cannot be specified")
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_signalr_service": dataSourceArmSignalRService(),
+		"azurerm_web_pubsub":      dataSourceWebPubSub(),
 	}
 }
 
@@ -30,5 +31,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_signalr_service":             resourceArmSignalRService(),
 		"azurerm_signalr_service_network_acl": resourceArmSignalRServiceNetworkACL(),
+		"azurerm_web_pubsub":                  resourceWebPubSub(),
+		"azurerm_web_pubsub_hub":              resourceWebPubSubHub(),
+		"azurerm_web_pubsub_network_acl":      resourceWebPubSubNetworkACL(),
 	}
 }
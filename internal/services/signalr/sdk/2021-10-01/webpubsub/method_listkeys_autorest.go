@@ -0,0 +1,65 @@
+package webpubsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListKeysResponse struct {
+	HttpResponse *http.Response
+	Model        *WebPubSubKeys
+}
+
+// ListKeys ...
+func (c WebPubSubClient) ListKeys(ctx context.Context, id WebPubSubId) (result ListKeysResponse, err error) {
+	req, err := c.preparerForListKeys(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ListKeys", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ListKeys", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListKeys(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ListKeys", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListKeys prepares the ListKeys request.
+func (c WebPubSubClient) preparerForListKeys(ctx context.Context, id WebPubSubId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listKeys", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListKeys handles the response to the ListKeys request. The method always
+// closes the http.Response Body.
+func (c WebPubSubClient) responderForListKeys(resp *http.Response) (result ListKeysResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
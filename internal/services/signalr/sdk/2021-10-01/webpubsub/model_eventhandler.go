@@ -0,0 +1,8 @@
+package webpubsub
+
+type EventHandler struct {
+	Auth             *UpstreamAuthSettings `json:"auth,omitempty"`
+	SystemEvents     *[]string             `json:"systemEvents,omitempty"`
+	UrlTemplate      string                `json:"urlTemplate"`
+	UserEventPattern *string               `json:"userEventPattern,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webpubsub
+
+type ManagedIdentitySettings struct {
+	Resource *string `json:"resource,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webpubsub
+
+type NetworkACL struct {
+	Allow *[]WebPubSubRequestType `json:"allow,omitempty"`
+	Deny  *[]WebPubSubRequestType `json:"deny,omitempty"`
+}
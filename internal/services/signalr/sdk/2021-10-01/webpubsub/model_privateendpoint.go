@@ -0,0 +1,5 @@
+package webpubsub
+
+type PrivateEndpoint struct {
+	Id *string `json:"id,omitempty"`
+}
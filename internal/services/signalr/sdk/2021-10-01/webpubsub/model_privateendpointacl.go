@@ -0,0 +1,7 @@
+package webpubsub
+
+type PrivateEndpointACL struct {
+	Allow *[]WebPubSubRequestType `json:"allow,omitempty"`
+	Deny  *[]WebPubSubRequestType `json:"deny,omitempty"`
+	Name  string                  `json:"name"`
+}
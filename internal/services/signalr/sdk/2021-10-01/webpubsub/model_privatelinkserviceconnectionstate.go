@@ -0,0 +1,7 @@
+package webpubsub
+
+type PrivateLinkServiceConnectionState struct {
+	ActionsRequired *string                             `json:"actionsRequired,omitempty"`
+	Description     *string                             `json:"description,omitempty"`
+	Status          *PrivateLinkServiceConnectionStatus `json:"status,omitempty"`
+}
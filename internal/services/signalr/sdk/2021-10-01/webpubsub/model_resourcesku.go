@@ -0,0 +1,9 @@
+package webpubsub
+
+type ResourceSku struct {
+	Capacity *int64            `json:"capacity,omitempty"`
+	Family   *string           `json:"family,omitempty"`
+	Name     string            `json:"name"`
+	Size     *string           `json:"size,omitempty"`
+	Tier     *WebPubSubSkuTier `json:"tier,omitempty"`
+}
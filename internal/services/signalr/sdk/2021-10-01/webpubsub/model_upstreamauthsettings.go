@@ -0,0 +1,6 @@
+package webpubsub
+
+type UpstreamAuthSettings struct {
+	ManagedIdentity *ManagedIdentitySettings `json:"managedIdentity,omitempty"`
+	Type            *UpstreamAuthType        `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webpubsub
+
+type WebPubSubHub struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties WebPubSubHubProperties `json:"properties"`
+	Type       *string                `json:"type,omitempty"`
+}
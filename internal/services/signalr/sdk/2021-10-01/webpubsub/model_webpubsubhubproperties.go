@@ -0,0 +1,6 @@
+package webpubsub
+
+type WebPubSubHubProperties struct {
+	AnonymousConnectPolicy *string         `json:"anonymousConnectPolicy,omitempty"`
+	EventHandlers          *[]EventHandler `json:"eventHandlers,omitempty"`
+}
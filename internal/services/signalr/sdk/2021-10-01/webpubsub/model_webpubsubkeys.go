@@ -0,0 +1,8 @@
+package webpubsub
+
+type WebPubSubKeys struct {
+	PrimaryConnectionString   *string `json:"primaryConnectionString,omitempty"`
+	PrimaryKey                *string `json:"primaryKey,omitempty"`
+	SecondaryConnectionString *string `json:"secondaryConnectionString,omitempty"`
+	SecondaryKey              *string `json:"secondaryKey,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webpubsub
+
+type WebPubSubNetworkACLs struct {
+	DefaultAction    *ACLAction            `json:"defaultAction,omitempty"`
+	PrivateEndpoints *[]PrivateEndpointACL `json:"privateEndpoints,omitempty"`
+	PublicNetwork    *NetworkACL           `json:"publicNetwork,omitempty"`
+}
@@ -0,0 +1,17 @@
+package webpubsub
+
+type WebPubSubProperties struct {
+	DisableAadAuth             *bool                        `json:"disableAadAuth,omitempty"`
+	DisableLocalAuth           *bool                        `json:"disableLocalAuth,omitempty"`
+	ExternalIP                 *string                      `json:"externalIP,omitempty"`
+	HostName                   *string                      `json:"hostName,omitempty"`
+	HostNamePrefix             *string                      `json:"hostNamePrefix,omitempty"`
+	NetworkACLs                *WebPubSubNetworkACLs        `json:"networkACLs,omitempty"`
+	PrivateEndpointConnections *[]PrivateEndpointConnection `json:"privateEndpointConnections,omitempty"`
+	ProvisioningState          *ProvisioningState           `json:"provisioningState,omitempty"`
+	PublicNetworkAccess        *string                      `json:"publicNetworkAccess,omitempty"`
+	PublicPort                 *int64                       `json:"publicPort,omitempty"`
+	ServerPort                 *int64                       `json:"serverPort,omitempty"`
+	Tls                        *WebPubSubTlsSettings        `json:"tls,omitempty"`
+	Version                    *string                      `json:"version,omitempty"`
+}
@@ -0,0 +1,11 @@
+package webpubsub
+
+type WebPubSubResource struct {
+	Id         *string              `json:"id,omitempty"`
+	Location   *string              `json:"location,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *WebPubSubProperties `json:"properties,omitempty"`
+	Sku        *ResourceSku         `json:"sku,omitempty"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
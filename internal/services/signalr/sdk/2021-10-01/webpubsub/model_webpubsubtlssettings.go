@@ -0,0 +1,5 @@
+package webpubsub
+
+type WebPubSubTlsSettings struct {
+	ClientCertEnabled *bool `json:"clientCertEnabled,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webpubsub
+
+import "fmt"
+
+const defaultApiVersion = "2021-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/webpubsub/%s", defaultApiVersion)
+}
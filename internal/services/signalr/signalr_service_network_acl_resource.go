@@ -7,13 +7,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
-	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2020-05-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
@@ -54,86 +52,9 @@ func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"public_network": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"allowed_request_types": {
-							Type:          pluginsdk.TypeSet,
-							Optional:      true,
-							ConflictsWith: []string{"public_network.0.denied_request_types"},
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(signalr.SignalRRequestTypeClientConnection),
-									string(signalr.SignalRRequestTypeRESTAPI),
-									string(signalr.SignalRRequestTypeServerConnection),
-									string(signalr.SignalRRequestTypeTrace),
-								}, false),
-							},
-						},
-
-						"denied_request_types": {
-							Type:          pluginsdk.TypeSet,
-							Optional:      true,
-							ConflictsWith: []string{"public_network.0.allowed_request_types"},
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(signalr.SignalRRequestTypeClientConnection),
-									string(signalr.SignalRRequestTypeRESTAPI),
-									string(signalr.SignalRRequestTypeServerConnection),
-									string(signalr.SignalRRequestTypeTrace),
-								}, false),
-							},
-						},
-					},
-				},
-			},
+			"public_network": publicNetworkSchema(),
 
-			"private_endpoint": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"id": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: networkValidate.PrivateEndpointID,
-						},
-
-						"allowed_request_types": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(signalr.SignalRRequestTypeClientConnection),
-									string(signalr.SignalRRequestTypeRESTAPI),
-									string(signalr.SignalRRequestTypeServerConnection),
-									string(signalr.SignalRRequestTypeTrace),
-								}, false),
-							},
-						},
-
-						"denied_request_types": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(signalr.SignalRRequestTypeClientConnection),
-									string(signalr.SignalRRequestTypeRESTAPI),
-									string(signalr.SignalRRequestTypeServerConnection),
-									string(signalr.SignalRRequestTypeTrace),
-								}, false),
-							},
-						},
-					},
-				},
-			},
+			"private_endpoint": privateEndpointSchema(),
 		},
 	}
 }
@@ -162,33 +83,24 @@ func resourceSignalRServiceNetworkACLCreateUpdate(d *pluginsdk.ResourceData, met
 	model := *resp.Model
 	if props := model.Properties; props != nil {
 		defaultAction := signalr.ACLAction(d.Get("default_action").(string))
-		networkACL := signalr.SignalRNetworkACLs{
-			DefaultAction: &defaultAction,
-			PublicNetwork: expandSignalRServicePublicNetwork(d.Get("public_network").([]interface{})),
-		}
+		publicNetwork := expandPublicNetworkRequestTypeRules(d.Get("public_network").([]interface{}))
+		privateEndpoints := d.Get("private_endpoint").(*pluginsdk.Set).List()
 
-		if v, ok := d.GetOk("private_endpoint"); ok {
-			networkACL.PrivateEndpoints = expandSignalRServicePrivateEndpoint(v.(*pluginsdk.Set).List(), props.PrivateEndpointConnections)
+		privateEndpointRules := make([]requestTypeRules, 0)
+		for _, item := range privateEndpoints {
+			privateEndpointRules = append(privateEndpointRules, expandRequestTypeRules(item.(map[string]interface{})))
 		}
-
-		if defaultAction == signalr.ACLActionAllow && len(*networkACL.PublicNetwork.Allow) != 0 {
-			return fmt.Errorf("when `default_action` is `Allow` for `public_network`, `allowed_request_types` cannot be specified")
-		} else if defaultAction == signalr.ACLActionDeny && len(*networkACL.PublicNetwork.Deny) != 0 {
-			return fmt.Errorf("when `default_action` is `Deny` for `public_network`, `denied_request_types` cannot be specified")
+		if err := validateNetworkACLRules(string(defaultAction), publicNetwork, privateEndpointRules); err != nil {
+			return err
 		}
 
-		if networkACL.PrivateEndpoints != nil {
-			for _, privateEndpoint := range *networkACL.PrivateEndpoints {
-				if len(*privateEndpoint.Allow) != 0 && len(*privateEndpoint.Deny) != 0 {
-					return fmt.Errorf("`allowed_request_types` and `denied_request_types` cannot be set together for `private_endpoint`")
-				}
+		networkACL := signalr.SignalRNetworkACLs{
+			DefaultAction: &defaultAction,
+			PublicNetwork: expandSignalRServicePublicNetwork(publicNetwork),
+		}
 
-				if defaultAction == signalr.ACLActionAllow && len(*privateEndpoint.Allow) != 0 {
-					return fmt.Errorf("when `default_action` is `Allow` for `private_endpoint`, `allowed_request_types` cannot be specified")
-				} else if defaultAction == signalr.ACLActionDeny && len(*privateEndpoint.Deny) != 0 {
-					return fmt.Errorf("when `default_action` is `Deny` for `private_endpoint`, `denied_request_types` cannot be specified")
-				}
-			}
+		if len(privateEndpoints) > 0 {
+			networkACL.PrivateEndpoints = expandSignalRServicePrivateEndpoint(privateEndpoints, props.PrivateEndpointConnections)
 		}
 
 		model.Properties.NetworkACLs = &networkACL
@@ -303,26 +215,29 @@ func resourceSignalRServiceNetworkACLDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
-func expandSignalRServicePublicNetwork(input []interface{}) *signalr.NetworkACL {
-	allowedRTs := make([]signalr.SignalRRequestType, 0)
-	deniedRTs := make([]signalr.SignalRRequestType, 0)
-
-	if len(input) != 0 && input[0] != nil {
-		v := input[0].(map[string]interface{})
-
-		for _, item := range *(utils.ExpandStringSlice(v["allowed_request_types"].(*pluginsdk.Set).List())) {
-			allowedRTs = append(allowedRTs, signalr.SignalRRequestType(item))
-		}
+func expandSignalRServicePublicNetwork(input requestTypeRules) *signalr.NetworkACL {
+	return &signalr.NetworkACL{
+		Allow: expandSignalRRequestTypes(input.allowed),
+		Deny:  expandSignalRRequestTypes(input.denied),
+	}
+}
 
-		for _, item := range *(utils.ExpandStringSlice(v["denied_request_types"].(*pluginsdk.Set).List())) {
-			deniedRTs = append(deniedRTs, signalr.SignalRRequestType(item))
-		}
+func expandSignalRRequestTypes(input []string) *[]signalr.SignalRRequestType {
+	requestTypes := make([]signalr.SignalRRequestType, 0)
+	for _, item := range input {
+		requestTypes = append(requestTypes, signalr.SignalRRequestType(item))
 	}
+	return &requestTypes
+}
 
-	return &signalr.NetworkACL{
-		Allow: &allowedRTs,
-		Deny:  &deniedRTs,
+func flattenSignalRRequestTypes(input *[]signalr.SignalRRequestType) []string {
+	requestTypes := make([]string, 0)
+	if input != nil {
+		for _, item := range *input {
+			requestTypes = append(requestTypes, string(item))
+		}
 	}
+	return requestTypes
 }
 
 func expandSignalRServicePrivateEndpoint(input []interface{}, privateEndpointConnections *[]signalr.PrivateEndpointConnection) *[]signalr.PrivateEndpointACL {
@@ -350,17 +265,9 @@ func expandSignalRServicePrivateEndpoint(input []interface{}, privateEndpointCon
 					continue
 				}
 
-				allowedRTs := make([]signalr.SignalRRequestType, 0)
-				for _, item := range *(utils.ExpandStringSlice(v["allowed_request_types"].(*pluginsdk.Set).List())) {
-					allowedRTs = append(allowedRTs, signalr.SignalRRequestType(item))
-				}
-				result.Allow = &allowedRTs
-
-				deniedRTs := make([]signalr.SignalRRequestType, 0)
-				for _, item := range *(utils.ExpandStringSlice(v["denied_request_types"].(*pluginsdk.Set).List())) {
-					deniedRTs = append(deniedRTs, signalr.SignalRRequestType(item))
-				}
-				result.Deny = &deniedRTs
+				rules := expandRequestTypeRules(v)
+				result.Allow = expandSignalRRequestTypes(rules.allowed)
+				result.Deny = expandSignalRRequestTypes(rules.denied)
 
 				break
 			}
@@ -377,27 +284,11 @@ func flattenSignalRServicePublicNetwork(input *signalr.NetworkACL) []interface{}
 		return make([]interface{}, 0)
 	}
 
-	allowRequestTypes := make([]string, 0)
-	if input.Allow != nil {
-		for _, item := range *input.Allow {
-			allowRequestTypes = append(allowRequestTypes, (string)(item))
-		}
-	}
-	allow := utils.FlattenStringSlice(&allowRequestTypes)
-
-	deniedRequestTypes := make([]string, 0)
-	if input.Deny != nil {
-		for _, item := range *input.Deny {
-			deniedRequestTypes = append(deniedRequestTypes, (string)(item))
-		}
-	}
-	deny := utils.FlattenStringSlice(&deniedRequestTypes)
-
 	return []interface{}{
-		map[string]interface{}{
-			"allowed_request_types": allow,
-			"denied_request_types":  deny,
-		},
+		flattenRequestTypeRules(requestTypeRules{
+			allowed: flattenSignalRRequestTypes(input.Allow),
+			denied:  flattenSignalRRequestTypes(input.Deny),
+		}),
 	}
 }
 
@@ -421,27 +312,12 @@ func flattenSignalRServicePrivateEndpoint(input *[]signalr.PrivateEndpointACL, p
 					continue
 				}
 
-				allowedRequestTypes := make([]string, 0)
-				if item.Allow != nil {
-					for _, item := range *item.Allow {
-						allowedRequestTypes = append(allowedRequestTypes, (string)(item))
-					}
-				}
-				allow := utils.FlattenStringSlice(&allowedRequestTypes)
-
-				deniedRequestTypes := make([]string, 0)
-				if item.Deny != nil {
-					for _, item := range *item.Deny {
-						deniedRequestTypes = append(deniedRequestTypes, (string)(item))
-					}
-				}
-				deny := utils.FlattenStringSlice(&deniedRequestTypes)
-
-				results = append(results, map[string]interface{}{
-					"id":                    *props.PrivateEndpoint.Id,
-					"allowed_request_types": allow,
-					"denied_request_types":  deny,
+				result := flattenRequestTypeRules(requestTypeRules{
+					allowed: flattenSignalRRequestTypes(item.Allow),
+					denied:  flattenSignalRRequestTypes(item.Deny),
 				})
+				result["id"] = *props.PrivateEndpoint.Id
+				results = append(results, result)
 
 				break
 			}
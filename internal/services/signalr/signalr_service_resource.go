@@ -58,29 +58,7 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"sku": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"Free_F1",
-								"Standard_S1",
-							}, false),
-						},
-
-						"capacity": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntInSlice([]int{1, 2, 5, 10, 20, 50, 100}),
-						},
-					},
-				},
-			},
+			"sku": skuSchema(),
 
 			"features": {
 				Type:     pluginsdk.TypeSet,
@@ -253,7 +231,7 @@ func resourceArmSignalRServiceCreate(d *pluginsdk.ResourceData, meta interface{}
 			Upstream: expandUpstreamSettings(upstreamSettings),
 		},
 		Sku:  expandSignalRServiceSku(sku),
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, resourceType); err != nil {
@@ -318,7 +296,7 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `upstream_endpoint`: %+v", err)
 			}
 
-			if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
 				return err
 			}
 		}
@@ -372,7 +350,7 @@ func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	if d.HasChange("tags") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		resourceType.Tags = tagsHelper.Expand(tagsRaw)
+		resourceType.Tags = tags.ExpandPointer(meta.(*clients.Client).Tags, tagsRaw)
 	}
 
 	if err := client.UpdateThenPoll(ctx, *id, resourceType); err != nil {
@@ -548,10 +526,10 @@ func flattenSignalRCors(input *signalr.SignalRCorsSettings) []interface{} {
 }
 
 func expandSignalRServiceSku(input []interface{}) *signalr.ResourceSku {
-	v := input[0].(map[string]interface{})
+	name, capacity := expandSku(input)
 	return &signalr.ResourceSku{
-		Name:     v["name"].(string),
-		Capacity: utils.Int64(int64(v["capacity"].(int))),
+		Name:     name,
+		Capacity: capacity,
 	}
 }
 
@@ -560,15 +538,5 @@ func flattenSignalRServiceSku(input *signalr.ResourceSku) []interface{} {
 		return []interface{}{}
 	}
 
-	capacity := 0
-	if input.Capacity != nil {
-		capacity = int(*input.Capacity)
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"capacity": capacity,
-			"name":     input.Name,
-		},
-	}
+	return flattenSku(input.Name, input.Capacity)
 }
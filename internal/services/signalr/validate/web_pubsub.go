@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func WebPubSubName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 3 and 63 characters, start with a letter, end with a letter or number and can only contain letters, numbers and hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func WebPubSubHubName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_`,.\\[\\]]{0,127}$").MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 128 characters, start with a letter and can only contain letters, numbers, underscores, backticks, commas, periods and square brackets: %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,115 @@
+package validate
+
+import "testing"
+
+func TestWebPubSubName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// too short
+			Input: "ab",
+			Valid: false,
+		},
+
+		{
+			// starts with a number
+			Input: "1webpubsub",
+			Valid: false,
+		},
+
+		{
+			// ends with a hyphen
+			Input: "webpubsub-",
+			Valid: false,
+		},
+
+		{
+			// contains an underscore
+			Input: "web_pubsub",
+			Valid: false,
+		},
+
+		{
+			// valid
+			Input: "web-pubsub1",
+			Valid: true,
+		},
+
+		{
+			// 63 characters
+			Input: "a12345678901234567890123456789012345678901234567890123456789012",
+			Valid: true,
+		},
+
+		{
+			// 64 characters
+			Input: "a123456789012345678901234567890123456789012345678901234567890123",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := WebPubSubName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestWebPubSubHubName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// starts with a number
+			Input: "1hub",
+			Valid: false,
+		},
+
+		{
+			// contains a hyphen
+			Input: "my-hub",
+			Valid: false,
+		},
+
+		{
+			// single character
+			Input: "h",
+			Valid: true,
+		},
+
+		{
+			// special characters
+			Input: "hub_1`,.[0]",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := WebPubSubHubName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package signalr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceWebPubSub() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceWebPubSubRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"aad_auth_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"local_auth_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"tls_client_cert_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"hostname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"external_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"server_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := webpubsub.NewWebPubSubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	keys, err := client.ListKeys(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.ResourceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if err := d.Set("sku", flattenWebPubSubSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("aad_auth_enabled", !utils.NormaliseNilableBool(props.DisableAadAuth))
+			d.Set("local_auth_enabled", !utils.NormaliseNilableBool(props.DisableLocalAuth))
+			d.Set("public_network_access_enabled", flattenWebPubSubPublicNetworkAccess(props.PublicNetworkAccess))
+
+			tlsClientCertEnabled := false
+			if props.Tls != nil {
+				tlsClientCertEnabled = utils.NormaliseNilableBool(props.Tls.ClientCertEnabled)
+			}
+			d.Set("tls_client_cert_enabled", tlsClientCertEnabled)
+
+			d.Set("hostname", props.HostName)
+			d.Set("external_ip", props.ExternalIP)
+			d.Set("public_port", props.PublicPort)
+			d.Set("server_port", props.ServerPort)
+			d.Set("version", props.Version)
+		}
+
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	if model := keys.Model; model != nil {
+		d.Set("primary_access_key", model.PrimaryKey)
+		d.Set("primary_connection_string", model.PrimaryConnectionString)
+		d.Set("secondary_access_key", model.SecondaryKey)
+		d.Set("secondary_connection_string", model.SecondaryConnectionString)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package signalr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type WebPubSubDataSource struct{}
+
+func TestAccDataSourceWebPubSub_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_web_pubsub", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: WebPubSubDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("sku.0.name").HasValue("Free_F1"),
+				check.That(data.ResourceName).Key("hostname").Exists(),
+				check.That(data.ResourceName).Key("external_ip").Exists(),
+				check.That(data.ResourceName).Key("public_port").Exists(),
+				check.That(data.ResourceName).Key("server_port").Exists(),
+				check.That(data.ResourceName).Key("primary_access_key").Exists(),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+			),
+		},
+	})
+}
+
+func (r WebPubSubDataSource) basic(data acceptance.TestData) string {
+	template := WebPubSubResource{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_web_pubsub" "test" {
+  name                = azurerm_web_pubsub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, template)
+}
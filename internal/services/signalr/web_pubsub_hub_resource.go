@@ -0,0 +1,277 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
+	signalrValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceWebPubSubHub() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubHubCreateUpdate,
+		Read:   resourceWebPubSubHubRead,
+		Update: resourceWebPubSubHubCreateUpdate,
+		Delete: resourceWebPubSubHubDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseHubID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalrValidate.WebPubSubHubName,
+			},
+
+			"web_pubsub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateWebPubSubID,
+			},
+
+			"event_handler": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"url_template": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: signalrValidate.UrlTemplate,
+						},
+
+						"user_event_pattern": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"system_events": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"connect",
+									"connected",
+									"disconnected",
+								}, false),
+							},
+						},
+
+						"auth": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"managed_identity_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"anonymous_connections_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceWebPubSubHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	webPubSubId, err := webpubsub.ParseWebPubSubID(d.Get("web_pubsub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := webpubsub.NewHubID(webPubSubId.SubscriptionId, webPubSubId.ResourceGroupName, webPubSubId.ResourceName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.HubsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_web_pubsub_hub", id.ID())
+		}
+	}
+
+	anonymousConnectPolicy := "deny"
+	if d.Get("anonymous_connections_enabled").(bool) {
+		anonymousConnectPolicy = "allow"
+	}
+
+	parameters := webpubsub.WebPubSubHub{
+		Properties: webpubsub.WebPubSubHubProperties{
+			AnonymousConnectPolicy: utils.String(anonymousConnectPolicy),
+			EventHandlers:          expandWebPubSubHubEventHandlers(d.Get("event_handler").([]interface{})),
+		},
+	}
+
+	if err := client.HubsCreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubHubRead(d, meta)
+}
+
+func resourceWebPubSubHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.HubsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.HubName)
+	d.Set("web_pubsub_id", webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		anonymousConnectionsEnabled := false
+		if props.AnonymousConnectPolicy != nil {
+			anonymousConnectionsEnabled = strings.EqualFold(*props.AnonymousConnectPolicy, "allow")
+		}
+		d.Set("anonymous_connections_enabled", anonymousConnectionsEnabled)
+
+		if err := d.Set("event_handler", flattenWebPubSubHubEventHandlers(props.EventHandlers)); err != nil {
+			return fmt.Errorf("setting `event_handler`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceWebPubSubHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.HubsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandWebPubSubHubEventHandlers(input []interface{}) *[]webpubsub.EventHandler {
+	eventHandlers := make([]webpubsub.EventHandler, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		eventHandler := webpubsub.EventHandler{
+			UrlTemplate:  v["url_template"].(string),
+			SystemEvents: utils.ExpandStringSlice(v["system_events"].(*pluginsdk.Set).List()),
+		}
+
+		if userEventPattern := v["user_event_pattern"].(string); userEventPattern != "" {
+			eventHandler.UserEventPattern = utils.String(userEventPattern)
+		}
+
+		if auth := v["auth"].([]interface{}); len(auth) > 0 && auth[0] != nil {
+			authType := webpubsub.UpstreamAuthTypeManagedIdentity
+			eventHandler.Auth = &webpubsub.UpstreamAuthSettings{
+				Type: &authType,
+				ManagedIdentity: &webpubsub.ManagedIdentitySettings{
+					Resource: utils.String(auth[0].(map[string]interface{})["managed_identity_id"].(string)),
+				},
+			}
+		}
+
+		eventHandlers = append(eventHandlers, eventHandler)
+	}
+
+	return &eventHandlers
+}
+
+func flattenWebPubSubHubEventHandlers(input *[]webpubsub.EventHandler) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		userEventPattern := ""
+		if item.UserEventPattern != nil {
+			userEventPattern = *item.UserEventPattern
+		}
+
+		auth := make([]interface{}, 0)
+		if item.Auth != nil && item.Auth.Type != nil && *item.Auth.Type == webpubsub.UpstreamAuthTypeManagedIdentity {
+			managedIdentityId := ""
+			if item.Auth.ManagedIdentity != nil && item.Auth.ManagedIdentity.Resource != nil {
+				managedIdentityId = *item.Auth.ManagedIdentity.Resource
+			}
+
+			auth = append(auth, map[string]interface{}{
+				"managed_identity_id": managedIdentityId,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"url_template":       item.UrlTemplate,
+			"user_event_pattern": userEventPattern,
+			"system_events":      utils.FlattenStringSlice(item.SystemEvents),
+			"auth":               auth,
+		})
+	}
+
+	return results
+}
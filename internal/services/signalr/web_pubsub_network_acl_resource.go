@@ -0,0 +1,325 @@
+package signalr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceWebPubSubNetworkACL() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubNetworkACLCreateUpdate,
+		Read:   resourceWebPubSubNetworkACLRead,
+		Update: resourceWebPubSubNetworkACLCreateUpdate,
+		Delete: resourceWebPubSubNetworkACLDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseWebPubSubID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"web_pubsub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateWebPubSubID,
+			},
+
+			"default_action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(webpubsub.ACLActionAllow),
+					string(webpubsub.ACLActionDeny),
+				}, false),
+			},
+
+			"public_network": publicNetworkSchema(),
+
+			"private_endpoint": privateEndpointSchema(),
+		},
+	}
+}
+
+func resourceWebPubSubNetworkACLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Get("web_pubsub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ResourceName, "azurerm_web_pubsub")
+	defer locks.UnlockByName(id.ResourceName, "azurerm_web_pubsub")
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", *id)
+	}
+
+	model := *resp.Model
+	if props := model.Properties; props != nil {
+		defaultAction := webpubsub.ACLAction(d.Get("default_action").(string))
+		publicNetwork := expandPublicNetworkRequestTypeRules(d.Get("public_network").([]interface{}))
+		privateEndpoints := d.Get("private_endpoint").(*pluginsdk.Set).List()
+
+		privateEndpointRules := make([]requestTypeRules, 0)
+		for _, item := range privateEndpoints {
+			privateEndpointRules = append(privateEndpointRules, expandRequestTypeRules(item.(map[string]interface{})))
+		}
+		if err := validateNetworkACLRules(string(defaultAction), publicNetwork, privateEndpointRules); err != nil {
+			return err
+		}
+
+		networkACL := webpubsub.WebPubSubNetworkACLs{
+			DefaultAction: &defaultAction,
+			PublicNetwork: expandWebPubSubPublicNetwork(publicNetwork),
+		}
+
+		if len(privateEndpoints) > 0 {
+			networkACL.PrivateEndpoints = expandWebPubSubPrivateEndpoint(privateEndpoints, props.PrivateEndpointConnections)
+		}
+
+		model.Properties.NetworkACLs = &networkACL
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, model); err != nil {
+		return fmt.Errorf("creating/updating NetworkACL for %s: %v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubNetworkACLRead(d, meta)
+}
+
+func resourceWebPubSubNetworkACLRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("web_pubsub_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil && props.NetworkACLs != nil {
+			defaultAction := ""
+			if props.NetworkACLs.DefaultAction != nil {
+				defaultAction = string(*props.NetworkACLs.DefaultAction)
+			}
+			d.Set("default_action", defaultAction)
+
+			if err := d.Set("public_network", flattenWebPubSubPublicNetwork(props.NetworkACLs.PublicNetwork)); err != nil {
+				return fmt.Errorf("setting `public_network`: %+v", err)
+			}
+
+			if err := d.Set("private_endpoint", flattenWebPubSubPrivateEndpoint(props.NetworkACLs.PrivateEndpoints, props.PrivateEndpointConnections)); err != nil {
+				return fmt.Errorf("setting `private_endpoint`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceWebPubSubNetworkACLDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ResourceName, "azurerm_web_pubsub")
+	defer locks.UnlockByName(id.ResourceName, "azurerm_web_pubsub")
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", *id)
+	}
+
+	model := *resp.Model
+
+	defaultAction := webpubsub.ACLActionDeny
+	defaultRequestTypes := []webpubsub.WebPubSubRequestType{
+		webpubsub.WebPubSubRequestTypeClientConnection,
+		webpubsub.WebPubSubRequestTypeRESTAPI,
+		webpubsub.WebPubSubRequestTypeServerConnection,
+		webpubsub.WebPubSubRequestTypeTrace,
+	}
+	networkACL := &webpubsub.WebPubSubNetworkACLs{
+		DefaultAction: &defaultAction,
+		PublicNetwork: &webpubsub.NetworkACL{
+			Allow: &defaultRequestTypes,
+		},
+	}
+
+	if model.Properties != nil && model.Properties.NetworkACLs != nil && model.Properties.NetworkACLs.PrivateEndpoints != nil {
+		privateEndpoints := make([]webpubsub.PrivateEndpointACL, 0)
+		for _, item := range *model.Properties.NetworkACLs.PrivateEndpoints {
+			privateEndpoints = append(privateEndpoints, webpubsub.PrivateEndpointACL{
+				Allow: &defaultRequestTypes,
+				Name:  item.Name,
+			})
+		}
+		networkACL.PrivateEndpoints = &privateEndpoints
+	}
+
+	if model.Properties != nil {
+		model.Properties.NetworkACLs = networkACL
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, model); err != nil {
+		return fmt.Errorf("resetting the default Network ACL configuration for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandWebPubSubPublicNetwork(input requestTypeRules) *webpubsub.NetworkACL {
+	return &webpubsub.NetworkACL{
+		Allow: expandWebPubSubRequestTypes(input.allowed),
+		Deny:  expandWebPubSubRequestTypes(input.denied),
+	}
+}
+
+func expandWebPubSubRequestTypes(input []string) *[]webpubsub.WebPubSubRequestType {
+	requestTypes := make([]webpubsub.WebPubSubRequestType, 0)
+	for _, item := range input {
+		requestTypes = append(requestTypes, webpubsub.WebPubSubRequestType(item))
+	}
+	return &requestTypes
+}
+
+func flattenWebPubSubRequestTypes(input *[]webpubsub.WebPubSubRequestType) []string {
+	requestTypes := make([]string, 0)
+	if input != nil {
+		for _, item := range *input {
+			requestTypes = append(requestTypes, string(item))
+		}
+	}
+	return requestTypes
+}
+
+func expandWebPubSubPrivateEndpoint(input []interface{}, privateEndpointConnections *[]webpubsub.PrivateEndpointConnection) *[]webpubsub.PrivateEndpointACL {
+	results := make([]webpubsub.PrivateEndpointACL, 0)
+	if privateEndpointConnections == nil {
+		return &results
+	}
+
+	for _, privateEndpointConnection := range *privateEndpointConnections {
+		result := webpubsub.PrivateEndpointACL{
+			Allow: &[]webpubsub.WebPubSubRequestType{},
+			Deny:  &[]webpubsub.WebPubSubRequestType{},
+		}
+
+		if privateEndpointConnection.Name != nil {
+			result.Name = *privateEndpointConnection.Name
+		}
+
+		for _, item := range input {
+			v := item.(map[string]interface{})
+			privateEndpointId := v["id"].(string)
+
+			if props := privateEndpointConnection.Properties; props != nil {
+				if props.PrivateEndpoint == nil || props.PrivateEndpoint.Id == nil || privateEndpointId != *props.PrivateEndpoint.Id {
+					continue
+				}
+
+				rules := expandRequestTypeRules(v)
+				result.Allow = expandWebPubSubRequestTypes(rules.allowed)
+				result.Deny = expandWebPubSubRequestTypes(rules.denied)
+
+				break
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenWebPubSubPublicNetwork(input *webpubsub.NetworkACL) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		flattenRequestTypeRules(requestTypeRules{
+			allowed: flattenWebPubSubRequestTypes(input.Allow),
+			denied:  flattenWebPubSubRequestTypes(input.Deny),
+		}),
+	}
+}
+
+func flattenWebPubSubPrivateEndpoint(input *[]webpubsub.PrivateEndpointACL, privateEndpointConnections *[]webpubsub.PrivateEndpointConnection) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if privateEndpointConnections != nil {
+			for _, privateEndpointConnection := range *privateEndpointConnections {
+				if privateEndpointConnection.Name == nil || privateEndpointConnection.Properties == nil {
+					continue
+				}
+				if *privateEndpointConnection.Name != item.Name {
+					continue
+				}
+				props := privateEndpointConnection.Properties
+				if props.PrivateEndpoint == nil || props.PrivateEndpoint.Id == nil {
+					continue
+				}
+
+				result := flattenRequestTypeRules(requestTypeRules{
+					allowed: flattenWebPubSubRequestTypes(item.Allow),
+					denied:  flattenWebPubSubRequestTypes(item.Deny),
+				})
+				result["id"] = *props.PrivateEndpoint.Id
+				results = append(results, result)
+
+				break
+			}
+		}
+	}
+
+	return results
+}
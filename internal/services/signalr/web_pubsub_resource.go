@@ -0,0 +1,355 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
+	signalrValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceWebPubSub() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubCreate,
+		Read:   resourceWebPubSubRead,
+		Update: resourceWebPubSubUpdate,
+		Delete: resourceWebPubSubDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseWebPubSubID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalrValidate.WebPubSubName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku": skuSchema(),
+
+			"aad_auth_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"local_auth_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tls_client_cert_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"hostname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"external_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"server_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceWebPubSubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := webpubsub.NewWebPubSubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_web_pubsub", id.ID())
+	}
+
+	parameters := webpubsub.WebPubSubResource{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: &webpubsub.WebPubSubProperties{
+			DisableAadAuth:      utils.Bool(!d.Get("aad_auth_enabled").(bool)),
+			DisableLocalAuth:    utils.Bool(!d.Get("local_auth_enabled").(bool)),
+			PublicNetworkAccess: utils.String(expandWebPubSubPublicNetworkAccess(d.Get("public_network_access_enabled").(bool))),
+			Tls: &webpubsub.WebPubSubTlsSettings{
+				ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
+			},
+		},
+		Sku:  expandWebPubSubSku(d.Get("sku").([]interface{})),
+		Tags: tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubRead(d, meta)
+}
+
+func resourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	keys, err := client.ListKeys(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ResourceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if err := d.Set("sku", flattenWebPubSubSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("aad_auth_enabled", !utils.NormaliseNilableBool(props.DisableAadAuth))
+			d.Set("local_auth_enabled", !utils.NormaliseNilableBool(props.DisableLocalAuth))
+			d.Set("public_network_access_enabled", flattenWebPubSubPublicNetworkAccess(props.PublicNetworkAccess))
+
+			tlsClientCertEnabled := false
+			if props.Tls != nil {
+				tlsClientCertEnabled = utils.NormaliseNilableBool(props.Tls.ClientCertEnabled)
+			}
+			d.Set("tls_client_cert_enabled", tlsClientCertEnabled)
+
+			d.Set("hostname", props.HostName)
+			d.Set("external_ip", props.ExternalIP)
+			d.Set("public_port", props.PublicPort)
+			d.Set("server_port", props.ServerPort)
+			d.Set("version", props.Version)
+		}
+
+		if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	if model := keys.Model; model != nil {
+		d.Set("primary_access_key", model.PrimaryKey)
+		d.Set("primary_connection_string", model.PrimaryConnectionString)
+		d.Set("secondary_access_key", model.SecondaryKey)
+		d.Set("secondary_connection_string", model.SecondaryConnectionString)
+	}
+
+	return nil
+}
+
+func resourceWebPubSubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Network ACLs are managed by the `azurerm_web_pubsub_network_acl` resource, so we update the
+	// existing model (rather than sending a new one) to ensure these aren't reset
+	locks.ByName(id.ResourceName, "azurerm_web_pubsub")
+	defer locks.UnlockByName(id.ResourceName, "azurerm_web_pubsub")
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", *id)
+	}
+
+	model := *existing.Model
+	if model.Properties == nil {
+		model.Properties = &webpubsub.WebPubSubProperties{}
+	}
+
+	if d.HasChange("aad_auth_enabled") {
+		model.Properties.DisableAadAuth = utils.Bool(!d.Get("aad_auth_enabled").(bool))
+	}
+
+	if d.HasChange("local_auth_enabled") {
+		model.Properties.DisableLocalAuth = utils.Bool(!d.Get("local_auth_enabled").(bool))
+	}
+
+	if d.HasChange("public_network_access_enabled") {
+		model.Properties.PublicNetworkAccess = utils.String(expandWebPubSubPublicNetworkAccess(d.Get("public_network_access_enabled").(bool)))
+	}
+
+	if d.HasChange("tls_client_cert_enabled") {
+		model.Properties.Tls = &webpubsub.WebPubSubTlsSettings{
+			ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
+		}
+	}
+
+	if d.HasChange("sku") {
+		model.Sku = expandWebPubSubSku(d.Get("sku").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		model.Tags = tags.ExpandPointer(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, model); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceWebPubSubRead(d, meta)
+}
+
+func resourceWebPubSubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseWebPubSubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// as with the SignalR Service, the API returns a 404 on the Future once the deletion has completed
+	future, err := client.Delete(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.Poller.PollUntilDone(); err != nil {
+		if !response.WasNotFound(future.Poller.HttpResponse) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandWebPubSubSku(input []interface{}) *webpubsub.ResourceSku {
+	name, capacity := expandSku(input)
+	return &webpubsub.ResourceSku{
+		Name:     name,
+		Capacity: capacity,
+	}
+}
+
+func flattenWebPubSubSku(input *webpubsub.ResourceSku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return flattenSku(input.Name, input.Capacity)
+}
+
+func expandWebPubSubPublicNetworkAccess(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+func flattenWebPubSubPublicNetworkAccess(input *string) bool {
+	// the API defaults to `Enabled` when this isn't returned
+	return input == nil || *input == "Enabled"
+}
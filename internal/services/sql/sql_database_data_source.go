@@ -112,5 +112,5 @@ func dataSourceArmSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("read_scale", props.ReadScale == sql.ReadScaleEnabled)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -398,7 +398,7 @@ func resourceSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 			CreateMode:    sql.CreateMode(createMode),
 			ZoneRedundant: utils.Bool(zoneRedundant),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
@@ -611,7 +611,7 @@ func resourceSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("failure in setting `extended_auditing_policy`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSqlDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -127,7 +127,7 @@ func resourceSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Name:                  &name,
 		Location:              &location,
 		ElasticPoolProperties: getArmSqlElasticPoolProperties(d),
-		Tags:                  tags.Expand(t),
+		Tags:                  tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, name, elasticPool)
@@ -214,7 +214,7 @@ func resourceSqlElasticPoolRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("pool_size", storageMb)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSqlElasticPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -166,7 +166,7 @@ func resourceSqlFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interf
 			ReadWriteEndpoint: expandSqlFailoverGroupReadWritePolicy(d),
 			PartnerServers:    expandSqlFailoverGroupPartnerServers(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if r, ok := d.Get("databases").(*pluginsdk.Set); ok && r.Len() > 0 {
@@ -244,7 +244,7 @@ func resourceSqlFailoverGroupRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSqlFailoverGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
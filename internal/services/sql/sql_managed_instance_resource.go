@@ -222,7 +222,7 @@ func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}
 	parameters := sql.ManagedInstance{
 		Sku:      sku,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 		ManagedInstanceProperties: &sql.ManagedInstanceProperties{
 			LicenseType:                sql.ManagedInstanceLicenseType(d.Get("license_type").(string)),
 			AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
@@ -315,7 +315,7 @@ func resourceArmSqlMiServerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("administrator_login_password", d.Get("administrator_login_password").(string))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmSqlMiServerDelete(d *schema.ResourceData, meta interface{}) error {
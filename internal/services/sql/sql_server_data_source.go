@@ -109,5 +109,5 @@ func dataSourceArmSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -228,7 +228,7 @@ func resourceSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	version := d.Get("version").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	metadata := tags.Expand(t)
+	metadata := tags.Expand(meta.(*clients.Client).Tags, t)
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -370,7 +370,7 @@ func resourceSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `extended_auditing_policy`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -3,16 +3,23 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5" // #nosec G501 - the Content-MD5 of a blob is an MD5 hash
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
@@ -129,19 +136,8 @@ func (sbu BlobUpload) createEmptyBlockBlob(ctx context.Context) error {
 }
 
 func (sbu BlobUpload) uploadBlockBlobFromContent(ctx context.Context) error {
-	tmpFile, err := os.CreateTemp(os.TempDir(), "upload-")
-	if err != nil {
-		return fmt.Errorf("creating temporary file: %s", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err = tmpFile.Write([]byte(sbu.SourceContent)); err != nil {
-		return fmt.Errorf("writing Source Content to Temp File: %s", err)
-	}
-	defer tmpFile.Close()
-
-	sbu.Source = tmpFile.Name()
-	return sbu.uploadBlockBlob(ctx)
+	content := strings.NewReader(sbu.SourceContent)
+	return sbu.blockUploadFromSource(ctx, content, content.Size())
 }
 
 func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
@@ -151,18 +147,12 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
-		ContentType: utils.String(sbu.ContentType),
-		MetaData:    sbu.MetaData,
-	}
-	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
-	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("PutBlockBlobFromFile: %s", err)
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
 	}
 
-	return nil
+	return sbu.blockUploadFromSource(ctx, file, info.Size())
 }
 
 func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
@@ -364,6 +354,273 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	minBlockSize int64 = 4 * 1024 * 1024
+	maxBlockSize int64 = 4000 * 1024 * 1024
+
+	maxBlockCount int64 = 50000
+)
+
+type storageBlobBlock struct {
+	id      string
+	offset  int64
+	section *io.SectionReader
+}
+
+// blockUploadFromSource uploads the source as a series of blocks which are streamed directly from the source, rather
+// than being buffered in memory, with at most `parallelism` blocks in-flight at once - before committing the block list
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, source io.ReaderAt, sourceSize int64) error {
+	blockList, err := storageBlobBlockSplit(source, sourceSize)
+	if err != nil {
+		return fmt.Errorf("splitting source %q into blocks: %s", sbu.Source, err)
+	}
+
+	// an empty source has no blocks, so an empty list is committed below
+	if len(blockList) > 0 {
+		uploadCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		blocks := make(chan storageBlobBlock, len(blockList))
+		errors := make(chan error, len(blockList))
+		wg := &sync.WaitGroup{}
+
+		for _, block := range blockList {
+			blocks <- block
+		}
+		close(blocks)
+
+		workerCount := sbu.Parallelism
+		if workerCount > len(blockList) {
+			workerCount = len(blockList)
+		}
+		wg.Add(workerCount)
+		for i := 0; i < workerCount; i++ {
+			go func() {
+				defer wg.Done()
+				for block := range blocks {
+					if err := sbu.putBlockFromSection(uploadCtx, block); err != nil {
+						errors <- err
+						// there's no point uploading the remaining blocks since this block list can't be committed
+						cancel()
+						return
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if len(errors) > 0 {
+			return fmt.Errorf("while uploading source %q: %s", sbu.Source, <-errors)
+		}
+	}
+
+	blockIds := make([]blobs.BlockID, 0)
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: block.id,
+		})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.CacheControl != "" {
+		input.CacheControl = utils.String(sbu.CacheControl)
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+// putBlockFromSection uploads a single block, using the section of the source as the request body so that the block
+// is streamed rather than read into memory - the section is re-read should the request need to be retried
+func (sbu BlobUpload) putBlockFromSection(ctx context.Context, block storageBlobBlock) error {
+	input := blobs.PutBlockInput{
+		BlockID: block.id,
+	}
+	req, err := sbu.Client.PutBlockPreparer(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		return fmt.Errorf("preparing block at offset %d: %s", block.offset, err)
+	}
+
+	size := block.section.Size()
+	req.ContentLength = size
+	req.Header.Set("Content-Length", strconv.FormatInt(size, 10))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(io.NewSectionReader(block.section, 0, size)), nil
+	}
+	req.Body, _ = req.GetBody()
+
+	resp, err := sbu.Client.PutBlockSender(req)
+	if err != nil {
+		return fmt.Errorf("writing block at offset %d: %s", block.offset, err)
+	}
+	if _, err := sbu.Client.PutBlockResponder(resp); err != nil {
+		return fmt.Errorf("writing block at offset %d: %s", block.offset, err)
+	}
+
+	return nil
+}
+
+// storageBlobBlockSize returns the smallest block size which allows the source to fit within the maximum number of blocks
+func storageBlobBlockSize(sourceSize int64) (int64, error) {
+	if sourceSize > maxBlockSize*maxBlockCount {
+		return 0, fmt.Errorf("the source is %d bytes but a Block blob can be at most %d bytes", sourceSize, maxBlockSize*maxBlockCount)
+	}
+
+	blockSize := minBlockSize
+	for blockSize*maxBlockCount < sourceSize {
+		blockSize *= 2
+	}
+	if blockSize > maxBlockSize {
+		blockSize = maxBlockSize
+	}
+
+	return blockSize, nil
+}
+
+func storageBlobBlockSplit(source io.ReaderAt, sourceSize int64) ([]storageBlobBlock, error) {
+	blockSize, err := storageBlobBlockSize(sourceSize)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]storageBlobBlock, 0)
+	for offset := int64(0); offset < sourceSize; offset += blockSize {
+		length := blockSize
+		if offset+length > sourceSize {
+			length = sourceSize - offset
+		}
+
+		// Block IDs must be Base64 encoded and the same length for every block within the blob
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%010d", len(blocks))))
+		blocks = append(blocks, storageBlobBlock{
+			id:      id,
+			offset:  offset,
+			section: io.NewSectionReader(source, offset, length),
+		})
+	}
+
+	return blocks, nil
+}
+
+// storageBlobContentHash returns the hex-encoded SHA256 hash of the `source` file or `source_content`, which is
+// computed by streaming the file so that large files don't need to be loaded into memory
+func storageBlobContentHash(source, sourceContent string) (string, error) {
+	sum, err := hashStorageBlobContent(sha256.New(), source, sourceContent)
+	if err != nil || sum == nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(sum), nil
+}
+
+// storageBlobContentMD5 returns the base64-encoded MD5 hash of the `source` file or `source_content`, in the
+// same format as the `Content-MD5` of a blob
+func storageBlobContentMD5(source, sourceContent string) (string, error) {
+	sum, err := hashStorageBlobContent(md5.New(), source, sourceContent) // #nosec G401
+	if err != nil || sum == nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sum), nil
+}
+
+func hashStorageBlobContent(h hash.Hash, source, sourceContent string) ([]byte, error) {
+	switch {
+	case sourceContent != "":
+		if _, err := h.Write([]byte(sourceContent)); err != nil {
+			return nil, err
+		}
+	case source != "":
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, fmt.Errorf("opening %q: %s", source, err)
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(h, file); err != nil {
+			return nil, fmt.Errorf("reading %q: %s", source, err)
+		}
+	default:
+		return nil, nil
+	}
+
+	return h.Sum(nil), nil
+}
+
+// storageBlobSourceUriVersion returns the version of the blob or file at the specified `source_uri`, which is the hex-encoded
+// Content-MD5 where this is available and the ETag otherwise. Blobs within a Storage Account which the Provider can access
+// are retrieved using the Data Plane API, otherwise an anonymous request is made (for example when the URI contains a SAS Token)
+func storageBlobSourceUriVersion(ctx context.Context, storageClient *client.Client, sourceUri string) (string, error) {
+	uri, err := url.Parse(sourceUri)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", sourceUri, err)
+	}
+
+	if strings.Contains(uri.Host, ".blob.") && uri.RawQuery == "" {
+		if id, err := blobs.ParseResourceID(sourceUri); err == nil {
+			account, err := storageClient.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return "", fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %s", id.AccountName, id.BlobName, id.ContainerName, err)
+			}
+			if account != nil {
+				blobsClient, err := storageClient.BlobsClient(ctx, *account)
+				if err != nil {
+					return "", fmt.Errorf("building Blobs Client: %s", err)
+				}
+
+				props, err := blobsClient.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+				if err != nil {
+					return "", fmt.Errorf("retrieving properties for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+				}
+
+				return storageBlobVersion(props.ContentMD5, props.ETag)
+			}
+		}
+	}
+
+	// the query string (which may contain a SAS Token) is omitted from any errors
+	displayUri := (&url.URL{Scheme: uri.Scheme, Host: uri.Host, Path: uri.Path}).String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, sourceUri, nil)
+	if err != nil {
+		return "", fmt.Errorf("building request: %+v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %q: %+v", displayUri, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("retrieving %q: unexpected status code %d", displayUri, resp.StatusCode)
+	}
+
+	return storageBlobVersion(resp.Header.Get("Content-MD5"), resp.Header.Get("ETag"))
+}
+
+func storageBlobVersion(contentMD5, etag string) (string, error) {
+	if contentMD5 != "" {
+		return convertBase64ToHexEncoding(contentMD5)
+	}
+	if etag = strings.Trim(etag, `"`); etag != "" {
+		return etag, nil
+	}
+
+	return "", fmt.Errorf("neither a Content-MD5 nor an ETag was returned")
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
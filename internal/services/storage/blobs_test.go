@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStorageBlobBlockSize(t *testing.T) {
+	cases := []struct {
+		SourceSize int64
+		Expected   int64
+		ShouldErr  bool
+	}{
+		{
+			SourceSize: 0,
+			Expected:   minBlockSize,
+		},
+		{
+			SourceSize: minBlockSize * maxBlockCount,
+			Expected:   minBlockSize,
+		},
+		{
+			SourceSize: minBlockSize*maxBlockCount + 1,
+			Expected:   minBlockSize * 2,
+		},
+		{
+			SourceSize: maxBlockSize * maxBlockCount,
+			Expected:   maxBlockSize,
+		},
+		{
+			SourceSize: maxBlockSize*maxBlockCount + 1,
+			ShouldErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %d", tc.SourceSize)
+
+		actual, err := storageBlobBlockSize(tc.SourceSize)
+		if err != nil {
+			if tc.ShouldErr {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %d but got: %+v", tc.SourceSize, err)
+		}
+		if tc.ShouldErr {
+			t.Fatalf("Expected an error for %d but didn't get one", tc.SourceSize)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected a block size of %d for %d but got %d", tc.Expected, tc.SourceSize, actual)
+		}
+	}
+}
+
+func TestStorageBlobBlockSplit(t *testing.T) {
+	sourceSize := minBlockSize*2 + 512
+	source := strings.NewReader(strings.Repeat("a", int(sourceSize)))
+
+	blocks, err := storageBlobBlockSplit(source, sourceSize)
+	if err != nil {
+		t.Fatalf("splitting: %+v", err)
+	}
+
+	if len(blocks) != 3 {
+		t.Fatalf("Expected 3 blocks but got %d", len(blocks))
+	}
+
+	total := int64(0)
+	for i, block := range blocks {
+		if block.offset != total {
+			t.Fatalf("Expected block %d to start at %d but got %d", i, total, block.offset)
+		}
+		total += block.section.Size()
+
+		if len(block.id) != len(blocks[0].id) {
+			t.Fatalf("Expected all Block IDs to be the same length but %q and %q differ", block.id, blocks[0].id)
+		}
+		if _, err := base64.StdEncoding.DecodeString(block.id); err != nil {
+			t.Fatalf("Expected Block ID %q to be Base64 encoded: %+v", block.id, err)
+		}
+	}
+
+	if total != sourceSize {
+		t.Fatalf("Expected the blocks to total %d bytes but got %d", sourceSize, total)
+	}
+	if last := blocks[2].section.Size(); last != 512 {
+		t.Fatalf("Expected the last block to be 512 bytes but got %d", last)
+	}
+}
+
+func TestStorageBlobContentHash(t *testing.T) {
+	file, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("creating temp file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString("hello world"); err != nil {
+		t.Fatalf("writing temp file: %+v", err)
+	}
+	file.Close()
+
+	// sha256 of "hello world"
+	expected := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+	fromContent, err := storageBlobContentHash("", "hello world")
+	if err != nil {
+		t.Fatalf("hashing content: %+v", err)
+	}
+	if fromContent != expected {
+		t.Fatalf("Expected %q but got %q", expected, fromContent)
+	}
+
+	fromFile, err := storageBlobContentHash(file.Name(), "")
+	if err != nil {
+		t.Fatalf("hashing file: %+v", err)
+	}
+	if fromFile != expected {
+		t.Fatalf("Expected %q but got %q", expected, fromFile)
+	}
+
+	empty, err := storageBlobContentHash("", "")
+	if err != nil {
+		t.Fatalf("hashing nothing: %+v", err)
+	}
+	if empty != "" {
+		t.Fatalf("Expected no hash without a source but got %q", empty)
+	}
+
+	if _, err := storageBlobContentHash(file.Name()+"-missing", ""); err == nil {
+		t.Fatalf("Expected an error for a missing file but didn't get one")
+	}
+}
+
+func TestStorageBlobContentMD5(t *testing.T) {
+	// the base64-encoded md5 of "hello world", as returned in the Content-MD5 of a blob
+	expected := "XrY7u+Ae7tCTyyK7j1rNww=="
+
+	actual, err := storageBlobContentMD5("", "hello world")
+	if err != nil {
+		t.Fatalf("hashing content: %+v", err)
+	}
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	empty, err := storageBlobContentMD5("", "")
+	if err != nil {
+		t.Fatalf("hashing nothing: %+v", err)
+	}
+	if empty != "" {
+		t.Fatalf("Expected no hash without a source but got %q", empty)
+	}
+}
+
+func TestStorageBlobSourceUriVersion(t *testing.T) {
+	cases := []struct {
+		Name       string
+		StatusCode int
+		Headers    map[string]string
+		Expected   string
+		ShouldErr  bool
+	}{
+		{
+			Name:       "Content-MD5",
+			StatusCode: http.StatusOK,
+			Headers: map[string]string{
+				"Content-MD5": "1B2M2Y8AsgTpgAmY7PhCfg==",
+				"ETag":        `"0x8D9A1B2C3D4E5F6"`,
+			},
+			Expected: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		{
+			Name:       "ETag",
+			StatusCode: http.StatusOK,
+			Headers: map[string]string{
+				"ETag": `"0x8D9A1B2C3D4E5F6"`,
+			},
+			Expected: "0x8D9A1B2C3D4E5F6",
+		},
+		{
+			Name:       "No Version",
+			StatusCode: http.StatusOK,
+			ShouldErr:  true,
+		},
+		{
+			Name:       "Not Found",
+			StatusCode: http.StatusNotFound,
+			ShouldErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodHead {
+				t.Errorf("Expected a HEAD request but got %q", r.Method)
+			}
+			for k, v := range tc.Headers {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(tc.StatusCode)
+		}))
+
+		// sources which aren't within a Storage Account known to the Provider are retrieved anonymously
+		actual, err := storageBlobSourceUriVersion(context.TODO(), nil, server.URL+"/container/example.vhd?sig=secret")
+		server.Close()
+		if err != nil {
+			if tc.ShouldErr {
+				if strings.Contains(err.Error(), "secret") {
+					t.Fatalf("Expected the SAS Token to be omitted from the error but got: %+v", err)
+				}
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+		}
+		if tc.ShouldErr {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Name)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q for %q but got %q", tc.Expected, tc.Name, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	legacystorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storagepool/mgmt/2021-08-01/storagepool"
@@ -38,12 +39,14 @@ type Client struct {
 	Environment                 az.Environment
 	FileServicesClient          *storage.FileServicesClient
 	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
+	ResourcesClient             *resources.Client
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
 
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
+	storageAuthorizer         autorest.Authorizer
 }
 
 func NewClient(options *common.ClientOptions) *Client {
@@ -80,6 +83,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	resourcesClient := resources.NewClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&resourcesClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -101,11 +107,13 @@ func NewClient(options *common.ClientOptions) *Client {
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
+		ResourcesClient:             &resourcesClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
+		storageAuthorizer:         options.StorageAuthorizer,
 	}
 
 	if options.StorageUseAzureAD {
@@ -116,9 +124,9 @@ func NewClient(options *common.ClientOptions) *Client {
 }
 
 func (client Client) AccountsDataPlaneClient(ctx context.Context, account accountDetails) (*accounts.Client, error) {
-	if client.storageAdAuth != nil {
+	if authorizer := client.dataPlaneAuthorizer(account); authorizer != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
-		accountsClient.Client.Authorizer = *client.storageAdAuth
+		accountsClient.Client.Authorizer = *authorizer
 		return &accountsClient, nil
 	}
 
@@ -138,9 +146,9 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 }
 
 func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*blobs.Client, error) {
-	if client.storageAdAuth != nil {
+	if authorizer := client.dataPlaneAuthorizer(account); authorizer != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
-		blobsClient.Client.Authorizer = *client.storageAdAuth
+		blobsClient.Client.Authorizer = *authorizer
 		return &blobsClient, nil
 	}
 
@@ -160,9 +168,9 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
-	if client.storageAdAuth != nil {
+	if authorizer := client.dataPlaneAuthorizer(account); authorizer != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
-		containersClient.Client.Authorizer = *client.storageAdAuth
+		containersClient.Client.Authorizer = *authorizer
 		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 		return shim, nil
 	}
@@ -186,6 +194,9 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
 	// NOTE: Files do not support AzureAD Authentication
+	if err := account.RequireSharedKeyAccess("Storage Share Directories"); err != nil {
+		return nil, err
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -204,6 +215,9 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 func (client Client) FileShareFilesClient(ctx context.Context, account accountDetails) (*files.Client, error) {
 	// NOTE: Files do not support AzureAD Authentication
+	if err := account.RequireSharedKeyAccess("Storage Share Files"); err != nil {
+		return nil, err
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -222,6 +236,9 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 func (client Client) FileSharesClient(ctx context.Context, account accountDetails) (shim.StorageShareWrapper, error) {
 	// NOTE: Files do not support AzureAD Authentication
+	if err := account.RequireSharedKeyAccess("Storage Shares"); err != nil {
+		return nil, err
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -240,9 +257,9 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 }
 
 func (client Client) QueuesClient(ctx context.Context, account accountDetails) (shim.StorageQueuesWrapper, error) {
-	if client.storageAdAuth != nil {
+	if authorizer := client.dataPlaneAuthorizer(account); authorizer != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
-		queueClient.Client.Authorizer = *client.storageAdAuth
+		queueClient.Client.Authorizer = *authorizer
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -262,7 +279,11 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 }
 
 func (client Client) TableEntityClient(ctx context.Context, account accountDetails) (*entities.Client, error) {
-	// NOTE: Table Entity does not support AzureAD Authentication
+	if authorizer := client.tablesDataPlaneAuthorizer(account); authorizer != nil {
+		entitiesClient := entities.NewWithEnvironment(client.Environment)
+		entitiesClient.Client.Authorizer = *authorizer
+		return &entitiesClient, nil
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -280,7 +301,11 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 }
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
-	// NOTE: Tables do not support AzureAD Authentication
+	if authorizer := client.tablesDataPlaneAuthorizer(account); authorizer != nil {
+		tablesClient := tables.NewWithEnvironment(client.Environment)
+		tablesClient.Client.Authorizer = *authorizer
+		return shim.NewDataPlaneStorageTableWrapper(&tablesClient), nil
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -297,3 +322,31 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
+
+// dataPlaneAuthorizer returns the Azure AD Authorizer which should be used to access the Data Plane API's for this
+// Storage Account - either when the Provider is configured to use Azure AD, or when Shared Key authorization has been
+// disabled on the Storage Account (in which case the Account Key can't be used). nil is returned when the Account Key
+// should be used instead.
+func (client Client) dataPlaneAuthorizer(account accountDetails) *autorest.Authorizer {
+	if client.storageAdAuth != nil {
+		return client.storageAdAuth
+	}
+
+	if !account.SharedKeyAccessEnabled() {
+		return &client.storageAuthorizer
+	}
+
+	return nil
+}
+
+// tablesDataPlaneAuthorizer returns the Azure AD Authorizer which should be used to access the Table Data Plane API's
+// for this Storage Account - which is only used when Shared Key authorization has been disabled on the Storage Account,
+// since (unlike the other Data Plane API's) Tables have historically always been accessed using the Account Key,
+// regardless of `storage_use_azuread`. nil is returned when the Account Key should be used instead.
+func (client Client) tablesDataPlaneAuthorizer(account accountDetails) *autorest.Authorizer {
+	if account.SharedKeyAccessEnabled() {
+		return nil
+	}
+
+	return client.dataPlaneAuthorizer(account)
+}
@@ -2,17 +2,26 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 var (
 	storageAccountsCache = map[string]accountDetails{}
 
+	// lookupLocks ensures only a single lookup is in progress for each Storage Account at any one time, without
+	// blocking lookups for other Storage Accounts
+	lookupLocks = map[string]*sync.Mutex{}
+
 	accountsLock    = sync.RWMutex{}
 	credentialsLock = sync.RWMutex{}
 )
@@ -34,6 +43,12 @@ func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*strin
 		return ad.accountKey, nil
 	}
 
+	// another operation may have already retrieved the key for this account
+	if existing, ok := getCachedAccount(ad.name); ok && existing.accountKey != nil {
+		ad.accountKey = existing.accountKey
+		return ad.accountKey, nil
+	}
+
 	log.Printf("[DEBUG] Cache Miss - looking up the account key for storage account %q..", ad.name)
 	props, err := client.AccountsClient.ListKeys(ctx, ad.ResourceGroup, ad.name, storage.ListKeyExpandKerb)
 	if err != nil {
@@ -48,21 +63,42 @@ func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*strin
 	ad.accountKey = keys[0].Value
 
 	// force-cache this
+	accountsLock.Lock()
 	storageAccountsCache[ad.name] = *ad
+	accountsLock.Unlock()
 
 	return ad.accountKey, nil
 }
 
-func (client Client) AddToCache(accountName string, props storage.Account) error {
-	accountsLock.Lock()
-	defer accountsLock.Unlock()
+// SharedKeyAccessEnabled returns whether Shared Key authorization (using the Account Key) is permitted
+// for this Storage Account - which is the default when this hasn't been explicitly disabled
+func (ad *accountDetails) SharedKeyAccessEnabled() bool {
+	if ad.Properties == nil || ad.Properties.AllowSharedKeyAccess == nil {
+		return true
+	}
 
+	return *ad.Properties.AllowSharedKeyAccess
+}
+
+// RequireSharedKeyAccess returns an error when Shared Key authorization has been disabled for this Storage Account,
+// for use by the Data Plane API's which don't support authenticating using Azure AD
+func (ad *accountDetails) RequireSharedKeyAccess(resourceType string) error {
+	if ad.SharedKeyAccessEnabled() {
+		return nil
+	}
+
+	return fmt.Errorf("%s require Shared Key authorization, however this is disabled for Storage Account %q (`shared_access_key_enabled` is set to `false`) - either enable Shared Key access or manage these using a different Storage Account", resourceType, ad.name)
+}
+
+func (client Client) AddToCache(accountName string, props storage.Account) error {
 	account, err := populateAccountDetails(accountName, props)
 	if err != nil {
 		return err
 	}
 
+	accountsLock.Lock()
 	storageAccountsCache[accountName] = *account
+	accountsLock.Unlock()
 
 	return nil
 }
@@ -73,14 +109,153 @@ func (client Client) RemoveAccountFromCache(accountName string) {
 	accountsLock.Unlock()
 }
 
+// GetAccount retrieves the details for the Storage Account directly, for use when the Resource Group is known
+func (client Client) GetAccount(ctx context.Context, id parse.StorageAccountId) (*accountDetails, error) {
+	if existing, ok := getCachedAccount(id.Name); ok {
+		return existing, nil
+	}
+
+	lookupLock := accountLookupLock(id.Name)
+	lookupLock.Lock()
+	defer lookupLock.Unlock()
+
+	if existing, ok := getCachedAccount(id.Name); ok {
+		return existing, nil
+	}
+
+	return client.getAndCacheAccount(ctx, id)
+}
+
+// FindAccount retrieves the details for the Storage Account, for use when only the name of the Storage Account is known
+// (for example from a Data Plane URI). The Storage Account is located using a query scoped to its name - falling back to
+// listing every Storage Account within the Subscription when this doesn't find it, since the query can lag behind newly
+// created Storage Accounts
 func (client Client) FindAccount(ctx context.Context, accountName string) (*accountDetails, error) {
-	accountsLock.Lock()
-	defer accountsLock.Unlock()
+	if existing, ok := getCachedAccount(accountName); ok {
+		return existing, nil
+	}
 
-	if existing, ok := storageAccountsCache[accountName]; ok {
-		return &existing, nil
+	lookupLock := accountLookupLock(accountName)
+	lookupLock.Lock()
+	defer lookupLock.Unlock()
+
+	// another operation may have populated the cache whilst we were waiting for the lock
+	if existing, ok := getCachedAccount(accountName); ok {
+		return existing, nil
+	}
+
+	account, err := client.findAccountUsingQuery(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if account != nil {
+		return account, nil
+	}
+
+	log.Printf("[DEBUG] Storage Account %q wasn't found using a scoped query - falling back to listing all Storage Accounts..", accountName)
+	return client.findAccountUsingList(ctx, accountName)
+}
+
+// FindAccountUsingQuery retrieves the details for the Storage Account using a query scoped to its name - unlike FindAccount
+// this doesn't fall back to listing every Storage Account within the Subscription, and as such is suitable for use at
+// plan-time, where the Storage Account may not exist yet. nil is returned when the Storage Account can't be found.
+func (client Client) FindAccountUsingQuery(ctx context.Context, accountName string) (*accountDetails, error) {
+	if existing, ok := getCachedAccount(accountName); ok {
+		return existing, nil
+	}
+
+	lookupLock := accountLookupLock(accountName)
+	lookupLock.Lock()
+	defer lookupLock.Unlock()
+
+	if existing, ok := getCachedAccount(accountName); ok {
+		return existing, nil
+	}
+
+	return client.findAccountUsingQuery(ctx, accountName)
+}
+
+func (client Client) findAccountUsingQuery(ctx context.Context, accountName string) (*accountDetails, error) {
+	id, err := client.findAccountIDUsingQuery(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if id == nil {
+		return nil, nil
 	}
 
+	return client.getAndCacheAccount(ctx, *id)
+}
+
+func (client Client) findAccountIDUsingQuery(ctx context.Context, accountName string) (*parse.StorageAccountId, error) {
+	filter := fmt.Sprintf("resourceType eq 'Microsoft.Storage/storageAccounts' and name eq '%s'", accountName)
+	result, err := client.ResourcesClient.List(ctx, filter, "", utils.Int32(5))
+	if err != nil {
+		if resourceFilterUnsupported(err) {
+			log.Printf("[DEBUG] Filtering Resources by name isn't supported - unable to locate Storage Account %q using a scoped query: %+v", accountName, err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing resources matching %q: %+v", filter, err)
+	}
+
+	for result.NotDone() {
+		for _, v := range result.Values() {
+			if v.ID == nil {
+				continue
+			}
+
+			id, err := parse.StorageAccountID(*v.ID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %+v", *v.ID, err)
+			}
+			if !strings.EqualFold(id.Name, accountName) {
+				continue
+			}
+
+			return id, nil
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("iterating over results: %+v", err)
+		}
+	}
+
+	return nil, nil
+}
+
+// resourceFilterUnsupported returns whether listing Resources failed because the filter isn't supported (e.g. by some
+// Azure Stack environments) - in which case the Storage Account is located by listing all Storage Accounts instead
+func resourceFilterUnsupported(err error) bool {
+	var requestErr *azure.RequestError
+	if !errors.As(err, &requestErr) || requestErr.Response == nil || requestErr.ServiceError == nil {
+		return false
+	}
+
+	return requestErr.Response.StatusCode == http.StatusBadRequest && strings.EqualFold(requestErr.ServiceError.Code, "InvalidFilterInQueryString")
+}
+
+func (client Client) getAndCacheAccount(ctx context.Context, id parse.StorageAccountId) (*accountDetails, error) {
+	props, err := client.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(props.Response) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	account, err := populateAccountDetails(id.Name, props)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsLock.Lock()
+	storageAccountsCache[id.Name] = *account
+	accountsLock.Unlock()
+
+	return account, nil
+}
+
+func (client Client) findAccountUsingList(ctx context.Context, accountName string) (*accountDetails, error) {
 	accountsPage, err := client.AccountsClient.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving storage accounts: %+v", err)
@@ -95,6 +270,9 @@ func (client Client) FindAccount(ctx context.Context, accountName string) (*acco
 		}
 	}
 
+	accountsLock.Lock()
+	defer accountsLock.Unlock()
+
 	for _, v := range accounts {
 		if v.Name == nil {
 			continue
@@ -105,6 +283,11 @@ func (client Client) FindAccount(ctx context.Context, accountName string) (*acco
 			return nil, err
 		}
 
+		// retain any account key which has already been retrieved
+		if existing, ok := storageAccountsCache[*v.Name]; ok {
+			account.accountKey = existing.accountKey
+		}
+
 		storageAccountsCache[*v.Name] = *account
 	}
 
@@ -115,6 +298,29 @@ func (client Client) FindAccount(ctx context.Context, accountName string) (*acco
 	return nil, nil
 }
 
+func getCachedAccount(accountName string) (*accountDetails, bool) {
+	accountsLock.RLock()
+	defer accountsLock.RUnlock()
+
+	existing, ok := storageAccountsCache[accountName]
+	if !ok {
+		return nil, false
+	}
+
+	return &existing, true
+}
+
+func accountLookupLock(accountName string) *sync.Mutex {
+	accountsLock.Lock()
+	defer accountsLock.Unlock()
+
+	if lookupLocks[accountName] == nil {
+		lookupLocks[accountName] = &sync.Mutex{}
+	}
+
+	return lookupLocks[accountName]
+}
+
 func populateAccountDetails(accountName string, props storage.Account) (*accountDetails, error) {
 	if props.ID == nil {
 		return nil, fmt.Errorf("`id` was nil for Account %q", accountName)
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestStorageAccountCacheConcurrentAccess(t *testing.T) {
+	client := Client{}
+	accountNames := []string{"cacheaccount1", "cacheaccount2", "cacheaccount3"}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		for _, accountName := range accountNames {
+			wg.Add(1)
+			go func(accountName string) {
+				defer wg.Done()
+
+				id := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/%s", accountName)
+				if err := client.AddToCache(accountName, storage.Account{ID: utils.String(id)}); err != nil {
+					t.Errorf("adding %q to the cache: %+v", accountName, err)
+					return
+				}
+
+				lock := accountLookupLock(accountName)
+				lock.Lock()
+				account, ok := getCachedAccount(accountName)
+				lock.Unlock()
+
+				if !ok {
+					t.Errorf("expected %q to be cached", accountName)
+					return
+				}
+				if account.ResourceGroup != "group1" {
+					t.Errorf("expected the Resource Group for %q to be %q but got %q", accountName, "group1", account.ResourceGroup)
+				}
+			}(accountName)
+		}
+	}
+	wg.Wait()
+
+	for _, accountName := range accountNames {
+		if accountLookupLock(accountName) != accountLookupLock(accountName) {
+			t.Fatalf("expected a single lookup lock for %q", accountName)
+		}
+
+		client.RemoveAccountFromCache(accountName)
+		if _, ok := getCachedAccount(accountName); ok {
+			t.Fatalf("expected %q to have been removed from the cache", accountName)
+		}
+	}
+}
+
+func TestStorageAccountSharedKeyAccess(t *testing.T) {
+	testData := []struct {
+		name       string
+		props      *storage.AccountProperties
+		expected   bool
+		useAzureAD bool
+	}{
+		{
+			name:     "no properties",
+			props:    nil,
+			expected: true,
+		},
+		{
+			name:     "not specified",
+			props:    &storage.AccountProperties{},
+			expected: true,
+		},
+		{
+			name: "enabled",
+			props: &storage.AccountProperties{
+				AllowSharedKeyAccess: utils.Bool(true),
+			},
+			expected: true,
+		},
+		{
+			name: "enabled using azuread",
+			props: &storage.AccountProperties{
+				AllowSharedKeyAccess: utils.Bool(true),
+			},
+			expected:   true,
+			useAzureAD: true,
+		},
+		{
+			name: "disabled",
+			props: &storage.AccountProperties{
+				AllowSharedKeyAccess: utils.Bool(false),
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		account := accountDetails{
+			name:       "example",
+			Properties: v.props,
+		}
+		if actual := account.SharedKeyAccessEnabled(); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+
+		err := account.RequireSharedKeyAccess("Storage Shares")
+		if v.expected && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !v.expected && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		client := Client{
+			storageAuthorizer: autorest.NullAuthorizer{},
+		}
+		if v.useAzureAD {
+			client.storageAdAuth = &client.storageAuthorizer
+		}
+		// Azure AD should be used when configured, or when the Account Key can't be used
+		if authorizer := client.dataPlaneAuthorizer(account); (authorizer != nil) != (v.useAzureAD || !v.expected) {
+			t.Fatalf("expected the Azure AD Authorizer to be used: %t", v.useAzureAD || !v.expected)
+		}
+		// whereas Tables only use Azure AD when the Account Key can't be used
+		if authorizer := client.tablesDataPlaneAuthorizer(account); (authorizer != nil) != !v.expected {
+			t.Fatalf("expected the Azure AD Authorizer to be used for Tables: %t", !v.expected)
+		}
+	}
+}
+
+func TestResourceFilterUnsupported(t *testing.T) {
+	// mirrors the error returned by the Resources Client when the API returns an error
+	requestError := func(statusCode int, code string) error {
+		resp := &http.Response{StatusCode: statusCode}
+		return autorest.NewErrorWithError(&azure.RequestError{
+			DetailedError: autorest.DetailedError{
+				StatusCode: statusCode,
+				Response:   resp,
+			},
+			ServiceError: &azure.ServiceError{
+				Code: code,
+			},
+		}, "resources.Client", "List", resp, "Failure responding to request")
+	}
+
+	testData := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "unsupported filter",
+			err:      requestError(http.StatusBadRequest, "InvalidFilterInQueryString"),
+			expected: true,
+		},
+		{
+			name:     "other bad request",
+			err:      requestError(http.StatusBadRequest, "InvalidApiVersionParameter"),
+			expected: false,
+		},
+		{
+			name:     "forbidden",
+			err:      requestError(http.StatusForbidden, "AuthorizationFailed"),
+			expected: false,
+		},
+		{
+			name:     "throttled",
+			err:      requestError(http.StatusTooManyRequests, "TooManyRequests"),
+			expected: false,
+		},
+		{
+			name:     "network error",
+			err:      autorest.NewErrorWithError(fmt.Errorf("dial tcp: i/o timeout"), "resources.Client", "List", nil, "Failure sending request"),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if actual := resourceFilterUnsupported(v.err); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
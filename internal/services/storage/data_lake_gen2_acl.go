@@ -0,0 +1,280 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/datalakestore/paths"
+	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
+)
+
+const (
+	// the `setAccessControlRecursive` operation isn't available in the Data Lake Gen2 SDK in use, which also targets an
+	// older API Version than this operation requires - so these requests are built using the same (authenticated) client
+	dataLakeGen2RecursiveAccessControlAPIVersion = "2020-02-10"
+
+	// checking for drift requires retrieving the ACL for each nested path individually (and so is opt-in, through
+	// `recursive_ace_drift_detection_enabled`) - this is bounded to the first N nested paths, which are checked
+	// concurrently using at most this many requests at once
+	dataLakeGen2AccessControlDriftMaxPaths    = 1000
+	dataLakeGen2AccessControlDriftParallelism = 10
+)
+
+type dataLakeGen2Path struct {
+	Name        string
+	IsDirectory bool
+}
+
+type dataLakeGen2SetAccessControlRecursiveResult struct {
+	DirectoriesSuccessful int64 `json:"directoriesSuccessful"`
+	FilesSuccessful       int64 `json:"filesSuccessful"`
+	FailureCount          int64 `json:"failureCount"`
+	FailedEntries         []struct {
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+		ErrorMessage string `json:"errorMessage"`
+	} `json:"failedEntries"`
+}
+
+type dataLakeGen2ListPathsResult struct {
+	Paths []struct {
+		Name string `json:"name"`
+		// this is returned as a string ("true") rather than as a boolean
+		IsDirectory json.RawMessage `json:"isDirectory"`
+	} `json:"paths"`
+}
+
+// setDataLakeGen2AccessControlRecursive replaces the ACL for the specified path (where `/` is the root of the
+// File System) and all of its existing children - where any Default entries are applied to directories only
+func setDataLakeGen2AccessControlRecursive(ctx context.Context, client paths.Client, accountName, fileSystemName, path string, acl accesscontrol.ACL) error {
+	continuation := ""
+	for {
+		queryParameters := map[string]interface{}{
+			"action": autorest.Encode("query", "setAccessControlRecursive"),
+			"mode":   autorest.Encode("query", "set"),
+		}
+		if continuation != "" {
+			queryParameters["continuation"] = autorest.Encode("query", continuation)
+		}
+
+		preparer := autorest.CreatePreparer(
+			autorest.AsPatch(),
+			autorest.WithBaseURL(dataLakeGen2Endpoint(client, accountName)),
+			autorest.WithPathParameters("/{fileSystemName}/{path}", map[string]interface{}{
+				"fileSystemName": autorest.Encode("path", fileSystemName),
+				"path":           autorest.Encode("path", path),
+			}),
+			autorest.WithQueryParameters(queryParameters),
+			autorest.WithHeaders(map[string]interface{}{
+				"x-ms-version": dataLakeGen2RecursiveAccessControlAPIVersion,
+				"x-ms-acl":     acl.String(),
+			}))
+		req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("preparing request: %+v", err)
+		}
+
+		var result dataLakeGen2SetAccessControlRecursiveResult
+		resp, err := sendDataLakeGen2Request(client, req, &result)
+		if err != nil {
+			return err
+		}
+
+		if result.FailureCount > 0 {
+			failures := make([]string, 0)
+			for _, entry := range result.FailedEntries {
+				failures = append(failures, fmt.Sprintf("%s %q: %s", entry.Type, entry.Name, entry.ErrorMessage))
+			}
+			return fmt.Errorf("%d paths failed to be updated: %s", result.FailureCount, strings.Join(failures, ", "))
+		}
+
+		log.Printf("[DEBUG] Updated the ACL for %d directories and %d files within %q in File System %q (Account %q)", result.DirectoriesSuccessful, result.FilesSuccessful, path, fileSystemName, accountName)
+
+		if continuation = resp.Header.Get("x-ms-continuation"); continuation == "" {
+			return nil
+		}
+	}
+}
+
+// listDataLakeGen2Paths returns up to `maxPaths` of the paths nested within the specified directory, where an empty
+// directory lists the entire File System
+func listDataLakeGen2Paths(ctx context.Context, client paths.Client, accountName, fileSystemName, directory string, maxPaths int) ([]dataLakeGen2Path, error) {
+	output := make([]dataLakeGen2Path, 0)
+
+	continuation := ""
+	for {
+		queryParameters := map[string]interface{}{
+			"resource":   autorest.Encode("query", "filesystem"),
+			"recursive":  autorest.Encode("query", "true"),
+			"maxResults": autorest.Encode("query", maxPaths-len(output)),
+		}
+		if directory != "" {
+			queryParameters["directory"] = autorest.Encode("query", directory)
+		}
+		if continuation != "" {
+			queryParameters["continuation"] = autorest.Encode("query", continuation)
+		}
+
+		preparer := autorest.CreatePreparer(
+			autorest.AsGet(),
+			autorest.WithBaseURL(dataLakeGen2Endpoint(client, accountName)),
+			autorest.WithPathParameters("/{fileSystemName}", map[string]interface{}{
+				"fileSystemName": autorest.Encode("path", fileSystemName),
+			}),
+			autorest.WithQueryParameters(queryParameters),
+			autorest.WithHeaders(map[string]interface{}{
+				"x-ms-version": paths.APIVersion,
+			}))
+		req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("preparing request: %+v", err)
+		}
+
+		var result dataLakeGen2ListPathsResult
+		resp, err := sendDataLakeGen2Request(client, req, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range result.Paths {
+			output = append(output, dataLakeGen2Path{
+				Name:        v.Name,
+				IsDirectory: strings.EqualFold(strings.Trim(string(v.IsDirectory), `"`), "true"),
+			})
+		}
+
+		if continuation = resp.Header.Get("x-ms-continuation"); continuation == "" {
+			return output, nil
+		}
+		if len(output) >= maxPaths {
+			log.Printf("[WARN] %q in File System %q (Account %q) contains more than %d paths - only the ACLs of the first %d paths are checked for drift", directory, fileSystemName, accountName, maxPaths, maxPaths)
+			return output[:maxPaths], nil
+		}
+	}
+}
+
+// findDataLakeGen2PathWithDifferentAccessControl returns the name of the first path nested within the specified
+// directory whose ACL differs from the specified ACL, or an empty string when these all match. Only the first
+// dataLakeGen2AccessControlDriftMaxPaths nested paths are checked, since each requires a separate request
+func findDataLakeGen2PathWithDifferentAccessControl(ctx context.Context, client paths.Client, accountName, fileSystemName, directory string, acl accesscontrol.ACL) (string, error) {
+	children, err := listDataLakeGen2Paths(ctx, client, accountName, fileSystemName, directory, dataLakeGen2AccessControlDriftMaxPaths)
+	if err != nil {
+		return "", fmt.Errorf("listing paths within %q: %+v", directory, err)
+	}
+
+	// the results are tracked per path so that the first path (rather than any path) which differs is returned
+	differs := make([]bool, len(children))
+	failures := make([]error, len(children))
+
+	wg := sync.WaitGroup{}
+	semaphore := make(chan struct{}, dataLakeGen2AccessControlDriftParallelism)
+	for i, child := range children {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, child dataLakeGen2Path) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			resp, err := client.GetProperties(ctx, accountName, fileSystemName, child.Name, paths.GetPropertiesActionGetAccessControl)
+			if err != nil {
+				failures[i] = fmt.Errorf("retrieving ACL for %q: %+v", child.Name, err)
+				return
+			}
+
+			actual, err := accesscontrol.ParseACL(resp.ACL)
+			if err != nil {
+				failures[i] = fmt.Errorf("parsing ACL %q for %q: %+v", resp.ACL, child.Name, err)
+				return
+			}
+
+			differs[i] = !dataLakeGen2AccessControlMatches(acl, actual, child.IsDirectory)
+		}(i, child)
+	}
+	wg.Wait()
+
+	for i, child := range children {
+		if failures[i] != nil {
+			return "", failures[i]
+		}
+		if differs[i] {
+			return child.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// dataLakeGen2RecursiveAccessControlDiff plans for the ACL to be re-applied recursively when Read found a nested path
+// whose ACL differs (exposed as `recursive_ace_drifted_path`) - so that the drifted path is shown in the plan
+func dataLakeGen2RecursiveAccessControlDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.Get("recursive_ace_enabled").(bool) {
+		return nil
+	}
+
+	if old, _ := d.GetChange("recursive_ace_drifted_path"); old.(string) != "" {
+		return d.SetNew("recursive_ace_drifted_path", "")
+	}
+
+	return nil
+}
+
+// dataLakeGen2AccessControlMatches returns whether the actual ACL of a path matches the expected ACL - since files
+// can't have Default entries, only the Access entries are compared for files
+func dataLakeGen2AccessControlMatches(expected, actual accesscontrol.ACL, isDirectory bool) bool {
+	entries := func(acl accesscontrol.ACL) map[string]struct{} {
+		output := make(map[string]struct{})
+		for _, ace := range acl.Entries {
+			if ace.IsDefault && !isDirectory {
+				continue
+			}
+			output[ace.String()] = struct{}{}
+		}
+		return output
+	}
+
+	expectedEntries := entries(expected)
+	actualEntries := entries(actual)
+	if len(expectedEntries) != len(actualEntries) {
+		return false
+	}
+	for k := range expectedEntries {
+		if _, ok := actualEntries[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataLakeGen2Endpoint(client paths.Client, accountName string) string {
+	return fmt.Sprintf("https://%s.dfs.%s", accountName, client.BaseURI)
+}
+
+func sendDataLakeGen2Request(client paths.Client, req *http.Request, result interface{}) (*http.Response, error) {
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return resp, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, fmt.Errorf("responding to request: %+v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
+)
+
+func TestDataLakeGen2AccessControlMatches(t *testing.T) {
+	cases := []struct {
+		Expected    string
+		Actual      string
+		IsDirectory bool
+		Matches     bool
+	}{
+		{
+			Expected:    "user::rwx,group::r-x,other::---",
+			Actual:      "user::rwx,group::r-x,other::---",
+			IsDirectory: true,
+			Matches:     true,
+		},
+		{
+			// ordering doesn't matter
+			Expected:    "user::rwx,group::r-x,other::---",
+			Actual:      "other::---,user::rwx,group::r-x",
+			IsDirectory: true,
+			Matches:     true,
+		},
+		{
+			Expected:    "user::rwx,group::r-x,other::---",
+			Actual:      "user::rwx,group::rwx,other::---",
+			IsDirectory: true,
+			Matches:     false,
+		},
+		{
+			Expected:    "user::rwx,user:00000000-0000-0000-0000-000000000000:r-x,group::r-x,mask::r-x,other::---",
+			Actual:      "user::rwx,group::r-x,mask::r-x,other::---",
+			IsDirectory: false,
+			Matches:     false,
+		},
+		{
+			Expected:    "user::rwx,group::r-x,other::---,default:user::rwx,default:group::r-x,default:other::---",
+			Actual:      "user::rwx,group::r-x,other::---",
+			IsDirectory: true,
+			Matches:     false,
+		},
+		{
+			// files can't have default entries
+			Expected:    "user::rwx,group::r-x,other::---,default:user::rwx,default:group::r-x,default:other::---",
+			Actual:      "user::rwx,group::r-x,other::---",
+			IsDirectory: false,
+			Matches:     true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q against %q (directory: %t)", v.Actual, v.Expected, v.IsDirectory)
+
+		expected, err := accesscontrol.ParseACL(v.Expected)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Expected, err)
+		}
+		actual, err := accesscontrol.ParseACL(v.Actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Actual, err)
+		}
+
+		if matches := dataLakeGen2AccessControlMatches(expected, actual, v.IsDirectory); matches != v.Matches {
+			t.Fatalf("expected %t but got %t", v.Matches, matches)
+		}
+	}
+}
@@ -1,12 +1,20 @@
 package storage
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var _ sdk.ServiceRegistrationWithFeatureFlags = Registration{}
+
+const (
+	featuresBlockName                   = "storage"
+	featureRecoverSoftDeletedContainers = "recover_soft_deleted_containers"
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Storage"
@@ -71,3 +79,18 @@ func (r Registration) Resources() []sdk.Resource {
 		DisksPoolResource{},
 	}
 }
+
+// FeatureFlags returns the Feature Flags exposed by this Service within the `features` block
+func (r Registration) FeatureFlags() map[string][]features.FeatureFlag {
+	// NOTE: Soft-Deleted Blobs and Containers can't be purged, they're removed once the retention period has passed
+	return map[string][]features.FeatureFlag{
+		featuresBlockName: {
+			{
+				Name:        featureRecoverSoftDeletedContainers,
+				Type:        pluginsdk.TypeBool,
+				Default:     false,
+				Description: "Should the `azurerm_storage_container` resources recover a Soft-Deleted Container with the same name, rather than creating a new Container?",
+			},
+		},
+	}
+}
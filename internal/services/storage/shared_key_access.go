@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// validateStorageAccountSharedKeyAccess returns an error at plan-time when the specified Storage Account already exists
+// and has Shared Key authorization disabled, for resources whose Data Plane API's don't support Azure AD authentication.
+// Storage Accounts which can't be found yet (e.g. those created in the same apply) are checked when the client is built.
+func validateStorageAccountSharedKeyAccess(ctx context.Context, meta interface{}, accountName string, resourceType string) error {
+	if accountName == "" {
+		return nil
+	}
+
+	account, err := meta.(*clients.Client).Storage.FindAccountUsingQuery(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q: %+v", accountName, err)
+	}
+	if account == nil {
+		return nil
+	}
+
+	return account.RequireSharedKeyAccess(resourceType)
+}
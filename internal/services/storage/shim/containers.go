@@ -10,7 +10,9 @@ type StorageContainerWrapper interface {
 	Create(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput) error
 	Delete(ctx context.Context, resourceGroup, accountName, containerName string) error
 	Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error)
+	FindSoftDeleted(ctx context.Context, resourceGroup, accountName, containerName string) (*string, error)
 	Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error)
+	Restore(ctx context.Context, resourceGroup, accountName, containerName, deletedVersion string) error
 	UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metadata map[string]string) error
 }
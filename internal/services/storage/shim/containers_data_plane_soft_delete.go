@@ -0,0 +1,152 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+// NOTE: Giovanni doesn't (yet) support listing or restoring Soft-Deleted Containers, so these
+// requests are made against the Data Plane API directly using the Containers Client
+
+type listContainersResult struct {
+	Containers []listContainersResultContainer `xml:"Containers>Container"`
+	NextMarker string                          `xml:"NextMarker"`
+}
+
+type listContainersResultContainer struct {
+	Name       string `xml:"Name"`
+	Deleted    bool   `xml:"Deleted"`
+	Version    string `xml:"Version"`
+	Properties struct {
+		DeletedTime string `xml:"DeletedTime"`
+	} `xml:"Properties"`
+}
+
+// FindSoftDeleted returns the Version of the most recently Soft-Deleted Container with the specified name, if any
+func (w DataPlaneStorageContainerWrapper) FindSoftDeleted(ctx context.Context, _, accountName, containerName string) (*string, error) {
+	var version *string
+	var latest time.Time
+	marker := ""
+	for {
+		result, err := w.listContainersIncludingDeleted(ctx, accountName, containerName, marker)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range result.Containers {
+			if !v.Deleted || v.Name != containerName || v.Version == "" {
+				continue
+			}
+
+			// there can be multiple Soft-Deleted versions of a Container, so we want the latest one
+			deletedTime, err := time.Parse(time.RFC1123, v.Properties.DeletedTime)
+			if err != nil {
+				deletedTime = time.Time{}
+			}
+			if version == nil || deletedTime.After(latest) {
+				containerVersion := v.Version
+				version = &containerVersion
+				latest = deletedTime
+			}
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	return version, nil
+}
+
+// listContainersIncludingDeleted returns a page of the Containers (including Soft-Deleted Containers) prefixed with the specified name
+func (w DataPlaneStorageContainerWrapper) listContainersIncludingDeleted(ctx context.Context, accountName, prefix, marker string) (*listContainersResult, error) {
+	queryParameters := map[string]interface{}{
+		"comp":    autorest.Encode("query", "list"),
+		"include": autorest.Encode("query", "deleted"),
+		"prefix":  autorest.Encode("query", prefix),
+	}
+	if marker != "" {
+		queryParameters["marker"] = autorest.Encode("query", marker)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(w.blobEndpoint(accountName)),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": containers.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("preparing request to list Soft-Deleted Containers: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(w.client, req, azure.DoRetryWithRegistration(w.client.Client))
+	if err != nil {
+		return nil, fmt.Errorf("listing Soft-Deleted Containers: %+v", err)
+	}
+
+	var result listContainersResult
+	err = autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, fmt.Errorf("listing Soft-Deleted Containers: %+v", err)
+	}
+
+	return &result, nil
+}
+
+// Restore restores the Soft-Deleted Container with the specified name and version
+func (w DataPlaneStorageContainerWrapper) Restore(ctx context.Context, _, accountName, containerName, deletedVersion string) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsPut(),
+		autorest.WithBaseURL(w.blobEndpoint(accountName)),
+		autorest.WithPathParameters("/{containerName}", map[string]interface{}{
+			"containerName": autorest.Encode("path", containerName),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": autorest.Encode("query", "container"),
+			"comp":    autorest.Encode("query", "undelete"),
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version":                   containers.APIVersion,
+			"x-ms-deleted-container-name":    containerName,
+			"x-ms-deleted-container-version": deletedVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("preparing request to restore Container %q: %+v", containerName, err)
+	}
+
+	resp, err := autorest.SendWithSender(w.client, req, azure.DoRetryWithRegistration(w.client.Client))
+	if err != nil {
+		return fmt.Errorf("restoring Container %q: %+v", containerName, err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return fmt.Errorf("restoring Container %q: %+v", containerName, err)
+	}
+
+	return nil
+}
+
+func (w DataPlaneStorageContainerWrapper) blobEndpoint(accountName string) string {
+	return fmt.Sprintf("https://%s.blob.%s", accountName, w.client.BaseURI)
+}
@@ -977,7 +977,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			EnableHTTPSTrafficOnly: &enableHTTPSTrafficOnly,
@@ -1146,7 +1146,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	if val, ok := d.GetOk("queue_properties"); ok {
 		storageClient := meta.(*clients.Client).Storage
-		account, err := storageClient.FindAccount(ctx, id.Name)
+		account, err := storageClient.GetAccount(ctx, id)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 		}
@@ -1190,7 +1190,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		storageClient := meta.(*clients.Client).Storage
 
-		account, err := storageClient.FindAccount(ctx, id.Name)
+		account, err := storageClient.GetAccount(ctx, id)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 		}
@@ -1274,6 +1274,11 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("updating Azure Storage Account AllowSharedKeyAccess %q: %+v", id.Name, err)
 	}
 
+	if d.HasChange("shared_access_key_enabled") {
+		// the cached account details determine how the Data Plane clients authenticate, so need to be refreshed
+		meta.(*clients.Client).Storage.RemoveAccountFromCache(id.Name)
+	}
+
 	if d.HasChange("account_replication_type") {
 		sku := storage.Sku{
 			Name: storage.SkuName(storageType),
@@ -1316,7 +1321,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		t := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: tags.Expand(t),
+			Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 		}
 
 		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
@@ -1517,7 +1522,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	if d.HasChange("queue_properties") {
 		storageClient := meta.(*clients.Client).Storage
-		account, err := storageClient.FindAccount(ctx, id.Name)
+		account, err := storageClient.GetAccount(ctx, *id)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 		}
@@ -1561,7 +1566,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		storageClient := meta.(*clients.Client).Storage
 
-		account, err := storageClient.FindAccount(ctx, id.Name)
+		account, err := storageClient.GetAccount(ctx, *id)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 		}
@@ -1782,7 +1787,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	storageClient := meta.(*clients.Client).Storage
-	account, err := storageClient.FindAccount(ctx, id.Name)
+	account, err := storageClient.GetAccount(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 	}
@@ -1851,7 +1856,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	if resp.Kind == storage.KindStorageV2 || resp.Kind == storage.KindBlockBlobStorage {
 		storageClient := meta.(*clients.Client).Storage
 
-		account, err := storageClient.FindAccount(ctx, id.Name)
+		account, err := storageClient.GetAccount(ctx, *id)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
 		}
@@ -1875,7 +1880,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `static_website `for AzureRM Storage Account %q: %+v", id.Name, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
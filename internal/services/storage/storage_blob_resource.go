@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -105,21 +107,19 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"source": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
 			"source_content": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source", "source_uri"},
 			},
 
+			// the version of the source blob/file is tracked in `content_hash`, so that changes to the source are copied
 			"source_uri": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source", "source_content"},
 			},
 
@@ -130,22 +130,29 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ConflictsWith: []string{"source_uri"},
 			},
 
+			// the hash of `source` / `source_content` (or the version of `source_uri`) is tracked so that changes to the content
+			// can be detected at plan time
+			"content_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"url": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
-				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageBlobContentHashDiff),
 	}
 }
 
@@ -221,6 +228,10 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.SetId(id)
 
+	if err := resourceStorageBlobSetContentHash(ctx, d, storageClient); err != nil {
+		return err
+	}
+
 	return resourceStorageBlobUpdate(d, meta)
 }
 
@@ -247,7 +258,55 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
-	if d.HasChange("access_tier") {
+	// blobs created before `content_hash` was tracked are backfilled when read (using their Content-MD5), however imported blobs
+	// and those without a Content-MD5 have no hash of the uploaded content - in which case the content is assumed to be
+	// up-to-date, rather than re-uploading every such blob. A change to the `source_uri` is always copied
+	oldContentHash, _ := d.GetChange("content_hash")
+	contentChanged := oldContentHash.(string) != "" && d.HasChanges("source", "source_content", "content_hash")
+	reuploaded := false
+	if !d.IsNewResource() && (contentChanged || d.HasChange("source_uri")) {
+		log.Printf("[DEBUG] Re-uploading the content for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+
+		contentMD5 := ""
+		if contentMD5Raw := d.Get("content_md5").(string); contentMD5Raw != "" {
+			contentMD5, err = convertHexToBase64Encoding(contentMD5Raw)
+			if err != nil {
+				return fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
+			}
+		}
+
+		blobInput := BlobUpload{
+			AccountName:   id.AccountName,
+			ContainerName: id.ContainerName,
+			BlobName:      id.BlobName,
+			Client:        blobsClient,
+
+			BlobType:      d.Get("type").(string),
+			CacheControl:  d.Get("cache_control").(string),
+			ContentType:   d.Get("content_type").(string),
+			ContentMD5:    contentMD5,
+			MetaData:      ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+			Parallelism:   d.Get("parallelism").(int),
+			Size:          d.Get("size").(int),
+			Source:        d.Get("source").(string),
+			SourceContent: d.Get("source_content").(string),
+			SourceUri:     d.Get("source_uri").(string),
+		}
+		if err := blobInput.Create(ctx); err != nil {
+			return fmt.Errorf("re-uploading the content for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+
+		if err := resourceStorageBlobSetContentHash(ctx, d, storageClient); err != nil {
+			return err
+		}
+
+		reuploaded = true
+		log.Printf("[DEBUG] Re-uploaded the content for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	// re-uploading a Block blob resets the Access Tier to the default for the Storage Account, so this needs to be set again
+	accessTierNeedsReapplying := reuploaded && strings.EqualFold(d.Get("type").(string), "Block") && d.Get("access_tier").(string) != ""
+	if d.HasChange("access_tier") || accessTierNeedsReapplying {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
@@ -297,6 +356,80 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	return resourceStorageBlobRead(d, meta)
 }
 
+func resourceStorageBlobContentHashDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_uri") || d.Get("source_uri").(string) != "" {
+		return resourceStorageBlobSourceUriVersionDiff(ctx, d, meta.(*clients.Client).Storage)
+	}
+
+	// the file may not exist until apply-time when `source` is generated by another resource
+	if !d.NewValueKnown("source") || !d.NewValueKnown("source_content") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	source := d.Get("source").(string)
+	hash, err := storageBlobContentHash(source, d.Get("source_content").(string))
+	if err != nil {
+		log.Printf("[DEBUG] Unable to hash the source %q for Blob %q at plan time, the hash will be computed during apply: %s", source, d.Get("name").(string), err)
+		return d.SetNewComputed("content_hash")
+	}
+
+	if hash != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
+
+// resourceStorageBlobSourceUriVersionDiff retrieves the version of the blob/file at the `source_uri` during each plan, so
+// that the blob is copied again when the source changes - when the version can't be retrieved the source is assumed to
+// be unchanged, rather than failing the plan
+func resourceStorageBlobSourceUriVersionDiff(ctx context.Context, d *pluginsdk.ResourceDiff, storageClient *client.Client) error {
+	// the version is recorded once the blob has been copied from the new `source_uri`
+	if !d.NewValueKnown("source_uri") || d.HasChange("source_uri") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	version, err := storageBlobSourceUriVersion(ctx, storageClient, d.Get("source_uri").(string))
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the version of the source for Blob %q at plan time, changes to the source can't be detected: %s", d.Get("name").(string), err)
+		return nil
+	}
+
+	if version != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", version)
+	}
+
+	return nil
+}
+
+// resourceStorageBlobSetContentHash sets the `content_hash` planned during plan-time - only hashing the content (or
+// retrieving the version of the `source_uri`) when this couldn't be determined during the plan, since this requires
+// reading the entire file
+func resourceStorageBlobSetContentHash(ctx context.Context, d *pluginsdk.ResourceData, storageClient *client.Client) error {
+	if d.Get("content_hash").(string) != "" {
+		return nil
+	}
+
+	if sourceUri := d.Get("source_uri").(string); sourceUri != "" {
+		version, err := storageBlobSourceUriVersion(ctx, storageClient, sourceUri)
+		if err != nil {
+			// the blob has been copied, so this is recorded during the next plan rather than failing the apply
+			log.Printf("[DEBUG] Unable to retrieve the version of the source for Blob %q: %s", d.Get("name").(string), err)
+			return nil
+		}
+		d.Set("content_hash", version)
+		return nil
+	}
+
+	hash, err := storageBlobContentHash(d.Get("source").(string), d.Get("source_content").(string))
+	if err != nil {
+		return fmt.Errorf("hashing the content of Blob %q: %s", d.Get("name").(string), err)
+	}
+	d.Set("content_hash", hash)
+
+	return nil
+}
+
 func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -365,6 +498,52 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("source_uri", props.CopySource)
 	}
 
+	// blobs created before `content_hash` was tracked don't have this in the state, so this is backfilled - however since
+	// the hash of the content which was last uploaded isn't known, the current `source`/`source_content` is compared to the
+	// Content-MD5 of the blob, so that a blob whose content is out-of-date is re-uploaded rather than being assumed current
+	if d.Get("content_hash").(string) == "" && d.Get("source_uri").(string) != "" {
+		// the version of the source which was copied isn't known, so the blob is assumed to be up-to-date with the source
+		if version, err := storageBlobSourceUriVersion(ctx, storageClient, d.Get("source_uri").(string)); err == nil {
+			d.Set("content_hash", version)
+		} else {
+			log.Printf("[DEBUG] Unable to retrieve the version of the source for Blob %q (Container %q / Account %q), this will be retrieved during the next plan: %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	} else if d.Get("content_hash").(string) == "" && props.ContentMD5 != "" && (d.Get("source").(string) != "" || d.Get("source_content").(string) != "") {
+		if err := resourceStorageBlobBackfillContentHash(d, props.ContentMD5); err != nil {
+			log.Printf("[DEBUG] Unable to hash the content of Blob %q (Container %q / Account %q), this will be detected during the next plan: %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceStorageBlobBackfillContentHash sets the `content_hash` for a blob created before this was tracked - when the
+// Content-MD5 of the blob differs from the current content the hex-encoded Content-MD5 is used instead, which won't match
+// the hash of the content during the next plan, so that the content is re-uploaded
+func resourceStorageBlobBackfillContentHash(d *pluginsdk.ResourceData, blobContentMD5 string) error {
+	source := d.Get("source").(string)
+	sourceContent := d.Get("source_content").(string)
+
+	contentMD5, err := storageBlobContentMD5(source, sourceContent)
+	if err != nil {
+		return err
+	}
+
+	if contentMD5 != blobContentMD5 {
+		uploadedMD5, err := convertBase64ToHexEncoding(blobContentMD5)
+		if err != nil {
+			return err
+		}
+		d.Set("content_hash", uploadedMD5)
+		return nil
+	}
+
+	hash, err := storageBlobContentHash(source, sourceContent)
+	if err != nil {
+		return err
+	}
+	d.Set("content_hash", hash)
+
 	return nil
 }
 
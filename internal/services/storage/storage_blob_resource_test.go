@@ -145,7 +145,31 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_hash", "parallelism", "size", "source_content", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromInlineContentUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromInlineContent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").Exists(),
+			),
+		},
+		data.ImportStep("content_hash", "parallelism", "size", "source_content", "type"),
+		{
+			Config: r.blockFromInlineContentUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").Exists(),
+			),
+		},
+		data.ImportStep("content_hash", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -214,7 +238,49 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("content_hash", "parallelism", "size", "source", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileUpdated(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("content_hash", "parallelism", "size", "source", "type"),
+		{
+			// the file is changed outside of Terraform, which should be detected and re-uploaded in-place
+			PreConfig: func() {
+				file, err := os.OpenFile(sourceBlob.Name(), os.O_RDWR, 0o600)
+				if err != nil {
+					t.Fatalf("Failed to open local source blob file: %s", err)
+				}
+				if err := populateTempFile(file); err != nil {
+					t.Fatalf("Error populating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("content_hash", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -239,7 +305,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("content_hash", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -382,7 +448,7 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStep("content_hash", "parallelism", "size", "type", "source"),
 	})
 }
 
@@ -655,6 +721,25 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) blockFromInlineContentUpdated(data acceptance.TestData) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Wubba Lubba Dub Dub, Get Schwifty"
+}
+`, template)
+}
+
 func (r StorageBlobResource) blockFromPublicBlob(data acceptance.TestData) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
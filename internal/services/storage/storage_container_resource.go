@@ -120,6 +120,32 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_storage_container", id)
 	}
 
+	if meta.(*clients.Client).Features.Services.Bool(featuresBlockName, featureRecoverSoftDeletedContainers) {
+		deletedVersion, err := client.FindSoftDeleted(ctx, account.ResourceGroup, accountName, containerName)
+		if err != nil {
+			return fmt.Errorf("checking for the presence of a Soft-Deleted Container %q (Storage Account %q): %+v", containerName, accountName, err)
+		}
+
+		if deletedVersion != nil {
+			log.Printf("[DEBUG] Recovering Soft-Deleted Container %q (Version %q) in Storage Account %q..", containerName, *deletedVersion, accountName)
+			if err := client.Restore(ctx, account.ResourceGroup, accountName, containerName, *deletedVersion); err != nil {
+				return fmt.Errorf("recovering Soft-Deleted Container %q (Storage Account %q): %+v", containerName, accountName, err)
+			}
+			log.Printf("[DEBUG] Recovered Soft-Deleted Container %q in Storage Account %q.", containerName, accountName)
+
+			// the recovered Container retains its previous configuration, so update it to match
+			if err := client.UpdateAccessLevel(ctx, account.ResourceGroup, accountName, containerName, accessLevel); err != nil {
+				return fmt.Errorf("updating the Access Control for Container %q (Storage Account %q / Resource Group %q): %s", containerName, accountName, account.ResourceGroup, err)
+			}
+			if err := client.UpdateMetaData(ctx, account.ResourceGroup, accountName, containerName, metaData); err != nil {
+				return fmt.Errorf("updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", containerName, accountName, account.ResourceGroup, err)
+			}
+
+			d.SetId(id)
+			return resourceStorageContainerRead(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating Container %q in Storage Account %q", containerName, accountName)
 	input := containers.CreateInput{
 		AccessLevel: accessLevel,
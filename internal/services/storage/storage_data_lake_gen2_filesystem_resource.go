@@ -75,6 +75,25 @@ func resourceStorageDataLakeGen2FileSystem() *pluginsdk.Resource {
 
 			"properties": MetaDataSchema(),
 
+			"recursive_ace_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"ace"},
+			},
+
+			"recursive_ace_drift_detection_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"recursive_ace_enabled"},
+			},
+
+			"recursive_ace_drifted_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"ace": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -106,6 +125,8 @@ func resourceStorageDataLakeGen2FileSystem() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dataLakeGen2RecursiveAccessControlDiff),
 	}
 }
 
@@ -250,6 +271,14 @@ func resourceStorageDataLakeGen2FileSystemUpdate(d *pluginsdk.ResourceData, meta
 		if _, err := pathClient.SetAccessControl(ctx, id.AccountName, id.DirectoryName, "/", accessControlInput); err != nil {
 			return fmt.Errorf("setting access control for root path in File System %q in Storage Account %q: %s", id.DirectoryName, id.AccountName, err)
 		}
+
+		// the ACL only needs to be re-applied to the existing paths when it's changed, or these have drifted (see Read)
+		if d.Get("recursive_ace_enabled").(bool) && d.HasChanges("ace", "recursive_ace_enabled", "recursive_ace_drifted_path") {
+			log.Printf("[INFO] Applying acl %q recursively in File System %q in Storage Account %q.", acl, id.DirectoryName, id.AccountName)
+			if err := setDataLakeGen2AccessControlRecursive(ctx, *pathClient, id.AccountName, id.DirectoryName, "/", *acl); err != nil {
+				return fmt.Errorf("setting access control recursively in File System %q in Storage Account %q: %s", id.DirectoryName, id.AccountName, err)
+			}
+		}
 	}
 
 	return resourceStorageDataLakeGen2FileSystemRead(d, meta)
@@ -315,6 +344,18 @@ func resourceStorageDataLakeGen2FileSystemRead(d *pluginsdk.ResourceData, meta i
 				return fmt.Errorf("parsing response ACL %q: %s", pathResponse.ACL, err)
 			}
 			ace = FlattenDataLakeGen2AceList(acl)
+
+			driftedPath := ""
+			if d.Get("recursive_ace_enabled").(bool) && d.Get("recursive_ace_drift_detection_enabled").(bool) {
+				driftedPath, err = findDataLakeGen2PathWithDifferentAccessControl(ctx, *pathClient, id.AccountName, id.DirectoryName, "", acl)
+				if err != nil {
+					return fmt.Errorf("checking the ACLs of the paths in File System %q in Storage Account %q: %s", id.DirectoryName, id.AccountName, err)
+				}
+				if driftedPath != "" {
+					log.Printf("[DEBUG] The ACL for Path %q in File System %q in Storage Account %q differs from the root ACL", driftedPath, id.DirectoryName, id.AccountName)
+				}
+			}
+			d.Set("recursive_ace_drifted_path", driftedPath)
 		}
 	}
 	d.Set("ace", ace)
@@ -82,6 +82,31 @@ func TestAccStorageDataLakeGen2FileSystem_UpdateDefaultACL(t *testing.T) {
 	})
 }
 
+func TestAccStorageDataLakeGen2FileSystem_recursiveACL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_filesystem", "test")
+	r := StorageDataLakeGen2FileSystemResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recursiveACL(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the ACL of the existing path is updated, otherwise the plan following the apply will be non-empty
+			Config: r.recursiveACL(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("recursive_ace_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("recursive_ace_drifted_path").IsEmpty(),
+			),
+		},
+		data.ImportStep("recursive_ace_enabled", "recursive_ace_drift_detection_enabled"),
+	})
+}
+
 func TestAccStorageDataLakeGen2FileSystem_properties(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_filesystem", "test")
 	r := StorageDataLakeGen2FileSystemResource{}
@@ -241,6 +266,55 @@ resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageDataLakeGen2FileSystemResource) recursiveACL(data acceptance.TestData, recursive bool) string {
+	template := r.template(data)
+	groupPermissions := "r-x"
+	if recursive {
+		groupPermissions = "rwx"
+	}
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "storageAccountRoleAssignment" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name                                  = "acctest-%[2]d"
+  storage_account_id                    = azurerm_storage_account.test.id
+  recursive_ace_enabled                 = %[3]t
+  recursive_ace_drift_detection_enabled = %[3]t
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "group"
+    permissions = "%[4]s"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+  depends_on = [
+    azurerm_role_assignment.storageAccountRoleAssignment
+  ]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath"
+  resource           = "directory"
+}
+`, template, data.RandomInteger, recursive, groupPermissions)
+}
+
 func (r StorageDataLakeGen2FileSystemResource) withExecuteACLForSPN(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -104,6 +104,25 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			"recursive_ace_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"ace"},
+			},
+
+			"recursive_ace_drift_detection_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"recursive_ace_enabled"},
+			},
+
+			"recursive_ace_drifted_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"ace": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -135,6 +154,8 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dataLakeGen2RecursiveAccessControlDiff),
 	}
 }
 
@@ -288,6 +309,14 @@ func resourceStorageDataLakeGen2PathUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	// the ACL only needs to be re-applied to the existing paths when it's changed, or these have drifted (see Read)
+	if acl != nil && d.Get("recursive_ace_enabled").(bool) && d.HasChanges("ace", "recursive_ace_enabled", "recursive_ace_drifted_path") {
+		log.Printf("[INFO] Applying acl %q recursively to Path %q in File System %q in Storage Account %q.", acl, path, id.FileSystemName, id.AccountName)
+		if err := setDataLakeGen2AccessControlRecursive(ctx, *client, id.AccountName, id.FileSystemName, path, *acl); err != nil {
+			return fmt.Errorf("setting access control recursively for Path %q in File System %q in Storage Account %q: %s", path, id.FileSystemName, id.AccountName, err)
+		}
+	}
+
 	return resourceStorageDataLakeGen2PathRead(d, meta)
 }
 
@@ -336,6 +365,18 @@ func resourceStorageDataLakeGen2PathRead(d *pluginsdk.ResourceData, meta interfa
 	}
 	d.Set("ace", FlattenDataLakeGen2AceList(acl))
 
+	driftedPath := ""
+	if d.Get("recursive_ace_enabled").(bool) && d.Get("recursive_ace_drift_detection_enabled").(bool) {
+		driftedPath, err = findDataLakeGen2PathWithDifferentAccessControl(ctx, *client, id.AccountName, id.FileSystemName, id.Path, acl)
+		if err != nil {
+			return fmt.Errorf("checking the ACLs of the paths within Path %q in File System %q in Storage Account %q: %s", id.Path, id.FileSystemName, id.AccountName, err)
+		}
+		if driftedPath != "" {
+			log.Printf("[DEBUG] The ACL for Path %q in File System %q in Storage Account %q differs from Path %q", driftedPath, id.FileSystemName, id.AccountName, id.Path)
+		}
+	}
+	d.Set("recursive_ace_drifted_path", driftedPath)
+
 	return nil
 }
 
@@ -112,6 +112,31 @@ func TestAccStorageDataLakeGen2Path_withOwner(t *testing.T) {
 	})
 }
 
+func TestAccStorageDataLakeGen2Path_recursiveACL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recursiveACL(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the ACL of the existing child path is updated, otherwise the plan following the apply will be non-empty
+			Config: r.recursiveACL(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("recursive_ace_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("recursive_ace_drifted_path").IsEmpty(),
+			),
+		},
+		data.ImportStep("recursive_ace_enabled", "recursive_ace_drift_detection_enabled"),
+	})
+}
+
 func (r StorageDataLakeGen2PathResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := paths.ParseResourceID(state.ID)
 	if err != nil {
@@ -320,6 +345,64 @@ resource "azurerm_storage_data_lake_gen2_path" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageDataLakeGen2PathResource) recursiveACL(data acceptance.TestData, recursive bool) string {
+	template := r.template(data)
+	groupPermissions := "r-x"
+	if recursive {
+		groupPermissions = "rwx"
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id                    = azurerm_storage_account.test.id
+  filesystem_name                       = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                                  = "testpath"
+  resource                              = "directory"
+  recursive_ace_enabled                 = %[2]t
+  recursive_ace_drift_detection_enabled = %[2]t
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "group"
+    permissions = "%[3]s"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+  ace {
+    scope       = "default"
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    scope       = "default"
+    type        = "group"
+    permissions = "%[3]s"
+  }
+  ace {
+    scope       = "default"
+    type        = "other"
+    permissions = "---"
+  }
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath/child"
+  resource           = "directory"
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.test
+  ]
+}
+`, template, recursive, groupPermissions)
+}
+
 func (r StorageDataLakeGen2PathResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
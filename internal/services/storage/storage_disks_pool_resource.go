@@ -119,7 +119,7 @@ func (d DisksPoolResource) Create() sdk.ResourceFunc {
 				Location: utils.String(m.Location),
 				Name:     utils.String(m.Name),
 				Sku:      expandDisksPoolSku(m.Sku),
-				Tags:     tags.Expand(m.Tags),
+				Tags:     tags.Expand(metadata.Client.Tags, m.Tags),
 			}
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, m.Name, createParameter)
 			if err != nil {
@@ -154,7 +154,7 @@ func (d DisksPoolResource) Read() sdk.ResourceFunc {
 			m := DisksPoolJobModel{
 				Name:              id.DiskPoolName,
 				ResourceGroupName: id.ResourceGroup,
-				Tags:              tags.Flatten(resp.Tags),
+				Tags:              tags.Flatten(metadata.Client.Tags, resp.Tags),
 			}
 			if resp.AvailabilityZones != nil {
 				m.AvailabilityZones = *resp.AvailabilityZones
@@ -227,7 +227,7 @@ func (d DisksPoolResource) Update() sdk.ResourceFunc {
 				patch.Sku = expandDisksPoolSku(m.Sku)
 			}
 			if r.HasChange("tags") {
-				patch.Tags = tags.Expand(m.Tags)
+				patch.Tags = tags.Expand(metadata.Client.Tags, m.Tags)
 			}
 
 			future, err := client.Update(ctx, id.ResourceGroup, id.DiskPoolName, patch)
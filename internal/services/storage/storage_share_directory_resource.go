@@ -55,7 +55,19 @@ func resourceStorageShareDirectory() *pluginsdk.Resource {
 
 			"metadata": MetaDataSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareDirectoryDiff),
+	}
+}
+
+func resourceStorageShareDirectoryDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// existing Storage Share Directories are checked when the client is built during the refresh
+	if (d.Id() != "" && !d.HasChange("storage_account_name")) || !d.NewValueKnown("storage_account_name") {
+		return nil
 	}
+
+	// the Data Plane API for File Shares doesn't support Azure AD authentication, so requires Shared Key access
+	return validateStorageAccountSharedKeyAccess(ctx, meta, d.Get("storage_account_name").(string), "Storage Share Directories")
 }
 
 func resourceStorageShareDirectoryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -86,7 +87,24 @@ func resourceStorageShareFile() *pluginsdk.Resource {
 
 			"metadata": MetaDataSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareFileDiff),
+	}
+}
+
+func resourceStorageShareFileDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// existing Storage Share Files are checked when the client is built during the refresh
+	if (d.Id() != "" && !d.HasChange("storage_share_id")) || !d.NewValueKnown("storage_share_id") {
+		return nil
 	}
+
+	storageShareID, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Data Plane API for File Shares doesn't support Azure AD authentication, so requires Shared Key access
+	return validateStorageAccountSharedKeyAccess(ctx, meta, storageShareID.AccountName, "Storage Share Files")
 }
 
 func resourceStorageShareFileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
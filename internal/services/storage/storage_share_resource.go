@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -118,7 +119,19 @@ func resourceStorageShare() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareDiff),
+	}
+}
+
+func resourceStorageShareDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// existing Storage Shares are checked when the client is built during the refresh
+	if (d.Id() != "" && !d.HasChange("storage_account_name")) || !d.NewValueKnown("storage_account_name") {
+		return nil
 	}
+
+	// the Data Plane API for File Shares doesn't support Azure AD authentication, so requires Shared Key access
+	return validateStorageAccountSharedKeyAccess(ctx, meta, d.Get("storage_account_name").(string), "Storage Shares")
 }
 
 func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) error {
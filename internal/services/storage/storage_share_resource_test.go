@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -200,6 +201,32 @@ func TestAccStorageShare_nfsProtocol(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_sharedKeyAccessDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Storage Account needs to exist for this to be caught at plan-time
+			Config: r.sharedKeyAccessTemplate(data, false),
+		},
+		{
+			Config:      r.sharedKeyAccess(data, false),
+			ExpectError: regexp.MustCompile("Storage Shares require Shared Key authorization"),
+		},
+		{
+			Config: r.sharedKeyAccessTemplate(data, true),
+		},
+		{
+			Config: r.sharedKeyAccess(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageShareDataPlaneID(state.ID)
 	if err != nil {
@@ -484,6 +511,40 @@ resource "azurerm_storage_share" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
 }
 
+func (r StorageShareResource) sharedKeyAccess(data acceptance.TestData, enabled bool) string {
+	template := r.sharedKeyAccessTemplate(data, enabled)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, template, data.RandomString)
+}
+
+func (r StorageShareResource) sharedKeyAccessTemplate(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                      = "acctestacc%s"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  account_tier              = "Standard"
+  account_replication_type  = "LRS"
+  shared_access_key_enabled = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, enabled)
+}
+
 func (r StorageShareResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -69,5 +69,5 @@ func dataSourceStorageSyncRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	if props := resp.ServiceProperties; props != nil {
 		d.Set("incoming_traffic_policy", props.IncomingTrafficPolicy)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
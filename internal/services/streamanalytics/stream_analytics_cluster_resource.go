@@ -100,7 +100,7 @@ func (r ClusterResource) Create() sdk.ResourceFunc {
 					Name:     streamanalytics.Default,
 					Capacity: utils.Int32(model.StreamingCapacity),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.Expand(metadata.Client.Tags, model.Tags),
 			}
 
 			future, err := client.CreateOrUpdate(ctx, props, id.ResourceGroup, id.Name, "", "")
@@ -192,7 +192,7 @@ func (r ClusterResource) Update() sdk.ResourceFunc {
 					Sku: &streamanalytics.ClusterSku{
 						Capacity: utils.Int32(state.StreamingCapacity),
 					},
-					Tags: tags.Expand(state.Tags),
+					Tags: tags.Expand(metadata.Client.Tags, state.Tags),
 				}
 
 				future, err := client.Update(ctx, props, id.ResourceGroup, id.Name, "")
@@ -93,5 +93,5 @@ func dataSourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("spending_limit", props.SpendingLimit)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
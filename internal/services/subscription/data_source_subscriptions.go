@@ -147,7 +147,7 @@ func dataSourceSubscriptionsRead(d *pluginsdk.ResourceData, meta interface{}) er
 			}
 		}
 
-		s["tags"] = tags.Flatten(val.Tags)
+		s["tags"] = tags.Flatten(meta.(*clients.Client).Tags, val.Tags)
 
 		subscriptions = append(subscriptions, s)
 	}
@@ -322,7 +322,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("subscription_id", subscriptionId)
 	d.Set("subscription_name", subscriptionName)
 	d.Set("tenant_id", tenantId)
-	if err := tags.FlattenAndSet(d, t); err != nil {
+	if err := tags.FlattenAndSet(meta.(*clients.Client).Tags, d, t); err != nil {
 		return err
 	}
 
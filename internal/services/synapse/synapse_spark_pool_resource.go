@@ -257,7 +257,7 @@ func resourceSynapseSparkPoolCreate(d *pluginsdk.ResourceData, meta interface{})
 			SparkEventsFolder:           utils.String(d.Get("spark_events_folder").(string)),
 			SparkVersion:                utils.String(d.Get("spark_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	if !*autoScale.Enabled {
 		bigDataPoolInfo.NodeCount = utils.Int32(int32(d.Get("node_count").(int)))
@@ -328,7 +328,7 @@ func resourceSynapseSparkPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("spark_config", flattenSparkPoolSparkConfig(props.SparkConfigProperties))
 		d.Set("spark_version", props.SparkVersion)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -367,7 +367,7 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 			SparkEventsFolder:           utils.String(d.Get("spark_events_folder").(string)),
 			SparkVersion:                utils.String(d.Get("spark_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	if !*autoScale.Enabled {
 		bigDataPoolInfo.NodeCount = utils.Int32(int32(d.Get("node_count").(int)))
@@ -220,7 +220,7 @@ func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta inte
 			DNSConfig:            expandArmTrafficManagerDNSConfig(d),
 			MonitorConfig:        expandArmTrafficManagerMonitorConfig(d),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if maxReturn, ok := d.GetOk("max_return"); ok {
@@ -289,7 +289,7 @@ func resourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("fqdn", dns.Fqdn)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmTrafficManagerProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -306,7 +306,7 @@ func resourceArmTrafficManagerProfileUpdate(d *pluginsdk.ResourceData, meta inte
 		ProfileProperties: &trafficmanager.ProfileProperties{},
 	}
 	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		update.Tags = tags.Expand(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("profile_status") {
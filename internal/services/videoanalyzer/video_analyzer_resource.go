@@ -188,7 +188,7 @@ func resourceVideoAnalyzerRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			return fmt.Errorf("setting `identity`: %s", err)
 		}
 
-		return tags.FlattenAndSet(d, flattenTags(model.Tags))
+		return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, flattenTags(model.Tags))
 	}
 	return nil
 }
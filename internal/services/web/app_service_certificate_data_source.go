@@ -119,5 +119,5 @@ func dataSourceAppServiceCertificateRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("thumbprint", props.Thumbprint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -198,5 +198,5 @@ func dataSourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
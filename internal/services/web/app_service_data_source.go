@@ -246,5 +246,5 @@ func dataSourceAppServiceRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
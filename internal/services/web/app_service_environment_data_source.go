@@ -131,5 +131,5 @@ func dataSourceAppServiceEnvironmentRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("service_ip_address", vipInfo.ServiceIPAddress)
 	d.Set("outbound_ip_addresses", vipInfo.OutboundIPAddresses)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -244,7 +244,7 @@ func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interfa
 			},
 			UserWhitelistedIPRanges: utils.ExpandStringSlice(userWhitelistedIPRangesRaw),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.Expand(meta.(*clients.Client).Tags, t),
 	}
 
 	if clusterSettingsRaw, ok := d.GetOk("cluster_setting"); ok {
@@ -413,7 +413,7 @@ func resourceAppServiceEnvironmentRead(d *pluginsdk.ResourceData, meta interface
 	d.Set("service_ip_address", vipInfo.ServiceIPAddress)
 	d.Set("outbound_ip_addresses", vipInfo.OutboundIPAddresses)
 
-	return tags.FlattenAndSet(d, existing.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, existing.Tags)
 }
 
 func resourceAppServiceEnvironmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -284,7 +284,7 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 					},
 					ZoneRedundant: utils.Bool(model.ZoneRedundant),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.Expand(metadata.Client.Tags, model.Tags),
 			}
 
 			if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.HostingEnvironmentName, envelope); err != nil {
@@ -383,7 +383,7 @@ func (r AppServiceEnvironmentV3Resource) Read() sdk.ResourceFunc {
 				return err
 			}
 			model.InboundNetworkDependencies = *inboundNetworkDependencies
-			model.Tags = tags.Flatten(existing.Tags)
+			model.Tags = tags.Flatten(metadata.Client.Tags, existing.Tags)
 
 			return metadata.Encode(&model)
 		},
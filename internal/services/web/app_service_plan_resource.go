@@ -179,7 +179,7 @@ func resourceAppServicePlanCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Location:                 &location,
 		Kind:                     &kind,
 		Sku:                      &sku,
-		Tags:                     tags.Expand(t),
+		Tags:                     tags.Expand(meta.(*clients.Client).Tags, t),
 		AppServicePlanProperties: properties,
 	}
 
@@ -301,7 +301,7 @@ func resourceAppServicePlanRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `sku`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAppServicePlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
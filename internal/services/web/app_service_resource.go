@@ -284,7 +284,7 @@ func resourceAppServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -417,7 +417,7 @@ func resourceAppServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.Expand(meta.(*clients.Client).Tags, t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -786,7 +786,7 @@ func resourceAppServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `identity`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAppServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -263,5 +263,5 @@ func dataSourceFunctionAppRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -1,6 +1,13 @@
 package tags
 
-func Expand(tagsMap map[string]interface{}) map[string]*string {
+// Expand expands the specified Tags, merging in any Default Tags configured in the Provider block
+func Expand(config ProviderConfiguration, tagsMap map[string]interface{}) map[string]*string {
+	return withDefaultTags(config, ExpandFilter(tagsMap))
+}
+
+// ExpandFilter expands the specified Tags as-is, without merging in any Default Tags - for use when the Tags
+// are used to filter existing resources (e.g. the `tags_filter` within a Data Source) rather than being sent to Azure
+func ExpandFilter(tagsMap map[string]interface{}) map[string]*string {
 	output := make(map[string]*string, len(tagsMap))
 
 	for i, v := range tagsMap {
@@ -11,3 +18,20 @@ func Expand(tagsMap map[string]interface{}) map[string]*string {
 
 	return output
 }
+
+// ExpandPointer expands the specified Tags into a pointer to a map of strings (as used by the
+// Pandora-generated SDKs), merging in any Default Tags configured in the Provider block
+func ExpandPointer(config ProviderConfiguration, tagsMap map[string]interface{}) *map[string]string {
+	expanded := Expand(config, tagsMap)
+
+	output := make(map[string]string, len(expanded))
+	for k, v := range expanded {
+		if v == nil {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	return &output
+}
@@ -6,7 +6,27 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
-func Flatten(tagMap map[string]*string) map[string]interface{} {
+// Flatten flattens the specified Tags, excluding any Ignored Tags and any Default Tags (configured
+// in the Provider block) which haven't been overridden
+func Flatten(config ProviderConfiguration, tagMap map[string]*string) map[string]interface{} {
+	return flatten(config, tagMap, nil)
+}
+
+// FlattenAndSet flattens the specified Tags and sets them into the `tags` field - any Ignored or Default
+// Tags which are defined within the existing `tags` field are retained, since they've been explicitly specified
+func FlattenAndSet(config ProviderConfiguration, d *pluginsdk.ResourceData, tagMap map[string]*string) error {
+	existing, _ := d.Get("tags").(map[string]interface{})
+	flattened := flatten(config, tagMap, existing)
+	if err := d.Set("tags", flattened); err != nil {
+		return fmt.Errorf("setting `tags`: %s", err)
+	}
+
+	return nil
+}
+
+func flatten(config ProviderConfiguration, tagMap map[string]*string, keep map[string]interface{}) map[string]interface{} {
+	tagMap = withoutDefaultAndIgnoredTags(config, tagMap, keep)
+
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
 
@@ -20,12 +40,3 @@ func Flatten(tagMap map[string]*string) map[string]interface{} {
 
 	return output
 }
-
-func FlattenAndSet(d *pluginsdk.ResourceData, tagMap map[string]*string) error {
-	flattened := Flatten(tagMap)
-	if err := d.Set("tags", flattened); err != nil {
-		return fmt.Errorf("setting `tags`: %s", err)
-	}
-
-	return nil
-}
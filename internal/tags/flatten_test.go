@@ -45,7 +45,7 @@ func TestFlatten(t *testing.T) {
 	for _, v := range testData {
 		t.Logf("[DEBUG] Test %q", v.Name)
 
-		actual := Flatten(v.Input)
+		actual := Flatten(ProviderConfiguration{}, v.Input)
 		if !reflect.DeepEqual(actual, v.Expected) {
 			t.Fatalf("Expected %+v but got %+v", actual, v.Expected)
 		}
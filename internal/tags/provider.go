@@ -0,0 +1,86 @@
+package tags
+
+import (
+	"strings"
+)
+
+// ProviderConfiguration is the Provider-level configuration for Tags, which is available from the
+// Provider's Client and applied by Expand/FromTypedObject (Default Tags) and Flatten/ToTypedObject
+// (Default & Ignored Tags)
+type ProviderConfiguration struct {
+	// DefaultTags are the Tags which should be applied to every Resource, unless the
+	// Resource overrides the value of the Tag
+	DefaultTags map[string]string
+
+	// IgnoreKeys are the keys of Tags which should be ignored when reading Tags from Azure,
+	// for example Tags which are added by Azure Policy - note these aren't merged back in when
+	// the Tags are expanded, so are removed when the Resource is next updated
+	IgnoreKeys []string
+
+	// IgnoreKeyPrefixes are the prefixes of keys of Tags which should be ignored when reading
+	// Tags from Azure, for example Tags which are added by Azure Policy
+	IgnoreKeyPrefixes []string
+}
+
+// withDefaultTags returns the Default Tags merged with the specified tags, where the
+// tags specified on the Resource take precedence over the Default Tags
+func withDefaultTags(config ProviderConfiguration, input map[string]*string) map[string]*string {
+	if len(config.DefaultTags) == 0 {
+		return input
+	}
+
+	output := make(map[string]*string, len(config.DefaultTags)+len(input))
+	for k, v := range config.DefaultTags {
+		value := v
+		output[k] = &value
+	}
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
+// withoutDefaultAndIgnoredTags removes any Ignored Tags, and any Default Tags which haven't been overridden,
+// from the specified tags - unless the key of the tag is contained within `keep`
+func withoutDefaultAndIgnoredTags(config ProviderConfiguration, input map[string]*string, keep map[string]interface{}) map[string]*string {
+	if len(config.DefaultTags) == 0 && len(config.IgnoreKeys) == 0 && len(config.IgnoreKeyPrefixes) == 0 {
+		return input
+	}
+
+	output := make(map[string]*string, len(input))
+	for k, v := range input {
+		if _, ok := keep[k]; ok {
+			output[k] = v
+			continue
+		}
+
+		if config.isIgnored(k) {
+			continue
+		}
+
+		if defaultValue, ok := config.DefaultTags[k]; ok && v != nil && *v == defaultValue {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func (c ProviderConfiguration) isIgnored(key string) bool {
+	for _, v := range c.IgnoreKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.IgnoreKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
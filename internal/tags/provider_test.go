@@ -0,0 +1,102 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandWithDefaultTags(t *testing.T) {
+	config := ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+			"env":         "dev",
+		},
+	}
+
+	actual := Expand(config, map[string]interface{}{
+		"env":  "prod",
+		"name": "example",
+	})
+	expected := map[string]*string{
+		"cost_center": utils.String("1234"),
+		"env":         utils.String("prod"),
+		"name":        utils.String("example"),
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	typed := FromTypedObject(config, map[string]string{
+		"name": "example",
+	})
+	if len(typed) != 3 || *typed["env"] != "dev" {
+		t.Fatalf("expected the Default Tags to be merged into the Typed Object but got %+v", typed)
+	}
+
+	pointer := ExpandPointer(config, map[string]interface{}{})
+	if pointer == nil || len(*pointer) != 2 {
+		t.Fatalf("expected 2 Default Tags but got %+v", pointer)
+	}
+
+	// a Provider block without Default Tags (e.g. an aliased Provider) is unaffected
+	other := Expand(ProviderConfiguration{}, map[string]interface{}{
+		"name": "example",
+	})
+	if len(other) != 1 {
+		t.Fatalf("expected no Default Tags to be merged for a separate Provider configuration but got %+v", other)
+	}
+}
+
+func TestFlattenWithDefaultAndIgnoredTags(t *testing.T) {
+	config := ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+			"env":         "dev",
+		},
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"policy:"},
+	}
+
+	input := map[string]*string{
+		"cost_center":   utils.String("1234"),
+		"env":           utils.String("prod"),
+		"createdby":     utils.String("policy"),
+		"Policy:Region": utils.String("westeurope"),
+		"name":          utils.String("example"),
+	}
+
+	actual := Flatten(config, input)
+	expected := map[string]interface{}{
+		"env":  "prod",
+		"name": "example",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	typed := ToTypedObject(config, input, nil)
+	expectedTyped := map[string]string{
+		"env":  "prod",
+		"name": "example",
+	}
+	if !reflect.DeepEqual(typed, expectedTyped) {
+		t.Fatalf("expected %+v but got %+v", expectedTyped, typed)
+	}
+
+	// explicitly specified tags are retained
+	kept := flatten(config, input, map[string]interface{}{
+		"cost_center": "1234",
+	})
+	if _, ok := kept["cost_center"]; !ok {
+		t.Fatalf("expected `cost_center` to be retained but got %+v", kept)
+	}
+
+	keptTyped := ToTypedObject(config, input, map[string]interface{}{
+		"cost_center": "1234",
+	})
+	if v, ok := keptTyped["cost_center"]; !ok || v != "1234" {
+		t.Fatalf("expected `cost_center` to be retained in the Typed Object but got %+v", keptTyped)
+	}
+}
@@ -1,6 +1,7 @@
 package tags
 
-func FromTypedObject(input map[string]string) map[string]*string {
+// FromTypedObject expands the Tags from a Typed Resource, merging in any Default Tags configured in the Provider block
+func FromTypedObject(config ProviderConfiguration, input map[string]string) map[string]*string {
 	output := make(map[string]*string, len(input))
 
 	for k, v := range input {
@@ -9,13 +10,17 @@ func FromTypedObject(input map[string]string) map[string]*string {
 		output[k] = &value
 	}
 
-	return output
+	return withDefaultTags(config, output)
 }
 
-func ToTypedObject(input map[string]*string) map[string]string {
+// ToTypedObject flattens the Tags for a Typed Resource, excluding any Ignored Tags and any Default Tags
+// (configured in the Provider block) which haven't been overridden - any Ignored or Default Tags which are
+// defined within the existing Tags (e.g. the `tags` field for the Resource) are retained, since they've been
+// explicitly specified. Data Sources should specify `nil` for the existing Tags
+func ToTypedObject(config ProviderConfiguration, input map[string]*string, existing map[string]interface{}) map[string]string {
 	output := make(map[string]string)
 
-	for k, v := range input {
+	for k, v := range withoutDefaultAndIgnoredTags(config, input, existing) {
 		if v == nil {
 			continue
 		}
@@ -14,7 +14,7 @@ func TestFromTypedObject(t *testing.T) {
 		"key3": "value3",
 	}
 
-	expanded := FromTypedObject(testData)
+	expanded := FromTypedObject(ProviderConfiguration{}, testData)
 
 	if len(expanded) != 3 {
 		t.Fatalf("Expected 3 results in expanded tag map, got %d", len(expanded))
@@ -65,7 +65,7 @@ func TestToTypedObject(t *testing.T) {
 	for _, v := range testData {
 		t.Logf("[DEBUG] Test %q", v.Name)
 
-		actual := ToTypedObject(v.Input)
+		actual := ToTypedObject(ProviderConfiguration{}, v.Input, nil)
 		if !reflect.DeepEqual(actual, v.Expected) {
 			t.Fatalf("Expected %+v but got %+v", actual, v.Expected)
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -12,7 +13,7 @@ import (
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForCreate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutCreate))
+	return buildWithTimeout(ctx, d, d.Timeout(pluginsdk.TimeoutCreate))
 }
 
 // ForCreateUpdate returns the context wrapped with the timeout for an combined Create/Update operation
@@ -32,7 +33,7 @@ func ForCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Co
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutDelete))
+	return buildWithTimeout(ctx, d, d.Timeout(pluginsdk.TimeoutDelete))
 }
 
 // ForRead returns the context wrapped with the timeout for an Read operation
@@ -40,7 +41,7 @@ func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context,
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
+	return buildWithTimeout(ctx, d, d.Timeout(pluginsdk.TimeoutRead))
 }
 
 // ForUpdate returns the context wrapped with the timeout for an Update operation
@@ -48,9 +49,11 @@ func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, c
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutUpdate))
+	return buildWithTimeout(ctx, d, d.Timeout(pluginsdk.TimeoutUpdate))
 }
 
-func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+func buildWithTimeout(ctx context.Context, d *pluginsdk.ResourceData, timeout time.Duration) (context.Context, context.CancelFunc) {
+	// the requests made by the operation are tracked (e.g. to log the Request Metrics) regardless of the context it's derived from
+	ctx = common.ContextWithRequestMetricsForResourceData(ctx, d)
 	return context.WithTimeout(ctx, timeout)
 }
@@ -0,0 +1,1520 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+const providerImportPath = "github.com/hashicorp/terraform-provider-azurerm"
+
+func main() {
+	f := flag.NewFlagSet("generator-typed-resource", flag.ExitOnError)
+
+	servicePackagePath := f.String("path", "", "The relative path to the service package")
+	name := f.String("name", "", "The name of this Resource Type, e.g. `LoadTest`")
+	resourceType := f.String("resource-type", "", "The Terraform Resource Type, e.g. `azurerm_load_test`")
+	id := f.String("id", "", "An example of this Resource ID")
+	sdkPath := f.String("sdk-path", "", "The path to the vendored SDK package containing the API Model")
+	model := f.String("model", "", "The name of the API Model within the SDK package, e.g. `LoadTestResource`")
+	client := f.String("client", "", "The name of the SDK Client used to manage this Resource, e.g. `LoadTestsClient` (defaults to `{name}sClient`)")
+
+	_ = f.Parse(os.Args[1:])
+
+	quitWithError := func(message string) {
+		log.Print(message)
+		os.Exit(1)
+	}
+
+	required := map[string]*string{
+		"-path":          servicePackagePath,
+		"-name":          name,
+		"-resource-type": resourceType,
+		"-id":            id,
+		"-sdk-path":      sdkPath,
+		"-model":         model,
+	}
+	for _, flagName := range []string{"-path", "-name", "-resource-type", "-id", "-sdk-path", "-model"} {
+		if v := required[flagName]; v == nil || *v == "" {
+			quitWithError(fmt.Sprintf("`%s` must be specified", flagName))
+			return
+		}
+	}
+
+	input := generatorInput{
+		ServicePackagePath: *servicePackagePath,
+		Name:               *name,
+		ResourceType:       *resourceType,
+		ResourceId:         *id,
+		SdkPath:            *sdkPath,
+		ModelName:          *model,
+		ClientName:         *client,
+	}
+	if input.ClientName == "" {
+		input.ClientName = fmt.Sprintf("%ssClient", input.Name)
+	}
+
+	if err := run(input); err != nil {
+		quitWithError(err.Error())
+	}
+}
+
+type generatorInput struct {
+	// ServicePackagePath is the path to the Service Package, e.g. `./internal/services/loadtest`
+	ServicePackagePath string
+
+	// Name is the name of this Resource Type, e.g. `LoadTest`
+	Name string
+
+	// ResourceType is the Terraform Resource Type, e.g. `azurerm_load_test`
+	ResourceType string
+
+	// ResourceId is an example of the Resource ID for this Resource
+	ResourceId string
+
+	// SdkPath is the path to the vendored SDK Package containing the API Model
+	SdkPath string
+
+	// ModelName is the name of the API Model within the SDK Package, e.g. `LoadTestResource`
+	ModelName string
+
+	// ClientName is the name of the SDK Client used to manage this Resource, e.g. `LoadTestsClient`
+	ClientName string
+}
+
+func run(input generatorInput) error {
+	servicePackagePath, err := filepath.Abs(input.ServicePackagePath)
+	if err != nil {
+		return fmt.Errorf("determining the absolute path for %q: %+v", input.ServicePackagePath, err)
+	}
+	servicePackageName, internalPath, err := parseServicePackagePath(servicePackagePath)
+	if err != nil {
+		return fmt.Errorf("determining Service Package Name for %q: %+v", input.ServicePackagePath, err)
+	}
+
+	id, err := parseResourceId(input.Name, input.ResourceId)
+	if err != nil {
+		return fmt.Errorf("parsing the Resource ID %q: %+v", input.ResourceId, err)
+	}
+
+	sdk, err := parseSdkPackage(input.SdkPath)
+	if err != nil {
+		return fmt.Errorf("parsing the SDK Package at %q: %+v", input.SdkPath, err)
+	}
+
+	model, err := sdk.apiModel(input.ModelName)
+	if err != nil {
+		return err
+	}
+
+	clientField, err := findServiceClientField(filepath.Join(internalPath, "clients", "client.go"), servicePackageName)
+	if err != nil {
+		return fmt.Errorf("determining the Client for the Service Package %q: %+v", servicePackageName, err)
+	}
+
+	g := generator{
+		input:              input,
+		servicePackageName: servicePackageName,
+		clientField:        clientField,
+		id:                 *id,
+		sdk:                *sdk,
+		model:              *model,
+	}
+
+	fileName := convertToSnakeCase(input.Name)
+	files := map[string]func() (string, error){
+		filepath.Join(servicePackagePath, fmt.Sprintf("%s_resource.go", fileName)):      g.resourceCode,
+		filepath.Join(servicePackagePath, fmt.Sprintf("%s_resource_test.go", fileName)): g.testCode,
+	}
+	for path := range files {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("the file %q already exists - remove it to regenerate this Resource", path)
+		}
+	}
+
+	paths := make([]string, 0)
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		contents, err := files[path]()
+		if err != nil {
+			return fmt.Errorf("generating %q: %+v", path, err)
+		}
+		if err := goFmtAndWriteToFile(path, contents); err != nil {
+			return err
+		}
+	}
+
+	if err := addResourceIdGenerator(filepath.Join(servicePackagePath, "resourceids.go"), servicePackageName, input.Name, input.ResourceId); err != nil {
+		return fmt.Errorf("adding the Resource ID to `resourceids.go`: %+v", err)
+	}
+
+	if err := registerResource(filepath.Join(servicePackagePath, "registration.go"), fmt.Sprintf("%sResource{}", input.Name)); err != nil {
+		log.Printf("[WARN] unable to register the Resource automatically, add `%sResource{}` to the typed Resources for this Service Package: %+v", input.Name, err)
+	}
+
+	if len(model.unsupported) > 0 {
+		log.Printf("[WARN] the following fields couldn't be mapped automatically and have been output as a TODO: %s", strings.Join(model.unsupported, ", "))
+	}
+	log.Printf("Generated %s - run `go generate` within %q to generate the Resource ID Parser/Validator", input.ResourceType, input.ServicePackagePath)
+
+	return nil
+}
+
+// parseServicePackagePath returns the name of the Service Package and the path to the `internal` directory
+func parseServicePackagePath(path string) (string, string, error) {
+	// we do this replacement to avoid the case that on windows machine, the absolute path are using the path separator of \ instead of /
+	segments := strings.Split(strings.ReplaceAll(path, "\\", "/"), "/")
+	serviceIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "services") {
+			serviceIndex = i
+			break
+		}
+	}
+
+	if serviceIndex == -1 {
+		return "", "", fmt.Errorf("`services` segment was not found")
+	}
+
+	if len(segments) <= serviceIndex+1 {
+		return "", "", fmt.Errorf("not enough segments")
+	}
+
+	internalPath := filepath.FromSlash(strings.Join(segments[:serviceIndex], "/"))
+	return segments[serviceIndex+1], internalPath, nil
+}
+
+// findServiceClientField returns the name of the field within `clients.Client` for the specified Service Package
+func findServiceClientField(clientFilePath, servicePackageName string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), clientFilePath, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", clientFilePath, err)
+	}
+
+	importPath := fmt.Sprintf("%s/internal/services/%s/client", providerImportPath, servicePackageName)
+	alias := ""
+	for _, spec := range file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == importPath {
+			alias = "client"
+			if spec.Name != nil {
+				alias = spec.Name.Name
+			}
+		}
+	}
+	if alias == "" {
+		return "", fmt.Errorf("the Service Package isn't imported in %q", clientFilePath)
+	}
+
+	field := ""
+	ast.Inspect(file, func(node ast.Node) bool {
+		if v, ok := node.(*ast.TypeSpec); ok && v.Name.Name == "Client" {
+			if s, ok := v.Type.(*ast.StructType); ok {
+				for _, f := range s.Fields.List {
+					if types.ExprString(f.Type) == fmt.Sprintf("*%s.Client", alias) && len(f.Names) > 0 {
+						field = f.Names[0].Name
+					}
+				}
+			}
+			return false
+		}
+		return true
+	})
+	if field == "" {
+		return "", fmt.Errorf("no field of type `*%s.Client` was found in %q", alias, clientFilePath)
+	}
+
+	return field, nil
+}
+
+// ResourceIdSegment is a key/value pair within the Resource ID, named as `generator-resource-id` names it
+type ResourceIdSegment struct {
+	// FieldName is the name of the field for this segment within the Resource ID, e.g. `ResourceGroup`
+	FieldName string
+
+	// ParameterName is the (lower-cased) name an SDK Client would use for this segment, e.g. `resourcegroupname`
+	ParameterName string
+
+	// SegmentKey is the Segment used for this in the Resource ID e.g. `resourceGroups`
+	SegmentKey string
+}
+
+type ResourceId struct {
+	HasResourceGroup bool
+	Segments         []ResourceIdSegment
+}
+
+// parseResourceId splits the example Resource ID into segments, using the same naming as `generator-resource-id`
+// so that the generated code references the fields of the generated Resource ID
+func parseResourceId(typeName, resourceId string) (*ResourceId, error) {
+	split := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
+	if len(split)%2 != 0 {
+		return nil, fmt.Errorf("segments weren't divisible by 2: %q", resourceId)
+	}
+
+	id := ResourceId{}
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+		if key == "providers" {
+			continue
+		}
+
+		segment := ResourceIdSegment{
+			FieldName:     strings.Title(fmt.Sprintf("%sName", key)),
+			ParameterName: strings.ToLower(fmt.Sprintf("%sName", key)),
+			SegmentKey:    key,
+		}
+
+		hasSubscriptionId := false
+		for _, v := range id.Segments {
+			if v.FieldName == "SubscriptionId" {
+				hasSubscriptionId = true
+			}
+		}
+
+		switch {
+		case strings.EqualFold(key, "resourceGroups"):
+			segment.FieldName = "ResourceGroup"
+			segment.ParameterName = "resourcegroupname"
+			id.HasResourceGroup = true
+
+		case key == "subscriptions" && !hasSubscriptionId:
+			segment.FieldName = "SubscriptionId"
+			segment.ParameterName = "subscriptionid"
+
+		case strings.HasSuffix(key, "s"):
+			singular := strings.TrimSuffix(key, "s")
+			if strings.HasSuffix(key, "ies") {
+				singular = fmt.Sprintf("%sy", strings.TrimSuffix(key, "ies"))
+			}
+			if strings.HasSuffix(key, "sses") {
+				singular = fmt.Sprintf("%sss", strings.TrimSuffix(key, "sses"))
+			}
+
+			segment.FieldName = strings.Title(fmt.Sprintf("%sName", singular))
+			segment.ParameterName = strings.ToLower(fmt.Sprintf("%sName", singular))
+			if strings.EqualFold(singular, typeName) {
+				segment.FieldName = "Name"
+			}
+		}
+
+		id.Segments = append(id.Segments, segment)
+	}
+
+	if len(id.Segments) == 0 || id.Segments[0].FieldName != "SubscriptionId" {
+		return nil, fmt.Errorf("the Resource ID must be scoped to a Subscription")
+	}
+	if len(id.Segments) == 1 {
+		return nil, fmt.Errorf("the Resource ID must contain a Name")
+	}
+
+	return &id, nil
+}
+
+// modelFields returns the segments of the Resource ID which are arguments for this Resource (e.g. excluding the Subscription ID)
+func (id ResourceId) modelFields() []idModelField {
+	out := make([]idModelField, 0)
+	for i, segment := range id.Segments {
+		switch {
+		case i == 0:
+			continue
+
+		case i == len(id.Segments)-1:
+			out = append(out, idModelField{
+				ModelName:  "Name",
+				SchemaName: "name",
+				Segment:    segment,
+			})
+
+		case segment.FieldName == "ResourceGroup":
+			out = append(out, idModelField{
+				ModelName:  "ResourceGroup",
+				SchemaName: "resource_group_name",
+				Segment:    segment,
+			})
+
+		default:
+			out = append(out, idModelField{
+				ModelName:  segment.FieldName,
+				SchemaName: convertToSnakeCase(segment.FieldName),
+				Segment:    segment,
+			})
+		}
+	}
+	return out
+}
+
+// argumentFields returns the same fields as modelFields, but with the Name first, as the Schema and Model are ordered
+func (id ResourceId) argumentFields() []idModelField {
+	fields := id.modelFields()
+	return append([]idModelField{fields[len(fields)-1]}, fields[:len(fields)-1]...)
+}
+
+type idModelField struct {
+	ModelName  string
+	SchemaName string
+	Segment    ResourceIdSegment
+}
+
+type sdkPackage struct {
+	Name       string
+	ImportPath string
+
+	structs map[string]*ast.StructType
+	enums   map[string][]string
+	methods map[string]map[string]*ast.FuncType
+}
+
+func parseSdkPackage(path string) (*sdkPackage, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	abs = strings.ReplaceAll(abs, "\\", "/")
+	i := strings.LastIndex(abs, "/vendor/")
+	if i == -1 {
+		return nil, fmt.Errorf("the SDK Package must be vendored")
+	}
+
+	packages, err := parser.ParseDir(token.NewFileSet(), path, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) != 1 {
+		return nil, fmt.Errorf("expected 1 package but got %d", len(packages))
+	}
+
+	out := sdkPackage{
+		ImportPath: abs[i+len("/vendor/"):],
+		structs:    map[string]*ast.StructType{},
+		enums:      map[string][]string{},
+		methods:    map[string]map[string]*ast.FuncType{},
+	}
+	for name, pkg := range packages {
+		out.Name = name
+
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch v := decl.(type) {
+				case *ast.GenDecl:
+					out.parseGenDecl(v)
+
+				case *ast.FuncDecl:
+					if v.Recv == nil || len(v.Recv.List) != 1 {
+						continue
+					}
+					receiver := strings.TrimPrefix(types.ExprString(v.Recv.List[0].Type), "*")
+					if _, ok := out.methods[receiver]; !ok {
+						out.methods[receiver] = map[string]*ast.FuncType{}
+					}
+					out.methods[receiver][v.Name.Name] = v.Type
+				}
+			}
+		}
+	}
+
+	for _, values := range out.enums {
+		sort.Strings(values)
+	}
+
+	return &out, nil
+}
+
+func (p *sdkPackage) parseGenDecl(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		switch v := spec.(type) {
+		case *ast.TypeSpec:
+			switch t := v.Type.(type) {
+			case *ast.StructType:
+				p.structs[v.Name.Name] = t
+			case *ast.Ident:
+				if t.Name == "string" {
+					if _, ok := p.enums[v.Name.Name]; !ok {
+						p.enums[v.Name.Name] = []string{}
+					}
+				}
+			}
+
+		case *ast.ValueSpec:
+			if decl.Tok != token.CONST || v.Type == nil {
+				continue
+			}
+			typeName := types.ExprString(v.Type)
+			for _, n := range v.Names {
+				p.enums[typeName] = append(p.enums[typeName], n.Name)
+			}
+		}
+	}
+}
+
+type fieldKind string
+
+const (
+	fieldKindBool        fieldKind = "bool"
+	fieldKindEnum        fieldKind = "enum"
+	fieldKindFloat       fieldKind = "float"
+	fieldKindInt32       fieldKind = "int32"
+	fieldKindInt64       fieldKind = "int64"
+	fieldKindString      fieldKind = "string"
+	fieldKindStringList  fieldKind = "stringList"
+	fieldKindUnsupported fieldKind = "unsupported"
+)
+
+type modelField struct {
+	// SdkName is the name of this field within the SDK Model
+	SdkName string
+
+	// SchemaName is the name of this field within the Terraform Schema
+	SchemaName string
+
+	Kind    fieldKind
+	Pointer bool
+
+	// EnumType is the name of the SDK type for an Enum, with EnumValues containing its constants
+	EnumType   string
+	EnumValues []string
+
+	// ReadOnly specifies that this field is returned from the API but can't be set, so is an Attribute
+	ReadOnly bool
+
+	// SdkType is the SDK type for this field, used to describe fields which can't be mapped automatically
+	SdkType string
+}
+
+type apiModel struct {
+	Name string
+
+	HasLocation bool
+	HasTags     bool
+
+	// PropertiesField is the name of the field containing the properties (e.g. `Properties`, or the name
+	// of the embedded struct) and PropertiesType is its type
+	PropertiesField string
+	PropertiesType  string
+
+	Fields []modelField
+
+	unsupported []string
+}
+
+func (p sdkPackage) apiModel(name string) (*apiModel, error) {
+	s, ok := p.structs[name]
+	if !ok {
+		return nil, fmt.Errorf("the Model %q was not found in the SDK Package %q", name, p.ImportPath)
+	}
+
+	out := apiModel{
+		Name: name,
+	}
+	for _, f := range s.Fields.List {
+		jsonName := jsonFieldName(f)
+
+		// the properties can either be a field, or an embedded struct (in older SDKs)
+		if jsonName == "properties" {
+			propertiesType := strings.TrimPrefix(types.ExprString(f.Type), "*")
+			properties, ok := p.structs[propertiesType]
+			if !ok {
+				return nil, fmt.Errorf("the type %q for the properties of %q was not found", propertiesType, name)
+			}
+
+			out.PropertiesField = propertiesType
+			if len(f.Names) > 0 {
+				out.PropertiesField = f.Names[0].Name
+			}
+			out.PropertiesType = propertiesType
+
+			for _, pf := range properties.Fields.List {
+				for _, n := range pf.Names {
+					// the Provisioning State is checked by the SDK when polling rather than exposed
+					if n.Name == "ProvisioningState" {
+						continue
+					}
+					field := p.modelField(n.Name, pf)
+					if field.Kind == fieldKindUnsupported {
+						out.unsupported = append(out.unsupported, fmt.Sprintf("%s.%s", propertiesType, n.Name))
+					}
+					out.Fields = append(out.Fields, field)
+				}
+			}
+			continue
+		}
+
+		for _, n := range f.Names {
+			switch n.Name {
+			case "ID", "Id", "Name", "Type", "SystemData", "Etag", "Response":
+				continue
+
+			case "Location":
+				out.HasLocation = true
+
+			case "Tags":
+				out.HasTags = true
+
+			default:
+				out.unsupported = append(out.unsupported, fmt.Sprintf("%s.%s", name, n.Name))
+			}
+		}
+	}
+
+	return &out, nil
+}
+
+func (p sdkPackage) modelField(name string, f *ast.Field) modelField {
+	out := modelField{
+		SdkName:    name,
+		SchemaName: convertToSnakeCase(name),
+		Kind:       fieldKindUnsupported,
+		SdkType:    types.ExprString(f.Type),
+		ReadOnly:   strings.Contains(f.Doc.Text(), "READ-ONLY") || strings.Contains(f.Comment.Text(), "READ-ONLY"),
+	}
+
+	t := f.Type
+	if v, ok := t.(*ast.StarExpr); ok {
+		out.Pointer = true
+		t = v.X
+	}
+
+	switch v := t.(type) {
+	case *ast.Ident:
+		switch v.Name {
+		case "bool":
+			out.Kind = fieldKindBool
+		case "float64":
+			out.Kind = fieldKindFloat
+		case "int32":
+			out.Kind = fieldKindInt32
+		case "int64":
+			out.Kind = fieldKindInt64
+		case "string":
+			out.Kind = fieldKindString
+		default:
+			if values, ok := p.enums[v.Name]; ok {
+				out.Kind = fieldKindEnum
+				out.EnumType = v.Name
+				out.EnumValues = values
+			}
+		}
+
+	case *ast.ArrayType:
+		if types.ExprString(v.Elt) == "string" && out.Pointer {
+			out.Kind = fieldKindStringList
+		}
+	}
+
+	return out
+}
+
+func (f modelField) modelType() string {
+	switch f.Kind {
+	case fieldKindBool:
+		return "bool"
+	case fieldKindFloat:
+		return "float64"
+	case fieldKindInt32, fieldKindInt64:
+		return "int64"
+	case fieldKindStringList:
+		return "[]string"
+	}
+	return "string"
+}
+
+func jsonFieldName(f *ast.Field) string {
+	if f.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+}
+
+type generator struct {
+	input              generatorInput
+	servicePackageName string
+	clientField        string
+
+	id    ResourceId
+	sdk   sdkPackage
+	model apiModel
+}
+
+func (g generator) resourceName() string {
+	return fmt.Sprintf("%sResource", g.input.Name)
+}
+
+func (g generator) modelName() string {
+	return fmt.Sprintf("%sModel", g.input.Name)
+}
+
+func (g generator) client() string {
+	return fmt.Sprintf("metadata.Client.%s.%s", g.clientField, g.input.ClientName)
+}
+
+func (g generator) imports() []string {
+	imports := []string{
+		"context",
+		"fmt",
+		"time",
+		"",
+		g.sdk.ImportPath,
+	}
+	if g.id.HasResourceGroup {
+		imports = append(imports, providerImportPath+"/helpers/azure")
+	}
+	if g.model.HasLocation {
+		imports = append(imports, providerImportPath+"/internal/location")
+	}
+	imports = append(imports,
+		providerImportPath+"/internal/sdk",
+		fmt.Sprintf("%s/internal/services/%s/parse", providerImportPath, g.servicePackageName),
+		fmt.Sprintf("%s/internal/services/%s/validate", providerImportPath, g.servicePackageName),
+	)
+	if g.model.HasTags {
+		imports = append(imports, providerImportPath+"/internal/tags")
+	}
+	imports = append(imports,
+		providerImportPath+"/internal/tf/pluginsdk",
+		providerImportPath+"/internal/tf/validation",
+		providerImportPath+"/utils",
+	)
+	return imports
+}
+
+// callArguments returns the arguments for calling the specified method on the SDK Client (based on its signature
+// where this can be found) and whether the method is a Long Running Operation
+func (g generator) callArguments(method, idVar, payloadVar string) (string, bool) {
+	idFields := make([]string, 0)
+	parameterNames := map[string]string{}
+	for _, segment := range g.id.Segments[1:] {
+		idFields = append(idFields, segment.FieldName)
+		parameterNames[segment.ParameterName] = segment.FieldName
+	}
+	parameterNames[strings.ToLower(g.input.Name)+"name"] = g.id.Segments[len(g.id.Segments)-1].FieldName
+
+	signature, ok := g.sdk.methods[g.input.ClientName][method]
+	if !ok {
+		// the usual signature for an SDK Client
+		args := []string{"ctx"}
+		for _, field := range idFields {
+			args = append(args, fmt.Sprintf("%s.%s", idVar, field))
+		}
+		if payloadVar != "" {
+			args = append(args, payloadVar)
+		}
+		return strings.Join(args, ", "), method != "Get"
+	}
+
+	used := map[string]struct{}{}
+	unused := func() string {
+		for _, field := range idFields {
+			if _, ok := used[field]; !ok {
+				used[field] = struct{}{}
+				return field
+			}
+		}
+		return ""
+	}
+
+	args := make([]string, 0)
+	for _, param := range signature.Params.List {
+		paramType := types.ExprString(param.Type)
+		for _, n := range param.Names {
+			switch {
+			case paramType == "context.Context":
+				args = append(args, "ctx")
+
+			case paramType == g.model.Name && payloadVar != "":
+				args = append(args, payloadVar)
+
+			case paramType == "string":
+				field, ok := parameterNames[strings.ToLower(n.Name)]
+				if _, isUsed := used[field]; ok && !isUsed {
+					used[field] = struct{}{}
+				} else {
+					field = unused()
+				}
+
+				if field == "" {
+					args = append(args, `""`)
+					continue
+				}
+				args = append(args, fmt.Sprintf("%s.%s", idVar, field))
+
+			case strings.HasPrefix(paramType, "*"), strings.HasPrefix(paramType, "[]"), strings.HasPrefix(paramType, "map["):
+				args = append(args, "nil")
+
+			default:
+				args = append(args, fmt.Sprintf("%s.%s{}", g.sdk.Name, paramType))
+			}
+		}
+	}
+
+	longRunning := false
+	if signature.Results != nil && len(signature.Results.List) > 0 {
+		longRunning = strings.HasSuffix(types.ExprString(signature.Results.List[0].Type), "Future")
+	}
+	return strings.Join(args, ", "), longRunning
+}
+
+func (g generator) resourceCode() (string, error) {
+	var b bytes.Buffer
+	w := func(format string, args ...interface{}) {
+		fmt.Fprintf(&b, format, args...)
+	}
+
+	w("package %s\n\nimport (\n", g.servicePackageName)
+	for _, v := range g.imports() {
+		if v == "" {
+			w("\n")
+			continue
+		}
+		w("\t%q\n", v)
+	}
+	w(")\n\n")
+
+	// the model
+	w("type %s struct{}\n\n", g.resourceName())
+	w("type %s struct {\n", g.modelName())
+	for _, f := range g.id.argumentFields() {
+		w("\t%s string `tfschema:%q`\n", f.ModelName, f.SchemaName)
+	}
+	if g.model.HasLocation {
+		w("\tLocation string `tfschema:\"location\"`\n")
+	}
+	for _, f := range g.model.Fields {
+		if f.Kind == fieldKindUnsupported {
+			continue
+		}
+		w("\t%s %s `tfschema:%q`\n", f.SdkName, f.modelType(), f.SchemaName)
+	}
+	if g.model.HasTags {
+		w("\tTags map[string]string `tfschema:\"tags\"`\n")
+	}
+	w("}\n\n")
+	w("var _ sdk.ResourceWithUpdate = %s{}\n\n", g.resourceName())
+
+	w("func (r %s) ModelObject() interface{} {\n\treturn &%s{}\n}\n\n", g.resourceName(), g.modelName())
+	w("func (r %s) ResourceType() string {\n\treturn %q\n}\n\n", g.resourceName(), g.input.ResourceType)
+	w("func (r %s) IDValidationFunc() pluginsdk.SchemaValidateFunc {\n\treturn validate.%sID\n}\n\n", g.resourceName(), g.input.Name)
+
+	// the schema
+	w("func (r %s) Arguments() map[string]*pluginsdk.Schema {\n\treturn map[string]*pluginsdk.Schema{\n", g.resourceName())
+	for _, f := range g.id.argumentFields() {
+		if f.SchemaName == "resource_group_name" {
+			w("\"resource_group_name\": azure.SchemaResourceGroupName(),\n\n")
+			continue
+		}
+		w("%q: {\nType: pluginsdk.TypeString,\nRequired: true,\nForceNew: true,\nValidateFunc: validation.StringIsNotEmpty,\n},\n\n", f.SchemaName)
+	}
+	if g.model.HasLocation {
+		w("\"location\": location.Schema(),\n\n")
+	}
+	for _, f := range g.model.Fields {
+		if f.ReadOnly {
+			continue
+		}
+		w("%s\n", g.schemaForField(f))
+	}
+	if g.model.HasTags {
+		w("\"tags\": tags.Schema(),\n")
+	}
+	w("}\n}\n\n")
+
+	w("func (r %s) Attributes() map[string]*pluginsdk.Schema {\n\treturn map[string]*pluginsdk.Schema{\n", g.resourceName())
+	for _, f := range g.model.Fields {
+		if !f.ReadOnly {
+			continue
+		}
+		w("%s\n", g.schemaForField(f))
+	}
+	w("}\n}\n\n")
+
+	g.writeCreate(w)
+	g.writeRead(w)
+	g.writeUpdate(w)
+	g.writeDelete(w)
+
+	return b.String(), nil
+}
+
+func (g generator) schemaForField(f modelField) string {
+	if f.Kind == fieldKindUnsupported {
+		return fmt.Sprintf("// TODO: map the `%s` field (of type `%s`)\n", f.SdkName, f.SdkType)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q: {\n", f.SchemaName)
+	switch f.Kind {
+	case fieldKindBool:
+		b.WriteString("Type: pluginsdk.TypeBool,\n")
+	case fieldKindFloat:
+		b.WriteString("Type: pluginsdk.TypeFloat,\n")
+	case fieldKindInt32, fieldKindInt64:
+		b.WriteString("Type: pluginsdk.TypeInt,\n")
+	case fieldKindStringList:
+		b.WriteString("Type: pluginsdk.TypeList,\n")
+	default:
+		b.WriteString("Type: pluginsdk.TypeString,\n")
+	}
+
+	if f.ReadOnly {
+		b.WriteString("Computed: true,\n")
+	} else {
+		b.WriteString("Optional: true,\n")
+	}
+
+	switch {
+	case f.Kind == fieldKindStringList && f.ReadOnly:
+		b.WriteString("Elem: &pluginsdk.Schema{\nType: pluginsdk.TypeString,\n},\n")
+
+	case f.Kind == fieldKindStringList:
+		b.WriteString("Elem: &pluginsdk.Schema{\nType: pluginsdk.TypeString,\nValidateFunc: validation.StringIsNotEmpty,\n},\n")
+
+	case f.Kind == fieldKindEnum && !f.ReadOnly && len(f.EnumValues) > 0:
+		b.WriteString("ValidateFunc: validation.StringInSlice([]string{\n")
+		for _, v := range f.EnumValues {
+			fmt.Fprintf(&b, "string(%s.%s),\n", g.sdk.Name, v)
+		}
+		b.WriteString("}, false),\n")
+
+	case f.Kind == fieldKindString && !f.ReadOnly:
+		b.WriteString("ValidateFunc: validation.StringIsNotEmpty,\n")
+	}
+
+	b.WriteString("},\n")
+	return b.String()
+}
+
+// expandValue returns the expression for the field within the SDK Model from the Typed Model - which isn't
+// possible for a pointer to an Enum, which instead needs to be assigned using expandField
+func (g generator) expandValue(f modelField, source string) (string, bool) {
+	switch f.Kind {
+	case fieldKindBool:
+		return fmt.Sprintf("utils.Bool(%s)", source), true
+	case fieldKindFloat:
+		return fmt.Sprintf("utils.Float(%s)", source), true
+	case fieldKindInt32:
+		return fmt.Sprintf("utils.Int32(int32(%s))", source), true
+	case fieldKindInt64:
+		return fmt.Sprintf("utils.Int64(%s)", source), true
+	case fieldKindString:
+		return fmt.Sprintf("utils.String(%s)", source), true
+	case fieldKindStringList:
+		return fmt.Sprintf("&%s", source), true
+	case fieldKindEnum:
+		if !f.Pointer {
+			return fmt.Sprintf("%s.%s(%s)", g.sdk.Name, f.EnumType, source), true
+		}
+	}
+	return "", false
+}
+
+// expandField returns the statements which set the field within the SDK Model from the Typed Model
+func (g generator) expandField(f modelField, target, source string) string {
+	if value, ok := g.expandValue(f, source); ok {
+		return fmt.Sprintf("%s = %s\n", target, value)
+	}
+	if f.Kind == fieldKindEnum {
+		variable := toCamelCase(f.SdkName)
+		return fmt.Sprintf("%s := %s.%s(%s)\n%s = &%s\n", variable, g.sdk.Name, f.EnumType, source, target, variable)
+	}
+	return ""
+}
+
+// flattenField returns the statements which set the field within the Typed Model from the SDK Model
+func (g generator) flattenField(f modelField, target, source string) string {
+	value := "*v"
+	switch f.Kind {
+	case fieldKindUnsupported:
+		return fmt.Sprintf("// TODO: flatten `%s`\n", source)
+	case fieldKindInt32:
+		value = "int64(*v)"
+	case fieldKindEnum:
+		if !f.Pointer {
+			return fmt.Sprintf("%s = string(%s)\n", target, source)
+		}
+		value = "string(*v)"
+	}
+	return fmt.Sprintf("if v := %s; v != nil {\n%s = %s\n}\n", source, target, value)
+}
+
+func (g generator) writeCreate(w func(format string, args ...interface{})) {
+	idArgs := []string{"subscriptionId"}
+	for _, f := range g.id.modelFields() {
+		idArgs = append(idArgs, fmt.Sprintf("model.%s", f.ModelName))
+	}
+	getArgs, _ := g.callArguments("Get", "id", "")
+	createArgs, longRunning := g.callArguments("CreateOrUpdate", "id", "payload")
+
+	w(`func (r %[1]s) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model %[2]s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+			client := %[3]s
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.New%[4]sID(%[5]s)
+			existing, err := client.Get(%[6]s)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %%s: %%+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := %[7]s.%[8]s{
+`, g.resourceName(), g.modelName(), g.client(), g.input.Name, strings.Join(idArgs, ", "), getArgs, g.sdk.Name, g.model.Name)
+	if g.model.HasLocation {
+		w("Location: utils.String(location.Normalize(model.Location)),\n")
+	}
+	if g.model.PropertiesField != "" {
+		w("%s: &%s.%s{\n", g.model.PropertiesField, g.sdk.Name, g.model.PropertiesType)
+		for _, f := range g.model.Fields {
+			if f.ReadOnly {
+				continue
+			}
+			if value, ok := g.expandValue(f, fmt.Sprintf("model.%s", f.SdkName)); ok {
+				w("%s: %s,\n", f.SdkName, value)
+			}
+		}
+		w("},\n")
+	}
+	if g.model.HasTags {
+		w("Tags: tags.FromTypedObject(metadata.Client.Tags, model.Tags),\n")
+	}
+	w("}\n")
+	for _, f := range g.model.Fields {
+		if f.ReadOnly || f.Kind != fieldKindEnum || !f.Pointer {
+			continue
+		}
+		w(g.expandField(f, fmt.Sprintf("payload.%s.%s", g.model.PropertiesField, f.SdkName), fmt.Sprintf("model.%s", f.SdkName)))
+	}
+	w("\n")
+
+	g.writeCall(w, "CreateOrUpdate", createArgs, "id", longRunning, "creating", "creation of")
+	w(`
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+`)
+}
+
+func (g generator) writeRead(w func(format string, args ...interface{})) {
+	getArgs, _ := g.callArguments("Get", "id", "")
+
+	w(`func (r %[1]s) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := %[2]s
+
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(%[4]s)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %%s: %%+v", *id, err)
+			}
+
+			state := %[5]s{
+`, g.resourceName(), g.client(), g.input.Name, getArgs, g.modelName())
+	for _, f := range g.id.argumentFields() {
+		w("%s: id.%s,\n", f.ModelName, f.Segment.FieldName)
+	}
+	if g.model.HasLocation {
+		w("Location: location.NormalizeNilable(resp.Location),\n")
+	}
+	if g.model.HasTags {
+		w("Tags: tags.ToTypedObject(metadata.Client.Tags, resp.Tags, metadata.ResourceData.Get(\"tags\").(map[string]interface{})),\n")
+	}
+	w("}\n\n")
+
+	if g.model.PropertiesField != "" {
+		w("if props := resp.%s; props != nil {\n", g.model.PropertiesField)
+		for _, f := range g.model.Fields {
+			w(g.flattenField(f, fmt.Sprintf("state.%s", f.SdkName), fmt.Sprintf("props.%s", f.SdkName)))
+		}
+		w("}\n\n")
+	}
+
+	w(`return metadata.Encode(&state)
+		},
+	}
+}
+
+`)
+}
+
+func (g generator) writeUpdate(w func(format string, args ...interface{})) {
+	getArgs, _ := g.callArguments("Get", "id", "")
+	updateArgs, longRunning := g.callArguments("CreateOrUpdate", "id", "payload")
+
+	w(`func (r %[1]s) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := %[2]s
+
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model %[4]s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+			payload, err := client.Get(%[5]s)
+			if err != nil {
+				return fmt.Errorf("retrieving %%s: %%+v", *id, err)
+			}
+`, g.resourceName(), g.client(), g.input.Name, g.modelName(), getArgs)
+	if g.model.PropertiesField != "" {
+		w("if payload.%[1]s == nil {\nreturn fmt.Errorf(\"retrieving %%s: `%[2]s` was nil\", *id)\n}\n", g.model.PropertiesField, "properties")
+	}
+	w("\n")
+
+	for _, f := range g.model.Fields {
+		if f.ReadOnly || f.Kind == fieldKindUnsupported {
+			continue
+		}
+		w("if metadata.ResourceData.HasChange(%q) {\n", f.SchemaName)
+		w(g.expandField(f, fmt.Sprintf("payload.%s.%s", g.model.PropertiesField, f.SdkName), fmt.Sprintf("model.%s", f.SdkName)))
+		w("}\n\n")
+	}
+	if g.model.HasTags {
+		w("if metadata.ResourceData.HasChange(\"tags\") {\npayload.Tags = tags.FromTypedObject(metadata.Client.Tags, model.Tags)\n}\n\n")
+	}
+
+	g.writeCall(w, "CreateOrUpdate", updateArgs, "*id", longRunning, "updating", "update of")
+	w(`
+			return nil
+		},
+	}
+}
+
+`)
+}
+
+func (g generator) writeDelete(w func(format string, args ...interface{})) {
+	deleteArgs, longRunning := g.callArguments("Delete", "id", "")
+
+	w(`func (r %[1]s) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := %[2]s
+
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %%s..", *id)
+`, g.resourceName(), g.client(), g.input.Name)
+	g.writeCall(w, "Delete", deleteArgs, "*id", longRunning, "deleting", "deletion of")
+	w(`
+			return nil
+		},
+	}
+}
+`)
+}
+
+func (g generator) writeCall(w func(format string, args ...interface{}), method, args, idExpr string, longRunning bool, verb, waitingFor string) {
+	if longRunning {
+		w(`future, err := client.%[1]s(%[2]s)
+if err != nil {
+	return fmt.Errorf("%[3]s %%s: %%+v", %[5]s, err)
+}
+
+if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	return fmt.Errorf("waiting for the %[4]s %%s: %%+v", %[5]s, err)
+}
+`, method, args, verb, waitingFor, idExpr)
+		return
+	}
+
+	w(`if _, err := client.%[1]s(%[2]s); err != nil {
+	return fmt.Errorf("%[3]s %%s: %%+v", %[4]s, err)
+}
+`, method, args, verb, idExpr)
+}
+
+func (g generator) testCode() (string, error) {
+	var b bytes.Buffer
+	w := func(format string, args ...interface{}) {
+		fmt.Fprintf(&b, format, args...)
+	}
+
+	getArgs, _ := g.callArguments("Get", "id", "")
+	testType := fmt.Sprintf("%sResource", g.input.Name)
+
+	w(`package %[1]s_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"%[2]s/internal/acceptance"
+	"%[2]s/internal/acceptance/check"
+	"%[2]s/internal/clients"
+	"%[2]s/internal/services/%[1]s/parse"
+	"%[2]s/internal/tf/pluginsdk"
+	"%[2]s/utils"
+)
+
+type %[3]s struct{}
+`, g.servicePackageName, providerImportPath, testType)
+
+	for _, test := range []struct {
+		name    string
+		configs []string
+	}{
+		{name: "basic", configs: []string{"basic"}},
+		{name: "complete", configs: []string{"complete"}},
+		{name: "update", configs: []string{"basic", "complete", "basic"}},
+	} {
+		w(`
+func TestAcc%[1]s_%[2]s(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[3]q, "test")
+	r := %[4]s{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+`, g.input.Name, test.name, g.input.ResourceType, testType)
+		for _, config := range test.configs {
+			w(`		{
+			Config: r.%s(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+`, config)
+		}
+		w("\t})\n}\n")
+	}
+
+	w(`
+func TestAcc%[1]s_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[2]q, "test")
+	r := %[3]s{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r %[3]s) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.%[1]sID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.%[4]s.%[5]s.Get(%[6]s)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %%s: %%+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+`, g.input.Name, g.input.ResourceType, testType, g.clientField, g.input.ClientName, getArgs)
+
+	configArgs := "r.template(data), data.RandomInteger"
+	if g.model.HasLocation && !g.id.HasResourceGroup {
+		configArgs += ", data.Locations.Primary"
+	}
+
+	w(`
+func (r %[1]s) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+%%[1]s
+
+resource %[2]q "test" {
+%[3]s}
+`+"`"+`, %[6]s)
+}
+
+func (r %[1]s) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+%%[1]s
+
+resource %[2]q "test" {
+%[4]s}
+`+"`"+`, %[6]s)
+}
+
+func (r %[1]s) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+%%s
+
+resource %[2]q "import" {
+%[5]s}
+`+"`"+`, r.basic(data))
+}
+`, testType, g.input.ResourceType, g.testConfigArguments(false), g.testConfigArguments(true), g.testConfigImportArguments(), configArgs)
+
+	if !g.id.HasResourceGroup {
+		w(`
+func (r %s) template(_ acceptance.TestData) string {
+	return `+"`"+`
+provider "azurerm" {
+  features {}
+}
+`+"`"+`
+}
+`, testType)
+		return b.String(), nil
+	}
+
+	w(`
+func (r %s) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%%d"
+  location = "%%s"
+}
+`+"`"+`, data.RandomInteger, data.Locations.Primary)
+}
+`, testType)
+
+	return b.String(), nil
+}
+
+func (g generator) testConfigArguments(complete bool) string {
+	lines := make([][2]string, 0)
+	for _, f := range g.id.argumentFields() {
+		switch f.SchemaName {
+		case "name":
+			lines = append(lines, [2]string{"name", fmt.Sprintf("\"acctest-%s-%%[2]d\"", strings.ReplaceAll(convertToSnakeCase(g.input.Name), "_", ""))})
+		case "resource_group_name":
+			lines = append(lines, [2]string{"resource_group_name", "azurerm_resource_group.test.name"})
+		default:
+			lines = append(lines, [2]string{f.SchemaName, "\"\" # TODO: reference the parent Resource"})
+		}
+	}
+	if g.model.HasLocation {
+		value := "azurerm_resource_group.test.location"
+		if !g.id.HasResourceGroup {
+			value = "\"%[3]s\""
+		}
+		lines = append(lines, [2]string{"location", value})
+	}
+	if complete {
+		for _, f := range g.model.Fields {
+			if f.ReadOnly || f.Kind == fieldKindUnsupported {
+				continue
+			}
+			lines = append(lines, [2]string{f.SchemaName, "#"})
+		}
+	}
+
+	return formatHCLArguments(lines) + g.testConfigTags(complete)
+}
+
+func (g generator) testConfigImportArguments() string {
+	lines := make([][2]string, 0)
+	for _, f := range g.id.argumentFields() {
+		lines = append(lines, [2]string{f.SchemaName, fmt.Sprintf("%s.test.%s", g.input.ResourceType, f.SchemaName)})
+	}
+	if g.model.HasLocation {
+		lines = append(lines, [2]string{"location", fmt.Sprintf("%s.test.location", g.input.ResourceType)})
+	}
+	return formatHCLArguments(lines)
+}
+
+func (g generator) testConfigTags(complete bool) string {
+	if !complete || !g.model.HasTags {
+		return ""
+	}
+	return "\n  tags = {\n    ENV = \"Test\"\n  }\n"
+}
+
+// formatHCLArguments aligns the equals signs, as `terraform fmt` does
+func formatHCLArguments(lines [][2]string) string {
+	width := 0
+	for _, line := range lines {
+		if len(line[0]) > width && !strings.HasPrefix(line[1], "#") {
+			width = len(line[0])
+		}
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		if strings.HasPrefix(line[1], "#") {
+			fmt.Fprintf(&b, "  # TODO: configure %s\n", line[0])
+			continue
+		}
+		fmt.Fprintf(&b, "  %s = %s\n", padRight(line[0], width), line[1])
+	}
+	return b.String()
+}
+
+func padRight(input string, width int) string {
+	return input + strings.Repeat(" ", width-len(input))
+}
+
+// addResourceIdGenerator adds a `go:generate` directive for `generator-resource-id` to the `resourceids.go` file
+func addResourceIdGenerator(path, servicePackageName, name, resourceId string) error {
+	line := fmt.Sprintf("//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=%s -id=%s", name, resourceId)
+
+	contents := fmt.Sprintf("package %s\n\n", servicePackageName)
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		contents = string(existing)
+		if strings.Contains(contents, fmt.Sprintf("-name=%s ", name)) {
+			return nil
+		}
+	}
+
+	contents = strings.TrimSuffix(contents, "\n") + "\n" + line + "\n"
+	return os.WriteFile(path, []byte(contents), 0644)
+}
+
+// registerResource adds the Resource to the list of typed Resources within the `registration.go` file
+func registerResource(path, resource string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	contents := string(existing)
+
+	start := strings.Index(contents, "Resources() []sdk.Resource {")
+	if start == -1 {
+		return fmt.Errorf("this Service Package doesn't support typed Resources")
+	}
+	listStart := strings.Index(contents[start:], "return []sdk.Resource{")
+	if listStart == -1 {
+		return fmt.Errorf("the list of typed Resources wasn't found")
+	}
+	listStart += start + len("return []sdk.Resource{")
+
+	// the list can either be empty (`[]sdk.Resource{}`) or one Resource per line
+	if strings.HasPrefix(contents[listStart:], "}") {
+		contents = contents[:listStart] + fmt.Sprintf("\n\t\t%s,\n\t", resource) + contents[listStart:]
+	} else {
+		listEnd := strings.Index(contents[listStart:], "\n\t}")
+		if listEnd == -1 {
+			return fmt.Errorf("the end of the list of typed Resources wasn't found")
+		}
+		listEnd += listStart
+		if strings.Contains(contents[listStart:listEnd], fmt.Sprintf("\t%s,", resource)) {
+			return nil
+		}
+		contents = contents[:listEnd] + fmt.Sprintf("\n\t\t%s,", resource) + contents[listEnd:]
+	}
+
+	return os.WriteFile(path, []byte(contents), 0644)
+}
+
+func goFmtAndWriteToFile(filePath, fileContents string) error {
+	formatted, err := format.Source([]byte(fileContents))
+	if err != nil {
+		return fmt.Errorf("formatting %q: %+v", filePath, err)
+	}
+
+	if err := os.WriteFile(filePath, formatted, 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", filePath, err)
+	}
+
+	return nil
+}
+
+func toCamelCase(input string) string {
+	out := []rune(input)
+	for i := range out {
+		// lower-case the leading acronym, e.g. `SKUName` -> `skuName`
+		if i > 0 && i+1 < len(out) && unicode.IsLower(out[i+1]) {
+			break
+		}
+		if !unicode.IsUpper(out[i]) {
+			break
+		}
+		out[i] = unicode.ToLower(out[i])
+	}
+	return string(out)
+}
+
+func convertToSnakeCase(input string) string {
+	splitIdxMap := map[int]struct{}{}
+	var lastChar rune
+	for idx, char := range input {
+		switch {
+		case idx == 0:
+			splitIdxMap[idx] = struct{}{}
+		case unicode.IsUpper(lastChar) == unicode.IsUpper(char):
+		case unicode.IsUpper(lastChar):
+			splitIdxMap[idx-1] = struct{}{}
+		case unicode.IsUpper(char):
+			splitIdxMap[idx] = struct{}{}
+		}
+		lastChar = char
+	}
+	splitIdx := make([]int, 0, len(splitIdxMap))
+	for idx := range splitIdxMap {
+		splitIdx = append(splitIdx, idx)
+	}
+	sort.Ints(splitIdx)
+
+	inputRunes := []rune(input)
+	out := make([]string, len(splitIdx))
+	for i := range splitIdx {
+		if i == len(splitIdx)-1 {
+			out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:]))
+			continue
+		}
+		out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:splitIdx[i+1]]))
+	}
+	return strings.Join(out, "_")
+}
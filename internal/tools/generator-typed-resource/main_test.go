@@ -0,0 +1,199 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+var updateGoldenFiles = flag.Bool("update", false, "Should the expected output in ./testdata/expected be updated?")
+
+func TestGenerateLoadTest(t *testing.T) {
+	internalPath := filepath.Join(t.TempDir(), "internal")
+	if err := copyDirectory("./testdata/internal", internalPath); err != nil {
+		t.Fatalf("copying testdata: %+v", err)
+	}
+	servicePath := filepath.Join(internalPath, "services", "loadtest")
+
+	input := generatorInput{
+		ServicePackagePath: servicePath,
+		Name:               "LoadTest",
+		ResourceType:       "azurerm_load_test",
+		ResourceId:         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1",
+		SdkPath:            "./testdata/vendor/github.com/example/sdk/loadtestservice",
+		ModelName:          "LoadTestResource",
+		ClientName:         "LoadTestsClient",
+	}
+	if err := run(input); err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+
+	for _, fileName := range []string{"load_test_resource.go", "load_test_resource_test.go", "registration.go", "resourceids.go"} {
+		actual, err := os.ReadFile(filepath.Join(servicePath, fileName))
+		if err != nil {
+			t.Fatalf("reading %q: %+v", fileName, err)
+		}
+
+		expectedPath := filepath.Join("testdata", "expected", fileName+".golden")
+		if *updateGoldenFiles {
+			if err := os.WriteFile(expectedPath, actual, 0644); err != nil {
+				t.Fatalf("updating %q: %+v", expectedPath, err)
+			}
+			continue
+		}
+
+		expected, err := os.ReadFile(expectedPath)
+		if err != nil {
+			t.Fatalf("reading %q: %+v", expectedPath, err)
+		}
+		if string(actual) != string(expected) {
+			dmp := diffmatchpatch.New()
+			diffs := dmp.DiffMain(string(expected), string(actual), false)
+			t.Fatalf("unexpected output for %q:\n%s", fileName, dmp.DiffPrettyText(diffs))
+		}
+	}
+
+	// generating the Resource again shouldn't overwrite it
+	if err := run(input); err == nil {
+		t.Fatalf("expected an error when the Resource already exists but didn't get one")
+	}
+}
+
+func TestParseResourceId(t *testing.T) {
+	testData := []struct {
+		name     string
+		id       string
+		expected []string
+		error    bool
+	}{
+		{
+			name:     "LoadTest",
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1",
+			expected: []string{"SubscriptionId", "ResourceGroup", "Name"},
+		},
+		{
+			name:     "Database",
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/databases/database1",
+			expected: []string{"SubscriptionId", "ResourceGroup", "ServerName", "Name"},
+		},
+		{
+			name:     "Gallery",
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1",
+			expected: []string{"SubscriptionId", "ResourceGroup", "Name"},
+		},
+		{
+			name:  "Invalid",
+			id:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+			error: true,
+		},
+		{
+			name:  "NoSubscription",
+			id:    "/providers/Microsoft.Management/managementGroups/group1",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.id)
+
+		actual, err := parseResourceId(v.name, v.id)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("parsing %q: %+v", v.id, err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		fields := make([]string, 0)
+		for _, segment := range actual.Segments {
+			fields = append(fields, segment.FieldName)
+		}
+		if strings.Join(fields, ",") != strings.Join(v.expected, ",") {
+			t.Fatalf("expected the fields %q but got %q", strings.Join(v.expected, ","), strings.Join(fields, ","))
+		}
+	}
+}
+
+func TestRegisterResource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registration.go")
+	input := `package example
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ExampleResource{},
+	}
+}
+`
+	if err := os.WriteFile(path, []byte(input), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", path, err)
+	}
+
+	// registering the Resource twice should be a no-op
+	for i := 0; i < 2; i++ {
+		if err := registerResource(path, "OtherResource{}"); err != nil {
+			t.Fatalf("registering the Resource: %+v", err)
+		}
+	}
+
+	actual, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", path, err)
+	}
+	expected := `package example
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ExampleResource{},
+		OtherResource{},
+	}
+}
+`
+	if string(actual) != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, string(actual))
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	testData := map[string]string{
+		"Sku":             "sku",
+		"SKUName":         "skuName",
+		"PublicIPAddress": "publicIPAddress",
+	}
+
+	for input, expected := range testData {
+		if actual := toCamelCase(input); actual != expected {
+			t.Fatalf("expected %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func copyDirectory(source, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relative, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destination, relative)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, contents, 0644)
+	})
+}
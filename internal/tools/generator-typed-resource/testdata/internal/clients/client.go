@@ -0,0 +1,9 @@
+package clients
+
+import (
+	loadTest "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/client"
+)
+
+type Client struct {
+	LoadTest *loadTest.Client
+}
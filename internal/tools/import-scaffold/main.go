@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+const (
+	modeBlocks   = "blocks"
+	modeCommands = "commands"
+
+	// probeResourceId is a Resource ID which shouldn't be accepted by any Resource, used to
+	// detect Resources which don't validate the Resource ID at import time
+	probeResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/import-scaffold/providers/Import.Scaffold/unknownTypes/probe"
+)
+
+func main() {
+	f := flag.NewFlagSet("example", flag.ExitOnError)
+
+	inputPath := f.String("input", "", "The path to a JSON file containing a listing of Azure Resources (e.g. the output of `az resource list`)")
+	outputPath := f.String("output-path", "", "The path to the directory where the generated files should be written")
+	mode := f.String("mode", modeBlocks, "Whether to generate `import` blocks (blocks) or `terraform import` commands (commands)")
+
+	_ = f.Parse(os.Args[1:])
+
+	quitWithError := func(message string) {
+		log.Print(message)
+		os.Exit(1)
+	}
+
+	if inputPath == nil || *inputPath == "" {
+		quitWithError("The path to the JSON listing of Azure Resources must be specified via `-input`")
+		return
+	}
+
+	if outputPath == nil || *outputPath == "" {
+		quitWithError("The path to the output directory must be specified via `-output-path`")
+		return
+	}
+
+	if *mode != modeBlocks && *mode != modeCommands {
+		quitWithError("The mode specified via `-mode` must be either `blocks` or `commands`")
+		return
+	}
+
+	if err := run(*inputPath, *outputPath, *mode); err != nil {
+		panic(err)
+	}
+}
+
+func run(inputPath, outputPath, mode string) error {
+	resources, err := loadResources(inputPath)
+	if err != nil {
+		return fmt.Errorf("loading Azure Resources from %q: %+v", inputPath, err)
+	}
+
+	matchers, err := registeredMatchers()
+	if err != nil {
+		return fmt.Errorf("building matchers for the registered Resources: %+v", err)
+	}
+
+	generator := importGenerator{
+		matchers: matchers,
+		mode:     mode,
+	}
+
+	// the Importers log each Resource ID they parse, which is noise here
+	log.SetOutput(ioutil.Discard)
+	output := generator.generate(resources)
+	log.SetOutput(os.Stderr)
+
+	if err := saveContent(filepath.Join(outputPath, "main.tf"), output.configuration); err != nil {
+		return fmt.Errorf("saving configuration: %+v", err)
+	}
+
+	if mode == modeCommands {
+		if err := saveContent(filepath.Join(outputPath, "import.sh"), output.commands); err != nil {
+			return fmt.Errorf("saving import commands: %+v", err)
+		}
+	}
+
+	for _, unmatched := range output.unmatched {
+		log.Printf("[WARN] no Resource could be found for %q (Type %q)", unmatched.ID, unmatched.Type)
+	}
+
+	return nil
+}
+
+// armResource is the subset of an Azure Resource Manager resource used to generate imports
+type armResource struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Location      string `json:"location"`
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+// loadResources parses either a JSON array of resources (as output by `az resource list`
+// and `az group list`) or an ARM list response containing a `value` array
+func loadResources(path string) ([]armResource, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseResources(contents)
+}
+
+func parseResources(contents []byte) ([]armResource, error) {
+	trimmed := strings.TrimSpace(string(contents))
+
+	var resources []armResource
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(contents, &resources); err != nil {
+			return nil, fmt.Errorf("unmarshaling resources: %+v", err)
+		}
+	} else {
+		var listResult struct {
+			Value []armResource `json:"value"`
+		}
+		if err := json.Unmarshal(contents, &listResult); err != nil {
+			return nil, fmt.Errorf("unmarshaling list result: %+v", err)
+		}
+		resources = listResult.Value
+	}
+
+	for i, resource := range resources {
+		if resource.ID == "" {
+			return nil, fmt.Errorf("resource at index %d has no `id`", i)
+		}
+	}
+
+	return resources, nil
+}
+
+// resourceMatcher determines whether a Resource ID can be imported into a given Resource
+type resourceMatcher struct {
+	// resourceName is the name of the Resource e.g. `azurerm_resource_group`
+	resourceName string
+
+	// resource is the Plugin SDK representation of this Resource, used for the Schema
+	resource *schema.Resource
+
+	// accepts returns whether the specified Resource ID is valid for this Resource
+	accepts func(id string) bool
+}
+
+func registeredMatchers() ([]resourceMatcher, error) {
+	matchers := make([]resourceMatcher, 0)
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, rs := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(rs)
+			rsWrapper, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
+			}
+
+			validateFunc := rs.IDValidationFunc()
+			matchers = append(matchers, resourceMatcher{
+				resourceName: rs.ResourceType(),
+				resource:     rsWrapper,
+				accepts: func(id string) bool {
+					_, errors := validateFunc(id, "id")
+					return len(errors) == 0
+				},
+			})
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for key, rs := range service.SupportedResources() {
+			resource := rs
+			matchers = append(matchers, resourceMatcher{
+				resourceName: key,
+				resource:     resource,
+				accepts: func(id string) bool {
+					return importerAcceptsId(resource, id)
+				},
+			})
+		}
+	}
+
+	// Resources which don't validate the Resource ID at import time would match everything
+	log.SetOutput(ioutil.Discard)
+	out := make([]resourceMatcher, 0)
+	for _, matcher := range matchers {
+		if matcher.accepts(probeResourceId) {
+			continue
+		}
+
+		out = append(out, matcher)
+	}
+	log.SetOutput(os.Stderr)
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].resourceName < out[j].resourceName
+	})
+
+	return out, nil
+}
+
+// importerAcceptsId runs the Importer for this Resource without a client, which is sufficient to
+// determine whether the Resource ID is valid for Resources using `pluginsdk.ImporterValidatingResourceId`
+func importerAcceptsId(resource *schema.Resource, id string) (accepted bool) {
+	if resource.Importer == nil {
+		return false
+	}
+
+	defer func() {
+		// some Importers go on to retrieve the Resource using the API client once the Resource ID
+		// has been validated - which isn't available here, so the Resource ID was accepted
+		if r := recover(); r != nil {
+			accepted = true
+		}
+	}()
+
+	d := resource.TestResourceData()
+	d.SetId(id)
+
+	var err error
+	if resource.Importer.StateContext != nil {
+		_, err = resource.Importer.StateContext(context.Background(), d, nil)
+	} else if resource.Importer.State != nil {
+		// nolint staticcheck
+		_, err = resource.Importer.State(d, nil)
+	} else {
+		return false
+	}
+
+	return err == nil
+}
+
+type importGenerator struct {
+	matchers []resourceMatcher
+
+	// mode is either `blocks` or `commands`
+	mode string
+}
+
+type importOutput struct {
+	// configuration is the Terraform Configuration containing the skeleton resources
+	// (and when using `blocks` mode, the import blocks)
+	configuration string
+
+	// commands is the list of `terraform import` commands when using `commands` mode
+	commands string
+
+	// unmatched is a list of the Azure Resources which couldn't be mapped to a Resource
+	unmatched []armResource
+}
+
+func (gen importGenerator) generate(resources []armResource) importOutput {
+	sort.Slice(resources, func(i, j int) bool {
+		return strings.ToLower(resources[i].ID) < strings.ToLower(resources[j].ID)
+	})
+
+	usedLabels := make(map[string]struct{})
+	configuration := make([]string, 0)
+	commands := []string{
+		"#!/usr/bin/env bash",
+		"set -e",
+		"",
+	}
+	unmatched := make([]armResource, 0)
+
+	for _, resource := range resources {
+		candidates := gen.candidatesForId(resource.ID)
+		if len(candidates) == 0 {
+			unmatched = append(unmatched, resource)
+			continue
+		}
+
+		matcher := candidates[0]
+		label := uniqueLabel(resource, usedLabels)
+		address := fmt.Sprintf("%s.%s", matcher.resourceName, label)
+
+		blocks := make([]string, 0)
+		if len(candidates) > 1 {
+			alternatives := make([]string, 0)
+			for _, candidate := range candidates[1:] {
+				alternatives = append(alternatives, candidate.resourceName)
+			}
+			blocks = append(blocks, fmt.Sprintf("# NOTE: %q can also be imported as: %s", resource.ID, strings.Join(alternatives, ", ")))
+		}
+
+		if gen.mode == modeBlocks {
+			blocks = append(blocks, fmt.Sprintf(`import {
+  to = %s
+  id = %q
+}
+`, address, resource.ID))
+		} else {
+			commands = append(commands, fmt.Sprintf("terraform import %s %q", address, resource.ID))
+		}
+
+		blocks = append(blocks, resourceBlock(matcher, label, resource))
+		configuration = append(configuration, strings.Join(blocks, "\n"))
+	}
+
+	return importOutput{
+		configuration: strings.Join(configuration, "\n"),
+		commands:      strings.Join(commands, "\n") + "\n",
+		unmatched:     unmatched,
+	}
+}
+
+// candidatesForId returns the Resources which accept this Resource ID, preferring
+// Resources which aren't deprecated
+func (gen importGenerator) candidatesForId(id string) []resourceMatcher {
+	supported := make([]resourceMatcher, 0)
+	deprecated := make([]resourceMatcher, 0)
+	for _, matcher := range gen.matchers {
+		if !matcher.accepts(id) {
+			continue
+		}
+
+		if matcher.resource != nil && matcher.resource.DeprecationMessage != "" {
+			deprecated = append(deprecated, matcher)
+			continue
+		}
+
+		supported = append(supported, matcher)
+	}
+
+	return append(supported, deprecated...)
+}
+
+func resourceBlock(matcher resourceMatcher, label string, resource armResource) string {
+	knownValues := map[string]string{
+		"name": resourceName(resource),
+	}
+	if resource.Location != "" {
+		knownValues["location"] = resource.Location
+	}
+	if resourceGroup := resourceGroupName(resource); resourceGroup != "" {
+		knownValues["resource_group_name"] = resourceGroup
+	}
+
+	fieldNames := make([]string, 0)
+	if matcher.resource != nil {
+		for k := range matcher.resource.Schema {
+			fieldNames = append(fieldNames, k)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	// align the known values in the same way `terraform fmt` does
+	maxLength := 0
+	for _, k := range fieldNames {
+		if _, ok := knownValues[k]; ok && len(k) > maxLength {
+			maxLength = len(k)
+		}
+	}
+
+	fields := make([]string, 0)
+	todos := make([]string, 0)
+	for _, k := range fieldNames {
+		if value, ok := knownValues[k]; ok {
+			fields = append(fields, fmt.Sprintf("  %s%s = %q", k, strings.Repeat(" ", maxLength-len(k)), value))
+			continue
+		}
+
+		if matcher.resource.Schema[k].Required {
+			todos = append(todos, fmt.Sprintf("  # TODO: %s (Required)", k))
+		}
+	}
+
+	lines := append(fields, todos...)
+	return fmt.Sprintf(`resource %q %q {
+%s
+}
+`, matcher.resourceName, label, strings.Join(lines, "\n"))
+}
+
+func resourceName(resource armResource) string {
+	// the name of a nested resource within a listing contains the parent names, e.g. `vnet1/subnet1`
+	segments := strings.Split(resource.Name, "/")
+	name := segments[len(segments)-1]
+	if name != "" {
+		return name
+	}
+
+	idSegments := strings.Split(strings.TrimSuffix(resource.ID, "/"), "/")
+	return idSegments[len(idSegments)-1]
+}
+
+func resourceGroupName(resource armResource) string {
+	if resource.ResourceGroup != "" {
+		return resource.ResourceGroup
+	}
+
+	id, err := azure.ParseAzureResourceID(resource.ID)
+	if err != nil {
+		return ""
+	}
+
+	return id.ResourceGroup
+}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueLabel returns a valid Terraform label for this Azure Resource which hasn't already been used
+func uniqueLabel(resource armResource, usedLabels map[string]struct{}) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(resourceName(resource)), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = fmt.Sprintf("r_%s", label)
+	}
+
+	candidate := label
+	for i := 2; ; i++ {
+		if _, exists := usedLabels[candidate]; !exists {
+			break
+		}
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+
+	usedLabels[candidate] = struct{}{}
+	return candidate
+}
+
+func saveContent(outputFileName string, content string) error {
+	outputPath, err := filepath.Abs(outputFileName)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, _ = file.WriteString(content)
+	return file.Sync()
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+func testMatchers() []resourceMatcher {
+	acceptsSuffix := func(suffix string) func(id string) bool {
+		return func(id string) bool {
+			return strings.Contains(id, suffix)
+		}
+	}
+
+	return []resourceMatcher{
+		{
+			resourceName: "azurerm_resource_group",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"location": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+					},
+				},
+			},
+			accepts: func(id string) bool {
+				return strings.Count(id, "/") == 4
+			},
+		},
+		{
+			resourceName: "azurerm_storage_account",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"resource_group_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"location": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"account_tier": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			accepts: acceptsSuffix("/Microsoft.Storage/storageAccounts/"),
+		},
+		{
+			resourceName: "azurerm_storage_account_legacy",
+			resource: &schema.Resource{
+				DeprecationMessage: "deprecated",
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			accepts: acceptsSuffix("/Microsoft.Storage/storageAccounts/"),
+		},
+	}
+}
+
+func TestLoadResources(t *testing.T) {
+	resources, err := loadResources("./testdata/resources.json")
+	if err != nil {
+		t.Fatalf("loading resources: %+v", err)
+	}
+
+	if len(resources) != 3 {
+		t.Fatalf("expected 3 resources but got %d", len(resources))
+	}
+
+	if resources[0].Type != "Microsoft.Storage/storageAccounts" {
+		t.Fatalf("expected the first resource to be a Storage Account but got %q", resources[0].Type)
+	}
+}
+
+func TestParseResourcesListResult(t *testing.T) {
+	input := `{
+  "value": [
+    {
+      "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+      "name": "example-resources",
+      "type": "Microsoft.Resources/resourceGroups"
+    }
+  ]
+}`
+	resources, err := parseResources([]byte(input))
+	if err != nil {
+		t.Fatalf("parsing resources: %+v", err)
+	}
+
+	if len(resources) != 1 {
+		t.Fatalf("expected 1 resource but got %d", len(resources))
+	}
+}
+
+func TestParseResourcesMissingId(t *testing.T) {
+	input := `[{"name": "example-resources"}]`
+	if _, err := parseResources([]byte(input)); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestUniqueLabel(t *testing.T) {
+	usedLabels := make(map[string]struct{})
+	testData := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "example-resources",
+			expected: "example_resources",
+		},
+		{
+			name:     "Example.Resources",
+			expected: "example_resources_2",
+		},
+		{
+			name:     "vnet1/subnet1",
+			expected: "subnet1",
+		},
+		{
+			name:     "1storage",
+			expected: "r_1storage",
+		},
+	}
+
+	for _, v := range testData {
+		actual := uniqueLabel(armResource{Name: v.name}, usedLabels)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestGenerateImportBlocks(t *testing.T) {
+	resources, err := loadResources("./testdata/resources.json")
+	if err != nil {
+		t.Fatalf("loading resources: %+v", err)
+	}
+
+	generator := importGenerator{
+		matchers: testMatchers(),
+		mode:     modeBlocks,
+	}
+	actual := generator.generate(resources)
+
+	expected := `import {
+  to = azurerm_resource_group.example_resources
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources"
+}
+
+resource "azurerm_resource_group" "example_resources" {
+  location = "westeurope"
+  name     = "example-resources"
+}
+
+# NOTE: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/examplestorage" can also be imported as: azurerm_storage_account_legacy
+import {
+  to = azurerm_storage_account.examplestorage
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/examplestorage"
+}
+
+resource "azurerm_storage_account" "examplestorage" {
+  location            = "westeurope"
+  name                = "examplestorage"
+  resource_group_name = "example-resources"
+  # TODO: account_tier (Required)
+}
+`
+	if actual.configuration != expected {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(actual.configuration, expected, false)
+		t.Fatalf("Expected differs from actual: %s", dmp.DiffPrettyText(diffs))
+	}
+
+	if len(actual.unmatched) != 1 || actual.unmatched[0].Name != "thing1" {
+		t.Fatalf("expected `thing1` to be unmatched but got %+v", actual.unmatched)
+	}
+}
+
+func TestGenerateImportCommands(t *testing.T) {
+	resources, err := loadResources("./testdata/resources.json")
+	if err != nil {
+		t.Fatalf("loading resources: %+v", err)
+	}
+
+	generator := importGenerator{
+		matchers: testMatchers(),
+		mode:     modeCommands,
+	}
+	actual := generator.generate(resources)
+
+	expected := `#!/usr/bin/env bash
+set -e
+
+terraform import azurerm_resource_group.example_resources "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources"
+terraform import azurerm_storage_account.examplestorage "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/examplestorage"
+`
+	if actual.commands != expected {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(actual.commands, expected, false)
+		t.Fatalf("Expected differs from actual: %s", dmp.DiffPrettyText(diffs))
+	}
+
+	if strings.Contains(actual.configuration, "import {") {
+		t.Fatalf("expected no import blocks when using `commands` mode")
+	}
+}